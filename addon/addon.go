@@ -1,23 +1,14 @@
 package addon
 
 import (
-    "bytes"
-    "io/ioutil"
+    "context"
     "net/http"
-    "time"
-    "encoding/json"
-    "io"
-    "errors"
-    "strconv"
-    "github.com/google/go-querystring/query"
     "reflect"
+    "github.com/lumaserv/lumaserv-api-go/core"
 )
 
 type AddonClient struct {
-    baseUrl string
-    apiKey  string
-    client  *http.Client
-    currentProject string
+    *core.Client
 }
 
 func NewClient (apiKey string) AddonClient {
@@ -30,92 +21,18 @@ func NewClientWithUrl (apiKey string, baseUrl string) AddonClient {
     }
 
     return AddonClient {
-        apiKey: apiKey,
-        baseUrl: baseUrl,
+        Client: core.NewClient(apiKey, baseUrl),
     }
 }
 
-func (c *AddonClient) SetCurrentProject (project string) {
-    c.currentProject = project
-}
+type ResponseMessages = core.ResponseMessages
 
-func (c *AddonClient) GetCurrentProject () string {
-    return c.currentProject
-}
+type ResponseMessage = core.ResponseMessage
 
-func (c *AddonClient) SetHttpClient(client *http.Client) {
-    c.client = client
-}
-
-func (c *AddonClient) SetAccessToken(token string) {
-    c.apiKey = token
-}
-
-func (c *AddonClient) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
-    if c.client == nil {
-        c.client = &http.Client{
-            Timeout: time.Second * 5,
-        }
-    }
-
-    req, err := http.NewRequest(method, c.baseUrl+path, postBody)
-    if err != nil {
-        return nil, nil, err
-    }
+type ResponsePagination = core.ResponsePagination
 
-    req.Header.Add("Authorization", "Bearer "+c.apiKey)
-    req.Header.Add("User-Agent", "LUMASERV-go-client")
-    req.Header.Add("Accept", "application/json")
-    res, err := c.client.Do(req)
-    if err != nil {
-        return res, nil, err
-    }
-
-    if res.Body != nil {
-        defer res.Body.Close()
-    }
-
-    body, err := ioutil.ReadAll(res.Body)
-
-    return res, body, err
-}
-
-func (c AddonClient) toStr(in interface{}) string {
-    switch in.(type) {
-        case string:
-            return in.(string)
-        case int:
-            return strconv.Itoa(in.(int))
-    }
+type ResponseMetadata = core.ResponseMetadata
 
-    panic("Unhandled type in toStr")
-}
-
-func (c AddonClient) applyCurrentProject (v reflect.Value) {
-    if len(c.currentProject) > 0 {
-        if v.Kind() == reflect.Ptr {
-            x := v.Elem()
-            f := x.FieldByName("ProjectId")
-            if f.IsValid() && f.CanSet() {
-                if f.Kind() == reflect.String && len(f.String()) == 0 {
-                    f.SetString(c.currentProject)
-                } else if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String {
-                    f.Set(reflect.ValueOf(&c.currentProject))
-                }
-            }
-
-            for i := 0; i < x.NumField(); i++ {
-                field := x.Field(i)
-                if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct || field.Kind() == reflect.Struct {
-                    if field.IsNil() && field.CanSet() {
-                        field.Set(reflect.New(field.Type().Elem()))
-                    }
-                    c.applyCurrentProject(field)
-                }
-            }
-        }
-    }
-}
 type SSLCertificate struct {
     OrganisationId string `json:"organisation_id"`
     ValidUntil string `json:"valid_until"`
@@ -129,12 +46,6 @@ type SSLCertificate struct {
     Labels map[string]*string `json:"labels"`
 }
 
-type ResponsePagination struct {
-    Total int `json:"total"`
-    Page int `json:"page"`
-    PageSize int `json:"page_size"`
-}
-
 type SSLOrganisation struct {
     AdditionalAddress string `json:"additional_address"`
     Address string `json:"address"`
@@ -159,17 +70,6 @@ type SSLType struct {
     Title string `json:"title"`
 }
 
-type ResponseMessages struct {
-    Warnings []ResponseMessage `json:"warnings"`
-    Errors []ResponseMessage `json:"errors"`
-    Infos []ResponseMessage `json:"infos"`
-}
-
-type ResponseMessage struct {
-    Message string `json:"message"`
-    Key string `json:"key"`
-}
-
 type SSLContact struct {
     AdditionalAddress string `json:"additional_address"`
     Address string `json:"address"`
@@ -211,12 +111,6 @@ type PleskLicense struct {
     Labels map[string]*string `json:"labels"`
 }
 
-type ResponseMetadata struct {
-    TransactionId string `json:"transaction_id"`
-    BuildCommit string `json:"build_commit"`
-    BuildTimestamp string `json:"build_timestamp"`
-}
-
 type SSLOrganisationListResponse struct {
     Metadata ResponseMetadata `json:"metadata"`
     Pagination *ResponsePagination `json:"pagination"`
@@ -234,7 +128,7 @@ type SSLContactListResponse struct {
 }
 
 type PleskLicenseTypeListResponse struct {
-    Metadata ResponseMessages `json:"metadata"`
+    Metadata ResponseMetadata `json:"metadata"`
     Pagination *ResponsePagination `json:"pagination"`
     Data []PleskLicenseType `json:"data"`
     Success bool `json:"success"`
@@ -389,21 +283,13 @@ type SSLContactCreateRequest struct {
 }
 
 func (c AddonClient) CreateSSLCertificate(in SSLCertificateCreateRequest) (SSLCertificateSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateSSLCertificateWithContext(context.Background(), in)
+}
+
+func (c AddonClient) CreateSSLCertificateWithContext(ctx context.Context, in SSLCertificateCreateRequest) (SSLCertificateSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SSLCertificateSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/ssl/certificates", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/ssl/certificates", nil, in, &body)
     return body, res, err
 }
 
@@ -426,24 +312,13 @@ type GetSSLCertificatesQueryParams struct {
 }
 
 func (c AddonClient) GetSSLCertificates(qParams GetSSLCertificatesQueryParams) (SSLCertificateListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSSLCertificatesWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetSSLCertificatesWithContext(ctx context.Context, qParams GetSSLCertificatesQueryParams) (SSLCertificateListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SSLCertificateListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/ssl/certificates"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/certificates", qParams, nil, &body)
     return body, res, err
 }
 
@@ -456,24 +331,13 @@ type GetPleskLicenseTypesQueryParams struct {
 }
 
 func (c AddonClient) GetPleskLicenseTypes(qParams GetPleskLicenseTypesQueryParams) (PleskLicenseTypeListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetPleskLicenseTypesWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetPleskLicenseTypesWithContext(ctx context.Context, qParams GetPleskLicenseTypesQueryParams) (PleskLicenseTypeListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := PleskLicenseTypeListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/license/plesk-types"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/license/plesk-types", qParams, nil, &body)
     return body, res, err
 }
 
@@ -491,94 +355,54 @@ type SearchQueryParams struct {
 }
 
 func (c AddonClient) Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.SearchWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SearchResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/search"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/search", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetSSLCertificate(id string) (SSLCertificateSingleResponse, *http.Response, error) {
+    return c.GetSSLCertificateWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetSSLCertificateWithContext(ctx context.Context, id string) (SSLCertificateSingleResponse, *http.Response, error) {
     body := SSLCertificateSingleResponse{}
-    res, j, err := c.Request("GET", "/ssl/certificates/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/certificates/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetSSLOrganisation(id string) (SSLOrganisationSingleResponse, *http.Response, error) {
+    return c.GetSSLOrganisationWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetSSLOrganisationWithContext(ctx context.Context, id string) (SSLOrganisationSingleResponse, *http.Response, error) {
     body := SSLOrganisationSingleResponse{}
-    res, j, err := c.Request("GET", "/ssl/organisations/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/organisations/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) DeleteSSLOrganisation(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteSSLOrganisationWithContext(context.Background(), id)
+}
+
+func (c AddonClient) DeleteSSLOrganisationWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/ssl/organisations/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/ssl/organisations/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) CreateSSLContact(in SSLContactCreateRequest) (SSLContactSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateSSLContactWithContext(context.Background(), in)
+}
+
+func (c AddonClient) CreateSSLContactWithContext(ctx context.Context, in SSLContactCreateRequest) (SSLContactSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SSLContactSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/ssl/contacts", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/ssl/contacts", nil, in, &body)
     return body, res, err
 }
 
@@ -597,43 +421,24 @@ type GetSSLContactsQueryParams struct {
 }
 
 func (c AddonClient) GetSSLContacts(qParams GetSSLContactsQueryParams) (SSLContactListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSSLContactsWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetSSLContactsWithContext(ctx context.Context, qParams GetSSLContactsQueryParams) (SSLContactListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SSLContactListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/ssl/contacts"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/contacts", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) CreateSSLOrganisation(in SSLOrganisationCreateRequest) (SSLOrganisationSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateSSLOrganisationWithContext(context.Background(), in)
+}
+
+func (c AddonClient) CreateSSLOrganisationWithContext(ctx context.Context, in SSLOrganisationCreateRequest) (SSLOrganisationSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SSLOrganisationSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/ssl/organisations", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/ssl/organisations", nil, in, &body)
     return body, res, err
 }
 
@@ -652,94 +457,54 @@ type GetSSLOrganisationsQueryParams struct {
 }
 
 func (c AddonClient) GetSSLOrganisations(qParams GetSSLOrganisationsQueryParams) (SSLOrganisationListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSSLOrganisationsWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetSSLOrganisationsWithContext(ctx context.Context, qParams GetSSLOrganisationsQueryParams) (SSLOrganisationListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SSLOrganisationListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/ssl/organisations"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/organisations", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetSSLType(id string) (SSLTypeSingleResponse, *http.Response, error) {
+    return c.GetSSLTypeWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetSSLTypeWithContext(ctx context.Context, id string) (SSLTypeSingleResponse, *http.Response, error) {
     body := SSLTypeSingleResponse{}
-    res, j, err := c.Request("GET", "/ssl/types/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/types/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetSSLContact(id string) (SSLContactSingleResponse, *http.Response, error) {
+    return c.GetSSLContactWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetSSLContactWithContext(ctx context.Context, id string) (SSLContactSingleResponse, *http.Response, error) {
     body := SSLContactSingleResponse{}
-    res, j, err := c.Request("GET", "/ssl/contacts/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/contacts/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) DeleteSSLContact(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteSSLContactWithContext(context.Background(), id)
+}
+
+func (c AddonClient) DeleteSSLContactWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/ssl/contacts/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/ssl/contacts/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) CreatePleskLicense(in PleskLicenseCreateRequest) (PleskLicenseSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreatePleskLicenseWithContext(context.Background(), in)
+}
+
+func (c AddonClient) CreatePleskLicenseWithContext(ctx context.Context, in PleskLicenseCreateRequest) (PleskLicenseSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := PleskLicenseSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/licenses/plesk", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/licenses/plesk", nil, in, &body)
     return body, res, err
 }
 
@@ -759,24 +524,13 @@ type GetPleskLicensesQueryParams struct {
 }
 
 func (c AddonClient) GetPleskLicenses(qParams GetPleskLicensesQueryParams) (PleskLicenseListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetPleskLicensesWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetPleskLicensesWithContext(ctx context.Context, qParams GetPleskLicensesQueryParams) (PleskLicenseListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := PleskLicenseListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/licenses/plesk"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/licenses/plesk", qParams, nil, &body)
     return body, res, err
 }
 
@@ -789,77 +543,44 @@ type GetSSLTypesQueryParams struct {
 }
 
 func (c AddonClient) GetSSLTypes(qParams GetSSLTypesQueryParams) (SSLTypeListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSSLTypesWithContext(context.Background(), qParams)
+}
+
+func (c AddonClient) GetSSLTypesWithContext(ctx context.Context, qParams GetSSLTypesQueryParams) (SSLTypeListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SSLTypeListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/ssl/types"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssl/types", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetPleskLicense(id string) (PleskLicenseSingleResponse, *http.Response, error) {
+    return c.GetPleskLicenseWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetPleskLicenseWithContext(ctx context.Context, id string) (PleskLicenseSingleResponse, *http.Response, error) {
     body := PleskLicenseSingleResponse{}
-    res, j, err := c.Request("GET", "/licenses/plesk/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/licenses/plesk/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AddonClient) UpdatePleskLicense(in PleskLicenseUpdateRequest, id string) (PleskLicenseSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdatePleskLicenseWithContext(context.Background(), in, id)
+}
+
+func (c AddonClient) UpdatePleskLicenseWithContext(ctx context.Context, in PleskLicenseUpdateRequest, id string) (PleskLicenseSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := PleskLicenseSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/licenses/plesk/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/licenses/plesk/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c AddonClient) GetPleskLicenseType(id string) (PleskLicenseTypeSingleResponse, *http.Response, error) {
+    return c.GetPleskLicenseTypeWithContext(context.Background(), id)
+}
+
+func (c AddonClient) GetPleskLicenseTypeWithContext(ctx context.Context, id string) (PleskLicenseTypeSingleResponse, *http.Response, error) {
     body := PleskLicenseTypeSingleResponse{}
-    res, j, err := c.Request("GET", "/license/plesk-types/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/license/plesk-types/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
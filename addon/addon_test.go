@@ -0,0 +1,35 @@
+package addon_test
+
+import (
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestPleskLicenses(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Token = "token"
+    c := addon.NewClientWithUrl("token", s.ServiceUrl("addon"))
+
+    prod := "prod"
+    created, _, err := c.CreatePleskLicense(addon.PleskLicenseCreateRequest{Address: "10.0.0.1", ProjectId: "p1", TypeId: "web", Labels: map[string]*string{"env": &prod}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    c.CreatePleskLicense(addon.PleskLicenseCreateRequest{Address: "10.0.0.2", ProjectId: "p1", TypeId: "web"})
+
+    list, _, err := c.GetPleskLicenses(addon.GetPleskLicensesQueryParams{Filter: &addon.GetPleskLicensesQueryParamsFilter{Labels: map[string]*string{"env": &prod}}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(list.Data) != 1 || list.Data[0].Id != created.Data.Id {
+        t.Errorf("label filter: got %+v", list.Data)
+    }
+
+    _, _, err = c.GetPleskLicense("missing")
+    if !core.IsNotFound(err) {
+        t.Errorf("got %v, want a not found error", err)
+    }
+}
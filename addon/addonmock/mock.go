@@ -0,0 +1,368 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package addonmock
+
+import (
+    "context"
+    "net/http"
+    "sync"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+)
+
+// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements addon.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+    CreateSSLCertificateFunc func(ctx context.Context, in addon.SSLCertificateCreateRequest) (addon.SSLCertificateSingleResponse, *http.Response, error)
+    GetSSLCertificatesFunc func(ctx context.Context, qParams addon.GetSSLCertificatesQueryParams) (addon.SSLCertificateListResponse, *http.Response, error)
+    GetPleskLicenseTypesFunc func(ctx context.Context, qParams addon.GetPleskLicenseTypesQueryParams) (addon.PleskLicenseTypeListResponse, *http.Response, error)
+    SearchFunc func(ctx context.Context, qParams addon.SearchQueryParams) (addon.SearchResponse, *http.Response, error)
+    GetSSLCertificateFunc func(ctx context.Context, id string) (addon.SSLCertificateSingleResponse, *http.Response, error)
+    GetSSLOrganisationFunc func(ctx context.Context, id string) (addon.SSLOrganisationSingleResponse, *http.Response, error)
+    DeleteSSLOrganisationFunc func(ctx context.Context, id string) (addon.EmptyResponse, *http.Response, error)
+    CreateSSLContactFunc func(ctx context.Context, in addon.SSLContactCreateRequest) (addon.SSLContactSingleResponse, *http.Response, error)
+    GetSSLContactsFunc func(ctx context.Context, qParams addon.GetSSLContactsQueryParams) (addon.SSLContactListResponse, *http.Response, error)
+    CreateSSLOrganisationFunc func(ctx context.Context, in addon.SSLOrganisationCreateRequest) (addon.SSLOrganisationSingleResponse, *http.Response, error)
+    GetSSLOrganisationsFunc func(ctx context.Context, qParams addon.GetSSLOrganisationsQueryParams) (addon.SSLOrganisationListResponse, *http.Response, error)
+    GetSSLTypeFunc func(ctx context.Context, id string) (addon.SSLTypeSingleResponse, *http.Response, error)
+    GetSSLContactFunc func(ctx context.Context, id string) (addon.SSLContactSingleResponse, *http.Response, error)
+    DeleteSSLContactFunc func(ctx context.Context, id string) (addon.EmptyResponse, *http.Response, error)
+    CreatePleskLicenseFunc func(ctx context.Context, in addon.PleskLicenseCreateRequest) (addon.PleskLicenseSingleResponse, *http.Response, error)
+    GetPleskLicensesFunc func(ctx context.Context, qParams addon.GetPleskLicensesQueryParams) (addon.PleskLicenseListResponse, *http.Response, error)
+    GetSSLTypesFunc func(ctx context.Context, qParams addon.GetSSLTypesQueryParams) (addon.SSLTypeListResponse, *http.Response, error)
+    GetPleskLicenseFunc func(ctx context.Context, id string) (addon.PleskLicenseSingleResponse, *http.Response, error)
+    UpdatePleskLicenseFunc func(ctx context.Context, in addon.PleskLicenseUpdateRequest, id string) (addon.PleskLicenseSingleResponse, *http.Response, error)
+    GetPleskLicenseTypeFunc func(ctx context.Context, id string) (addon.PleskLicenseTypeSingleResponse, *http.Response, error)
+}
+
+var _ addon.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+
+func (m *Mock) CreateSSLCertificate(in addon.SSLCertificateCreateRequest) (addon.SSLCertificateSingleResponse, *http.Response, error) {
+    return m.CreateSSLCertificateWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateSSLCertificateWithContext(ctx context.Context, in addon.SSLCertificateCreateRequest) (addon.SSLCertificateSingleResponse, *http.Response, error) {
+    m.record("CreateSSLCertificate", in)
+    if m.CreateSSLCertificateFunc != nil {
+        return m.CreateSSLCertificateFunc(ctx, in)
+    }
+    return addon.SSLCertificateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLCertificates(qParams addon.GetSSLCertificatesQueryParams) (addon.SSLCertificateListResponse, *http.Response, error) {
+    return m.GetSSLCertificatesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSSLCertificatesWithContext(ctx context.Context, qParams addon.GetSSLCertificatesQueryParams) (addon.SSLCertificateListResponse, *http.Response, error) {
+    m.record("GetSSLCertificates", qParams)
+    if m.GetSSLCertificatesFunc != nil {
+        return m.GetSSLCertificatesFunc(ctx, qParams)
+    }
+    return addon.SSLCertificateListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSSLCertificates(ctx context.Context, qParams addon.GetSSLCertificatesQueryParams) *addon.SSLCertificateIterator {
+    return addon.NewSSLCertificateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.SSLCertificate, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSSLCertificatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetPleskLicenseTypes(qParams addon.GetPleskLicenseTypesQueryParams) (addon.PleskLicenseTypeListResponse, *http.Response, error) {
+    return m.GetPleskLicenseTypesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetPleskLicenseTypesWithContext(ctx context.Context, qParams addon.GetPleskLicenseTypesQueryParams) (addon.PleskLicenseTypeListResponse, *http.Response, error) {
+    m.record("GetPleskLicenseTypes", qParams)
+    if m.GetPleskLicenseTypesFunc != nil {
+        return m.GetPleskLicenseTypesFunc(ctx, qParams)
+    }
+    return addon.PleskLicenseTypeListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllPleskLicenseTypes(ctx context.Context, qParams addon.GetPleskLicenseTypesQueryParams) *addon.PleskLicenseTypeIterator {
+    return addon.NewPleskLicenseTypeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.PleskLicenseType, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetPleskLicenseTypesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) Search(qParams addon.SearchQueryParams) (addon.SearchResponse, *http.Response, error) {
+    return m.SearchWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) SearchWithContext(ctx context.Context, qParams addon.SearchQueryParams) (addon.SearchResponse, *http.Response, error) {
+    m.record("Search", qParams)
+    if m.SearchFunc != nil {
+        return m.SearchFunc(ctx, qParams)
+    }
+    return addon.SearchResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLCertificate(id string) (addon.SSLCertificateSingleResponse, *http.Response, error) {
+    return m.GetSSLCertificateWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSSLCertificateWithContext(ctx context.Context, id string) (addon.SSLCertificateSingleResponse, *http.Response, error) {
+    m.record("GetSSLCertificate", id)
+    if m.GetSSLCertificateFunc != nil {
+        return m.GetSSLCertificateFunc(ctx, id)
+    }
+    return addon.SSLCertificateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLOrganisation(id string) (addon.SSLOrganisationSingleResponse, *http.Response, error) {
+    return m.GetSSLOrganisationWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSSLOrganisationWithContext(ctx context.Context, id string) (addon.SSLOrganisationSingleResponse, *http.Response, error) {
+    m.record("GetSSLOrganisation", id)
+    if m.GetSSLOrganisationFunc != nil {
+        return m.GetSSLOrganisationFunc(ctx, id)
+    }
+    return addon.SSLOrganisationSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteSSLOrganisation(id string) (addon.EmptyResponse, *http.Response, error) {
+    return m.DeleteSSLOrganisationWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteSSLOrganisationWithContext(ctx context.Context, id string) (addon.EmptyResponse, *http.Response, error) {
+    m.record("DeleteSSLOrganisation", id)
+    if m.DeleteSSLOrganisationFunc != nil {
+        return m.DeleteSSLOrganisationFunc(ctx, id)
+    }
+    return addon.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateSSLContact(in addon.SSLContactCreateRequest) (addon.SSLContactSingleResponse, *http.Response, error) {
+    return m.CreateSSLContactWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateSSLContactWithContext(ctx context.Context, in addon.SSLContactCreateRequest) (addon.SSLContactSingleResponse, *http.Response, error) {
+    m.record("CreateSSLContact", in)
+    if m.CreateSSLContactFunc != nil {
+        return m.CreateSSLContactFunc(ctx, in)
+    }
+    return addon.SSLContactSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLContacts(qParams addon.GetSSLContactsQueryParams) (addon.SSLContactListResponse, *http.Response, error) {
+    return m.GetSSLContactsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSSLContactsWithContext(ctx context.Context, qParams addon.GetSSLContactsQueryParams) (addon.SSLContactListResponse, *http.Response, error) {
+    m.record("GetSSLContacts", qParams)
+    if m.GetSSLContactsFunc != nil {
+        return m.GetSSLContactsFunc(ctx, qParams)
+    }
+    return addon.SSLContactListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSSLContacts(ctx context.Context, qParams addon.GetSSLContactsQueryParams) *addon.SSLContactIterator {
+    return addon.NewSSLContactIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.SSLContact, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSSLContactsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateSSLOrganisation(in addon.SSLOrganisationCreateRequest) (addon.SSLOrganisationSingleResponse, *http.Response, error) {
+    return m.CreateSSLOrganisationWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateSSLOrganisationWithContext(ctx context.Context, in addon.SSLOrganisationCreateRequest) (addon.SSLOrganisationSingleResponse, *http.Response, error) {
+    m.record("CreateSSLOrganisation", in)
+    if m.CreateSSLOrganisationFunc != nil {
+        return m.CreateSSLOrganisationFunc(ctx, in)
+    }
+    return addon.SSLOrganisationSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLOrganisations(qParams addon.GetSSLOrganisationsQueryParams) (addon.SSLOrganisationListResponse, *http.Response, error) {
+    return m.GetSSLOrganisationsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSSLOrganisationsWithContext(ctx context.Context, qParams addon.GetSSLOrganisationsQueryParams) (addon.SSLOrganisationListResponse, *http.Response, error) {
+    m.record("GetSSLOrganisations", qParams)
+    if m.GetSSLOrganisationsFunc != nil {
+        return m.GetSSLOrganisationsFunc(ctx, qParams)
+    }
+    return addon.SSLOrganisationListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSSLOrganisations(ctx context.Context, qParams addon.GetSSLOrganisationsQueryParams) *addon.SSLOrganisationIterator {
+    return addon.NewSSLOrganisationIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.SSLOrganisation, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSSLOrganisationsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetSSLType(id string) (addon.SSLTypeSingleResponse, *http.Response, error) {
+    return m.GetSSLTypeWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSSLTypeWithContext(ctx context.Context, id string) (addon.SSLTypeSingleResponse, *http.Response, error) {
+    m.record("GetSSLType", id)
+    if m.GetSSLTypeFunc != nil {
+        return m.GetSSLTypeFunc(ctx, id)
+    }
+    return addon.SSLTypeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSLContact(id string) (addon.SSLContactSingleResponse, *http.Response, error) {
+    return m.GetSSLContactWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSSLContactWithContext(ctx context.Context, id string) (addon.SSLContactSingleResponse, *http.Response, error) {
+    m.record("GetSSLContact", id)
+    if m.GetSSLContactFunc != nil {
+        return m.GetSSLContactFunc(ctx, id)
+    }
+    return addon.SSLContactSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteSSLContact(id string) (addon.EmptyResponse, *http.Response, error) {
+    return m.DeleteSSLContactWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteSSLContactWithContext(ctx context.Context, id string) (addon.EmptyResponse, *http.Response, error) {
+    m.record("DeleteSSLContact", id)
+    if m.DeleteSSLContactFunc != nil {
+        return m.DeleteSSLContactFunc(ctx, id)
+    }
+    return addon.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreatePleskLicense(in addon.PleskLicenseCreateRequest) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    return m.CreatePleskLicenseWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreatePleskLicenseWithContext(ctx context.Context, in addon.PleskLicenseCreateRequest) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    m.record("CreatePleskLicense", in)
+    if m.CreatePleskLicenseFunc != nil {
+        return m.CreatePleskLicenseFunc(ctx, in)
+    }
+    return addon.PleskLicenseSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetPleskLicenses(qParams addon.GetPleskLicensesQueryParams) (addon.PleskLicenseListResponse, *http.Response, error) {
+    return m.GetPleskLicensesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetPleskLicensesWithContext(ctx context.Context, qParams addon.GetPleskLicensesQueryParams) (addon.PleskLicenseListResponse, *http.Response, error) {
+    m.record("GetPleskLicenses", qParams)
+    if m.GetPleskLicensesFunc != nil {
+        return m.GetPleskLicensesFunc(ctx, qParams)
+    }
+    return addon.PleskLicenseListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllPleskLicenses(ctx context.Context, qParams addon.GetPleskLicensesQueryParams) *addon.PleskLicenseIterator {
+    return addon.NewPleskLicenseIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.PleskLicense, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetPleskLicensesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetSSLTypes(qParams addon.GetSSLTypesQueryParams) (addon.SSLTypeListResponse, *http.Response, error) {
+    return m.GetSSLTypesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSSLTypesWithContext(ctx context.Context, qParams addon.GetSSLTypesQueryParams) (addon.SSLTypeListResponse, *http.Response, error) {
+    m.record("GetSSLTypes", qParams)
+    if m.GetSSLTypesFunc != nil {
+        return m.GetSSLTypesFunc(ctx, qParams)
+    }
+    return addon.SSLTypeListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSSLTypes(ctx context.Context, qParams addon.GetSSLTypesQueryParams) *addon.SSLTypeIterator {
+    return addon.NewSSLTypeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]addon.SSLType, *addon.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSSLTypesWithContext(ctx, qParams)
+        if res.Data == nil {
+            return nil, res.Pagination, err
+        }
+        return *res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetPleskLicense(id string) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    return m.GetPleskLicenseWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetPleskLicenseWithContext(ctx context.Context, id string) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    m.record("GetPleskLicense", id)
+    if m.GetPleskLicenseFunc != nil {
+        return m.GetPleskLicenseFunc(ctx, id)
+    }
+    return addon.PleskLicenseSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdatePleskLicense(in addon.PleskLicenseUpdateRequest, id string) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    return m.UpdatePleskLicenseWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdatePleskLicenseWithContext(ctx context.Context, in addon.PleskLicenseUpdateRequest, id string) (addon.PleskLicenseSingleResponse, *http.Response, error) {
+    m.record("UpdatePleskLicense", in, id)
+    if m.UpdatePleskLicenseFunc != nil {
+        return m.UpdatePleskLicenseFunc(ctx, in, id)
+    }
+    return addon.PleskLicenseSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetPleskLicenseType(id string) (addon.PleskLicenseTypeSingleResponse, *http.Response, error) {
+    return m.GetPleskLicenseTypeWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetPleskLicenseTypeWithContext(ctx context.Context, id string) (addon.PleskLicenseTypeSingleResponse, *http.Response, error) {
+    m.record("GetPleskLicenseType", id)
+    if m.GetPleskLicenseTypeFunc != nil {
+        return m.GetPleskLicenseTypeFunc(ctx, id)
+    }
+    return addon.PleskLicenseTypeSingleResponse{}, nil, nil
+}
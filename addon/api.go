@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package addon
+
+//go:generate go run ../internal/cmd/apigen -client AddonClient
+
+import (
+    "context"
+    "net/http"
+)
+
+// API lists all methods of AddonClient, allowing it to be replaced by a mock.
+type API interface {
+    CreateSSLCertificate(in SSLCertificateCreateRequest) (SSLCertificateSingleResponse, *http.Response, error)
+    CreateSSLCertificateWithContext(ctx context.Context, in SSLCertificateCreateRequest) (SSLCertificateSingleResponse, *http.Response, error)
+    GetSSLCertificates(qParams GetSSLCertificatesQueryParams) (SSLCertificateListResponse, *http.Response, error)
+    GetSSLCertificatesWithContext(ctx context.Context, qParams GetSSLCertificatesQueryParams) (SSLCertificateListResponse, *http.Response, error)
+    ListAllSSLCertificates(ctx context.Context, qParams GetSSLCertificatesQueryParams) *SSLCertificateIterator
+    GetPleskLicenseTypes(qParams GetPleskLicenseTypesQueryParams) (PleskLicenseTypeListResponse, *http.Response, error)
+    GetPleskLicenseTypesWithContext(ctx context.Context, qParams GetPleskLicenseTypesQueryParams) (PleskLicenseTypeListResponse, *http.Response, error)
+    ListAllPleskLicenseTypes(ctx context.Context, qParams GetPleskLicenseTypesQueryParams) *PleskLicenseTypeIterator
+    Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    GetSSLCertificate(id string) (SSLCertificateSingleResponse, *http.Response, error)
+    GetSSLCertificateWithContext(ctx context.Context, id string) (SSLCertificateSingleResponse, *http.Response, error)
+    GetSSLOrganisation(id string) (SSLOrganisationSingleResponse, *http.Response, error)
+    GetSSLOrganisationWithContext(ctx context.Context, id string) (SSLOrganisationSingleResponse, *http.Response, error)
+    DeleteSSLOrganisation(id string) (EmptyResponse, *http.Response, error)
+    DeleteSSLOrganisationWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreateSSLContact(in SSLContactCreateRequest) (SSLContactSingleResponse, *http.Response, error)
+    CreateSSLContactWithContext(ctx context.Context, in SSLContactCreateRequest) (SSLContactSingleResponse, *http.Response, error)
+    GetSSLContacts(qParams GetSSLContactsQueryParams) (SSLContactListResponse, *http.Response, error)
+    GetSSLContactsWithContext(ctx context.Context, qParams GetSSLContactsQueryParams) (SSLContactListResponse, *http.Response, error)
+    ListAllSSLContacts(ctx context.Context, qParams GetSSLContactsQueryParams) *SSLContactIterator
+    CreateSSLOrganisation(in SSLOrganisationCreateRequest) (SSLOrganisationSingleResponse, *http.Response, error)
+    CreateSSLOrganisationWithContext(ctx context.Context, in SSLOrganisationCreateRequest) (SSLOrganisationSingleResponse, *http.Response, error)
+    GetSSLOrganisations(qParams GetSSLOrganisationsQueryParams) (SSLOrganisationListResponse, *http.Response, error)
+    GetSSLOrganisationsWithContext(ctx context.Context, qParams GetSSLOrganisationsQueryParams) (SSLOrganisationListResponse, *http.Response, error)
+    ListAllSSLOrganisations(ctx context.Context, qParams GetSSLOrganisationsQueryParams) *SSLOrganisationIterator
+    GetSSLType(id string) (SSLTypeSingleResponse, *http.Response, error)
+    GetSSLTypeWithContext(ctx context.Context, id string) (SSLTypeSingleResponse, *http.Response, error)
+    GetSSLContact(id string) (SSLContactSingleResponse, *http.Response, error)
+    GetSSLContactWithContext(ctx context.Context, id string) (SSLContactSingleResponse, *http.Response, error)
+    DeleteSSLContact(id string) (EmptyResponse, *http.Response, error)
+    DeleteSSLContactWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreatePleskLicense(in PleskLicenseCreateRequest) (PleskLicenseSingleResponse, *http.Response, error)
+    CreatePleskLicenseWithContext(ctx context.Context, in PleskLicenseCreateRequest) (PleskLicenseSingleResponse, *http.Response, error)
+    GetPleskLicenses(qParams GetPleskLicensesQueryParams) (PleskLicenseListResponse, *http.Response, error)
+    GetPleskLicensesWithContext(ctx context.Context, qParams GetPleskLicensesQueryParams) (PleskLicenseListResponse, *http.Response, error)
+    ListAllPleskLicenses(ctx context.Context, qParams GetPleskLicensesQueryParams) *PleskLicenseIterator
+    GetSSLTypes(qParams GetSSLTypesQueryParams) (SSLTypeListResponse, *http.Response, error)
+    GetSSLTypesWithContext(ctx context.Context, qParams GetSSLTypesQueryParams) (SSLTypeListResponse, *http.Response, error)
+    ListAllSSLTypes(ctx context.Context, qParams GetSSLTypesQueryParams) *SSLTypeIterator
+    GetPleskLicense(id string) (PleskLicenseSingleResponse, *http.Response, error)
+    GetPleskLicenseWithContext(ctx context.Context, id string) (PleskLicenseSingleResponse, *http.Response, error)
+    UpdatePleskLicense(in PleskLicenseUpdateRequest, id string) (PleskLicenseSingleResponse, *http.Response, error)
+    UpdatePleskLicenseWithContext(ctx context.Context, in PleskLicenseUpdateRequest, id string) (PleskLicenseSingleResponse, *http.Response, error)
+    GetPleskLicenseType(id string) (PleskLicenseTypeSingleResponse, *http.Response, error)
+    GetPleskLicenseTypeWithContext(ctx context.Context, id string) (PleskLicenseTypeSingleResponse, *http.Response, error)
+}
+
+var _ API = AddonClient{}
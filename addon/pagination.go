@@ -0,0 +1,389 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package addon
+
+import (
+    "context"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// SSLCertificateIterator iterates over the SSLCertificate items of all pages of a list.
+type SSLCertificateIterator struct {
+    pager *core.Pager
+    items []SSLCertificate
+    current SSLCertificate
+}
+
+// NewSSLCertificateIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSSLCertificateIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]SSLCertificate, *ResponsePagination, error)) *SSLCertificateIterator {
+    it := &SSLCertificateIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SSLCertificateIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SSLCertificateIterator) Value() SSLCertificate {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SSLCertificateIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SSLCertificateIterator) All() ([]SSLCertificate, error) {
+    all := []SSLCertificate{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// PleskLicenseTypeIterator iterates over the PleskLicenseType items of all pages of a list.
+type PleskLicenseTypeIterator struct {
+    pager *core.Pager
+    items []PleskLicenseType
+    current PleskLicenseType
+}
+
+// NewPleskLicenseTypeIterator returns an iterator over the items returned by fetch, starting at page.
+func NewPleskLicenseTypeIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]PleskLicenseType, *ResponsePagination, error)) *PleskLicenseTypeIterator {
+    it := &PleskLicenseTypeIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *PleskLicenseTypeIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *PleskLicenseTypeIterator) Value() PleskLicenseType {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *PleskLicenseTypeIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *PleskLicenseTypeIterator) All() ([]PleskLicenseType, error) {
+    all := []PleskLicenseType{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// SSLContactIterator iterates over the SSLContact items of all pages of a list.
+type SSLContactIterator struct {
+    pager *core.Pager
+    items []SSLContact
+    current SSLContact
+}
+
+// NewSSLContactIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSSLContactIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]SSLContact, *ResponsePagination, error)) *SSLContactIterator {
+    it := &SSLContactIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SSLContactIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SSLContactIterator) Value() SSLContact {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SSLContactIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SSLContactIterator) All() ([]SSLContact, error) {
+    all := []SSLContact{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// SSLOrganisationIterator iterates over the SSLOrganisation items of all pages of a list.
+type SSLOrganisationIterator struct {
+    pager *core.Pager
+    items []SSLOrganisation
+    current SSLOrganisation
+}
+
+// NewSSLOrganisationIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSSLOrganisationIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]SSLOrganisation, *ResponsePagination, error)) *SSLOrganisationIterator {
+    it := &SSLOrganisationIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SSLOrganisationIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SSLOrganisationIterator) Value() SSLOrganisation {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SSLOrganisationIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SSLOrganisationIterator) All() ([]SSLOrganisation, error) {
+    all := []SSLOrganisation{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// PleskLicenseIterator iterates over the PleskLicense items of all pages of a list.
+type PleskLicenseIterator struct {
+    pager *core.Pager
+    items []PleskLicense
+    current PleskLicense
+}
+
+// NewPleskLicenseIterator returns an iterator over the items returned by fetch, starting at page.
+func NewPleskLicenseIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]PleskLicense, *ResponsePagination, error)) *PleskLicenseIterator {
+    it := &PleskLicenseIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *PleskLicenseIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *PleskLicenseIterator) Value() PleskLicense {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *PleskLicenseIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *PleskLicenseIterator) All() ([]PleskLicense, error) {
+    all := []PleskLicense{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// SSLTypeIterator iterates over the SSLType items of all pages of a list.
+type SSLTypeIterator struct {
+    pager *core.Pager
+    items []SSLType
+    current SSLType
+}
+
+// NewSSLTypeIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSSLTypeIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]SSLType, *ResponsePagination, error)) *SSLTypeIterator {
+    it := &SSLTypeIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SSLTypeIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SSLTypeIterator) Value() SSLType {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SSLTypeIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SSLTypeIterator) All() ([]SSLType, error) {
+    all := []SSLType{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ListAllSSLCertificates iterates over the items of all pages of GetSSLCertificates.
+func (c AddonClient) ListAllSSLCertificates(ctx context.Context, qParams GetSSLCertificatesQueryParams) *SSLCertificateIterator {
+    return NewSSLCertificateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]SSLCertificate, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSSLCertificatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllPleskLicenseTypes iterates over the items of all pages of GetPleskLicenseTypes.
+func (c AddonClient) ListAllPleskLicenseTypes(ctx context.Context, qParams GetPleskLicenseTypesQueryParams) *PleskLicenseTypeIterator {
+    return NewPleskLicenseTypeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]PleskLicenseType, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetPleskLicenseTypesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllSSLContacts iterates over the items of all pages of GetSSLContacts.
+func (c AddonClient) ListAllSSLContacts(ctx context.Context, qParams GetSSLContactsQueryParams) *SSLContactIterator {
+    return NewSSLContactIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]SSLContact, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSSLContactsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllSSLOrganisations iterates over the items of all pages of GetSSLOrganisations.
+func (c AddonClient) ListAllSSLOrganisations(ctx context.Context, qParams GetSSLOrganisationsQueryParams) *SSLOrganisationIterator {
+    return NewSSLOrganisationIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]SSLOrganisation, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSSLOrganisationsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllPleskLicenses iterates over the items of all pages of GetPleskLicenses.
+func (c AddonClient) ListAllPleskLicenses(ctx context.Context, qParams GetPleskLicensesQueryParams) *PleskLicenseIterator {
+    return NewPleskLicenseIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]PleskLicense, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetPleskLicensesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllSSLTypes iterates over the items of all pages of GetSSLTypes.
+func (c AddonClient) ListAllSSLTypes(ctx context.Context, qParams GetSSLTypesQueryParams) *SSLTypeIterator {
+    return NewSSLTypeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]SSLType, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSSLTypesWithContext(ctx, qParams)
+        if res.Data == nil {
+            return nil, res.Pagination, err
+        }
+        return *res.Data, res.Pagination, err
+    })
+}
@@ -0,0 +1,92 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package auth
+
+//go:generate go run ../internal/cmd/apigen -client AuthClient
+
+import (
+    "context"
+    "net/http"
+)
+
+// API lists all methods of AuthClient, allowing it to be replaced by a mock.
+type API interface {
+    CreateProject(in ProjectCreateRequest) (ProjectSingleResponse, *http.Response, error)
+    CreateProjectWithContext(ctx context.Context, in ProjectCreateRequest) (ProjectSingleResponse, *http.Response, error)
+    GetProjects(qParams GetProjectsQueryParams) (ProjectListResponse, *http.Response, error)
+    GetProjectsWithContext(ctx context.Context, qParams GetProjectsQueryParams) (ProjectListResponse, *http.Response, error)
+    ListAllProjects(ctx context.Context, qParams GetProjectsQueryParams) *ProjectIterator
+    GetProject(id string, qParams GetProjectQueryParams) (ProjectSingleResponse, *http.Response, error)
+    GetProjectWithContext(ctx context.Context, id string, qParams GetProjectQueryParams) (ProjectSingleResponse, *http.Response, error)
+    DeleteProject(id string) (EmptyResponse, *http.Response, error)
+    DeleteProjectWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateProject(in ProjectUpdateRequest, id string) (ProjectSingleResponse, *http.Response, error)
+    UpdateProjectWithContext(ctx context.Context, in ProjectUpdateRequest, id string) (ProjectSingleResponse, *http.Response, error)
+    Login(in LoginRequest) (LoginResponse, *http.Response, error)
+    LoginWithContext(ctx context.Context, in LoginRequest) (LoginResponse, *http.Response, error)
+    CreateUser(in UserCreateRequest) (UserSingleResponse, *http.Response, error)
+    CreateUserWithContext(ctx context.Context, in UserCreateRequest) (UserSingleResponse, *http.Response, error)
+    GetUsers(qParams GetUsersQueryParams) (UserListResponse, *http.Response, error)
+    GetUsersWithContext(ctx context.Context, qParams GetUsersQueryParams) (UserListResponse, *http.Response, error)
+    ListAllUsers(ctx context.Context, qParams GetUsersQueryParams) *UserIterator
+    GetUser(id string) (UserSingleResponse, *http.Response, error)
+    GetUserWithContext(ctx context.Context, id string) (UserSingleResponse, *http.Response, error)
+    UpdateUser(in UserUpdateRequest, id string) (UserSingleResponse, *http.Response, error)
+    UpdateUserWithContext(ctx context.Context, in UserUpdateRequest, id string) (UserSingleResponse, *http.Response, error)
+    RequestPasswordReset(in RequestPasswordResetRequest) (EmptyResponse, *http.Response, error)
+    RequestPasswordResetWithContext(ctx context.Context, in RequestPasswordResetRequest) (EmptyResponse, *http.Response, error)
+    ExecutePasswordReset(in ExecutePasswordResetRequest) (EmptyResponse, *http.Response, error)
+    ExecutePasswordResetWithContext(ctx context.Context, in ExecutePasswordResetRequest) (EmptyResponse, *http.Response, error)
+    ChangeEmail(in EmailChangeRequest) (EmptyResponse, *http.Response, error)
+    ChangeEmailWithContext(ctx context.Context, in EmailChangeRequest) (EmptyResponse, *http.Response, error)
+    RejectProjectInvite(id string) (EmptyResponse, *http.Response, error)
+    RejectProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    InsertAuditLogEntry(in AuditLogRequest) (EmptyResponse, *http.Response, error)
+    InsertAuditLogEntryWithContext(ctx context.Context, in AuditLogRequest) (EmptyResponse, *http.Response, error)
+    SearchAuditLog(qParams SearchAuditLogQueryParams) (AuditLogEntryListResponse, *http.Response, error)
+    SearchAuditLogWithContext(ctx context.Context, qParams SearchAuditLogQueryParams) (AuditLogEntryListResponse, *http.Response, error)
+    SearchAllAuditLog(ctx context.Context, qParams SearchAuditLogQueryParams) *AuditLogEntryIterator
+    CreateToken(in TokenCreateRequest) (TokenSingleResponse, *http.Response, error)
+    CreateTokenWithContext(ctx context.Context, in TokenCreateRequest) (TokenSingleResponse, *http.Response, error)
+    GetTokens(qParams GetTokensQueryParams) (TokenListResponse, *http.Response, error)
+    GetTokensWithContext(ctx context.Context, qParams GetTokensQueryParams) (TokenListResponse, *http.Response, error)
+    ListAllTokens(ctx context.Context, qParams GetTokensQueryParams) *TokenIterator
+    GetCountry(code string) (CountrySingleResponse, *http.Response, error)
+    GetCountryWithContext(ctx context.Context, code string) (CountrySingleResponse, *http.Response, error)
+    ChangePassword(in PasswordChangeRequest) (EmptyResponse, *http.Response, error)
+    ChangePasswordWithContext(ctx context.Context, in PasswordChangeRequest) (EmptyResponse, *http.Response, error)
+    GetToken(id string) (TokenSingleResponse, *http.Response, error)
+    GetTokenWithContext(ctx context.Context, id string) (TokenSingleResponse, *http.Response, error)
+    DeleteToken(id string) (EmptyResponse, *http.Response, error)
+    DeleteTokenWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    DeleteProjectInvite(id string) (EmptyResponse, *http.Response, error)
+    DeleteProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    ValidateToken(token string) (TokenValidationResponse, *http.Response, error)
+    ValidateTokenWithContext(ctx context.Context, token string) (TokenValidationResponse, *http.Response, error)
+    CreateProjectInvite(in ProjectInviteCreateRequest) (ProjectInviteSingleResponse, *http.Response, error)
+    CreateProjectInviteWithContext(ctx context.Context, in ProjectInviteCreateRequest) (ProjectInviteSingleResponse, *http.Response, error)
+    GetProjectInvites(qParams GetProjectInvitesQueryParams) (ProjectInviteListResponse, *http.Response, error)
+    GetProjectInvitesWithContext(ctx context.Context, qParams GetProjectInvitesQueryParams) (ProjectInviteListResponse, *http.Response, error)
+    ListAllProjectInvites(ctx context.Context, qParams GetProjectInvitesQueryParams) *ProjectInviteIterator
+    AddProjectMember(in ProjectMemberCreateRequest, id string) (ProjectMemberSingleResponse, *http.Response, error)
+    AddProjectMemberWithContext(ctx context.Context, in ProjectMemberCreateRequest, id string) (ProjectMemberSingleResponse, *http.Response, error)
+    GetProjectMembers(id string, qParams GetProjectMembersQueryParams) (ProjectMemberListResponse, *http.Response, error)
+    GetProjectMembersWithContext(ctx context.Context, id string, qParams GetProjectMembersQueryParams) (ProjectMemberListResponse, *http.Response, error)
+    ListAllProjectMembers(ctx context.Context, id string, qParams GetProjectMembersQueryParams) *ProjectMemberIterator
+    SearchTransactionLog(in TransactionLogRequest) (TransactionLogResponse, *http.Response, error)
+    SearchTransactionLogWithContext(ctx context.Context, in TransactionLogRequest) (TransactionLogResponse, *http.Response, error)
+    ValidateSelf() (TokenValidationResponse, *http.Response, error)
+    ValidateSelfWithContext(ctx context.Context) (TokenValidationResponse, *http.Response, error)
+    AcceptProjectInvite(id string) (EmptyResponse, *http.Response, error)
+    AcceptProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    RemoveProjectMember(id string, user_id string) (EmptyResponse, *http.Response, error)
+    RemoveProjectMemberWithContext(ctx context.Context, id string, user_id string) (EmptyResponse, *http.Response, error)
+    GetUserProjectMemberships(id string, qParams GetUserProjectMembershipsQueryParams) (ProjectMemberListResponse, *http.Response, error)
+    GetUserProjectMembershipsWithContext(ctx context.Context, id string, qParams GetUserProjectMembershipsQueryParams) (ProjectMemberListResponse, *http.Response, error)
+    ListAllUserProjectMemberships(ctx context.Context, id string, qParams GetUserProjectMembershipsQueryParams) *ProjectMemberIterator
+    GetCountries(qParams GetCountriesQueryParams) (CountryListResponse, *http.Response, error)
+    GetCountriesWithContext(ctx context.Context, qParams GetCountriesQueryParams) (CountryListResponse, *http.Response, error)
+    ListAllCountries(ctx context.Context, qParams GetCountriesQueryParams) *CountryIterator
+}
+
+var _ API = AuthClient{}
@@ -1,23 +1,14 @@
 package auth
 
 import (
-    "bytes"
-    "io/ioutil"
+    "context"
     "net/http"
-    "time"
-    "encoding/json"
-    "io"
-    "errors"
-    "strconv"
-    "github.com/google/go-querystring/query"
     "reflect"
+    "github.com/lumaserv/lumaserv-api-go/core"
 )
 
 type AuthClient struct {
-    baseUrl string
-    apiKey  string
-    client  *http.Client
-    currentProject string
+    *core.Client
 }
 
 func NewClient (apiKey string) AuthClient {
@@ -30,92 +21,18 @@ func NewClientWithUrl (apiKey string, baseUrl string) AuthClient {
     }
 
     return AuthClient {
-        apiKey: apiKey,
-        baseUrl: baseUrl,
+        Client: core.NewClient(apiKey, baseUrl),
     }
 }
 
-func (c *AuthClient) SetCurrentProject (project string) {
-    c.currentProject = project
-}
-
-func (c *AuthClient) GetCurrentProject () string {
-    return c.currentProject
-}
-
-func (c *AuthClient) SetHttpClient(client *http.Client) {
-    c.client = client
-}
+type ResponseMessages = core.ResponseMessages
 
-func (c *AuthClient) SetAccessToken(token string) {
-    c.apiKey = token
-}
+type ResponseMessage = core.ResponseMessage
 
-func (c *AuthClient) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
-    if c.client == nil {
-        c.client = &http.Client{
-            Timeout: time.Second * 5,
-        }
-    }
+type ResponsePagination = core.ResponsePagination
 
-    req, err := http.NewRequest(method, c.baseUrl+path, postBody)
-    if err != nil {
-        return nil, nil, err
-    }
-
-    req.Header.Add("Authorization", "Bearer "+c.apiKey)
-    req.Header.Add("User-Agent", "LUMASERV-go-client")
-    req.Header.Add("Accept", "application/json")
-    res, err := c.client.Do(req)
-    if err != nil {
-        return res, nil, err
-    }
-
-    if res.Body != nil {
-        defer res.Body.Close()
-    }
-
-    body, err := ioutil.ReadAll(res.Body)
-
-    return res, body, err
-}
+type ResponseMetadata = core.ResponseMetadata
 
-func (c AuthClient) toStr(in interface{}) string {
-    switch in.(type) {
-        case string:
-            return in.(string)
-        case int:
-            return strconv.Itoa(in.(int))
-    }
-
-    panic("Unhandled type in toStr")
-}
-
-func (c AuthClient) applyCurrentProject (v reflect.Value) {
-    if len(c.currentProject) > 0 {
-        if v.Kind() == reflect.Ptr {
-            x := v.Elem()
-            f := x.FieldByName("ProjectId")
-            if f.IsValid() && f.CanSet() {
-                if f.Kind() == reflect.String && len(f.String()) == 0 {
-                    f.SetString(c.currentProject)
-                } else if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String {
-                    f.Set(reflect.ValueOf(&c.currentProject))
-                }
-            }
-
-            for i := 0; i < x.NumField(); i++ {
-                field := x.Field(i)
-                if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct || field.Kind() == reflect.Struct {
-                    if field.IsNil() && field.CanSet() {
-                        field.Set(reflect.New(field.Type().Elem()))
-                    }
-                    c.applyCurrentProject(field)
-                }
-            }
-        }
-    }
-}
 type User struct {
     Gender *Gender `json:"gender"`
     LastName string `json:"last_name"`
@@ -129,6 +46,25 @@ type User struct {
 
 type UserState string
 
+const (
+    UserStateActive UserState = "ACTIVE"
+    UserStatePending UserState = "PENDING"
+    UserStateLocked UserState = "LOCKED"
+    UserStateDeleted UserState = "DELETED"
+)
+
+func (e UserState) String() string {
+    return string(e)
+}
+
+func (e UserState) IsValid() bool {
+    switch e {
+        case UserStateActive, UserStatePending, UserStateLocked, UserStateDeleted:
+            return true
+    }
+    return false
+}
+
 type Token struct {
     UserId string `json:"user_id"`
     Scope TokenScope `json:"scope"`
@@ -138,12 +74,25 @@ type Token struct {
     Token *string `json:"token"`
 }
 
-type ResponseMessage struct {
-    Message string `json:"message"`
-    Key string `json:"key"`
+type Gender string
+
+const (
+    GenderMale Gender = "MALE"
+    GenderFemale Gender = "FEMALE"
+    GenderDiverse Gender = "DIVERSE"
+)
+
+func (e Gender) String() string {
+    return string(e)
 }
 
-type Gender string
+func (e Gender) IsValid() bool {
+    switch e {
+        case GenderMale, GenderFemale, GenderDiverse:
+            return true
+    }
+    return false
+}
 
 type Project struct {
     CreatedAt *string `json:"created_at"`
@@ -153,6 +102,39 @@ type Project struct {
 
 type ObjectType string
 
+const (
+    ObjectTypeServer ObjectType = "SERVER"
+    ObjectTypeServerVolume ObjectType = "SERVER_VOLUME"
+    ObjectTypeServerMedia ObjectType = "SERVER_MEDIA"
+    ObjectTypeServerBackup ObjectType = "SERVER_BACKUP"
+    ObjectTypeServerFirewall ObjectType = "SERVER_FIREWALL"
+    ObjectTypeSSHKey ObjectType = "SSH_KEY"
+    ObjectTypeNetwork ObjectType = "NETWORK"
+    ObjectTypeSubnet ObjectType = "SUBNET"
+    ObjectTypeAddress ObjectType = "ADDRESS"
+    ObjectTypeS3Bucket ObjectType = "S3_BUCKET"
+    ObjectTypeS3AccessKey ObjectType = "S3_ACCESS_KEY"
+    ObjectTypeDNSZone ObjectType = "DNS_ZONE"
+    ObjectTypeDomain ObjectType = "DOMAIN"
+    ObjectTypeDomainHandle ObjectType = "DOMAIN_HANDLE"
+    ObjectTypeSSLCertificate ObjectType = "SSL_CERTIFICATE"
+    ObjectTypeSSLContact ObjectType = "SSL_CONTACT"
+    ObjectTypeSSLOrganisation ObjectType = "SSL_ORGANISATION"
+    ObjectTypePleskLicense ObjectType = "PLESK_LICENSE"
+)
+
+func (e ObjectType) String() string {
+    return string(e)
+}
+
+func (e ObjectType) IsValid() bool {
+    switch e {
+        case ObjectTypeServer, ObjectTypeServerVolume, ObjectTypeServerMedia, ObjectTypeServerBackup, ObjectTypeServerFirewall, ObjectTypeSSHKey, ObjectTypeNetwork, ObjectTypeSubnet, ObjectTypeAddress, ObjectTypeS3Bucket, ObjectTypeS3AccessKey, ObjectTypeDNSZone, ObjectTypeDomain, ObjectTypeDomainHandle, ObjectTypeSSLCertificate, ObjectTypeSSLContact, ObjectTypeSSLOrganisation, ObjectTypePleskLicense:
+            return true
+    }
+    return false
+}
+
 type TokenValidationInfo struct {
     ProjectMemberships []ProjectMember `json:"project_memberships"`
     User User `json:"user"`
@@ -172,12 +154,6 @@ type AuditLogEntry struct {
     ObjectId *string `json:"object_id"`
 }
 
-type ResponsePagination struct {
-    Total int `json:"total"`
-    Page int `json:"page"`
-    PageSize int `json:"page_size"`
-}
-
 type TokenScope struct {
     ProjectId *string `json:"project_id"`
 }
@@ -187,12 +163,6 @@ type Country struct {
     Title string `json:"title"`
 }
 
-type ResponseMessages struct {
-    Warnings []ResponseMessage `json:"warnings"`
-    Errors []ResponseMessage `json:"errors"`
-    Infos []ResponseMessage `json:"infos"`
-}
-
 type ProjectInvite struct {
     ValidUntil string `json:"valid_until"`
     ProjectId string `json:"project_id"`
@@ -210,10 +180,22 @@ type ProjectMember struct {
 
 type UserType string
 
-type ResponseMetadata struct {
-    TransactionId string `json:"transaction_id"`
-    BuildCommit string `json:"build_commit"`
-    BuildTimestamp string `json:"build_timestamp"`
+const (
+    UserTypeCustomer UserType = "CUSTOMER"
+    UserTypeAdmin UserType = "ADMIN"
+    UserTypeService UserType = "SERVICE"
+)
+
+func (e UserType) String() string {
+    return string(e)
+}
+
+func (e UserType) IsValid() bool {
+    switch e {
+        case UserTypeCustomer, UserTypeAdmin, UserTypeService:
+            return true
+    }
+    return false
 }
 
 type TokenListResponse struct {
@@ -436,21 +418,13 @@ type AuditLogRequest struct {
 }
 
 func (c AuthClient) CreateProject(in ProjectCreateRequest) (ProjectSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateProjectWithContext(context.Background(), in)
+}
+
+func (c AuthClient) CreateProjectWithContext(ctx context.Context, in ProjectCreateRequest) (ProjectSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ProjectSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/projects", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/projects", nil, in, &body)
     return body, res, err
 }
 
@@ -470,24 +444,13 @@ type GetProjectsQueryParams struct {
 }
 
 func (c AuthClient) GetProjects(qParams GetProjectsQueryParams) (ProjectListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetProjectsWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) GetProjectsWithContext(ctx context.Context, qParams GetProjectsQueryParams) (ProjectListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ProjectListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/projects"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/projects", qParams, nil, &body)
     return body, res, err
 }
 
@@ -496,98 +459,56 @@ type GetProjectQueryParams struct {
 }
 
 func (c AuthClient) GetProject(id string, qParams GetProjectQueryParams) (ProjectSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetProjectWithContext(context.Background(), id, qParams)
+}
+
+func (c AuthClient) GetProjectWithContext(ctx context.Context, id string, qParams GetProjectQueryParams) (ProjectSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ProjectSingleResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/projects/"+c.toStr(id)+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/projects/"+core.ToStr(id), qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) DeleteProject(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteProjectWithContext(context.Background(), id)
+}
+
+func (c AuthClient) DeleteProjectWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/projects/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/projects/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) UpdateProject(in ProjectUpdateRequest, id string) (ProjectSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateProjectWithContext(context.Background(), in, id)
+}
+
+func (c AuthClient) UpdateProjectWithContext(ctx context.Context, in ProjectUpdateRequest, id string) (ProjectSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ProjectSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/projects/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/projects/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) Login(in LoginRequest) (LoginResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.LoginWithContext(context.Background(), in)
+}
+
+func (c AuthClient) LoginWithContext(ctx context.Context, in LoginRequest) (LoginResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := LoginResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/login", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/login", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) CreateUser(in UserCreateRequest) (UserSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateUserWithContext(context.Background(), in)
+}
+
+func (c AuthClient) CreateUserWithContext(ctx context.Context, in UserCreateRequest) (UserSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := UserSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/users", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/users", nil, in, &body)
     return body, res, err
 }
 
@@ -609,153 +530,88 @@ type GetUsersQueryParams struct {
 }
 
 func (c AuthClient) GetUsers(qParams GetUsersQueryParams) (UserListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetUsersWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) GetUsersWithContext(ctx context.Context, qParams GetUsersQueryParams) (UserListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := UserListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/users"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/users", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) GetUser(id string) (UserSingleResponse, *http.Response, error) {
+    return c.GetUserWithContext(context.Background(), id)
+}
+
+func (c AuthClient) GetUserWithContext(ctx context.Context, id string) (UserSingleResponse, *http.Response, error) {
     body := UserSingleResponse{}
-    res, j, err := c.Request("GET", "/users/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/users/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) UpdateUser(in UserUpdateRequest, id string) (UserSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateUserWithContext(context.Background(), in, id)
+}
+
+func (c AuthClient) UpdateUserWithContext(ctx context.Context, in UserUpdateRequest, id string) (UserSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := UserSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/users/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/users/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) RequestPasswordReset(in RequestPasswordResetRequest) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.RequestPasswordResetWithContext(context.Background(), in)
+}
+
+func (c AuthClient) RequestPasswordResetWithContext(ctx context.Context, in RequestPasswordResetRequest) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/password-reset", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/password-reset", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) ExecutePasswordReset(in ExecutePasswordResetRequest) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ExecutePasswordResetWithContext(context.Background(), in)
+}
+
+func (c AuthClient) ExecutePasswordResetWithContext(ctx context.Context, in ExecutePasswordResetRequest) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/password-reset", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/password-reset", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) ChangeEmail(in EmailChangeRequest) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ChangeEmailWithContext(context.Background(), in)
+}
+
+func (c AuthClient) ChangeEmailWithContext(ctx context.Context, in EmailChangeRequest) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/email-change", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/email-change", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) RejectProjectInvite(id string) (EmptyResponse, *http.Response, error) {
+    return c.RejectProjectInviteWithContext(context.Background(), id)
+}
+
+func (c AuthClient) RejectProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/project-invites/"+c.toStr(id)+"/reject", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/project-invites/"+core.ToStr(id)+"/reject", nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) InsertAuditLogEntry(in AuditLogRequest) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.InsertAuditLogEntryWithContext(context.Background(), in)
+}
+
+func (c AuthClient) InsertAuditLogEntryWithContext(ctx context.Context, in AuditLogRequest) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/audit-log", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/audit-log", nil, in, &body)
     return body, res, err
 }
 
@@ -769,43 +625,24 @@ type SearchAuditLogQueryParams struct {
 }
 
 func (c AuthClient) SearchAuditLog(qParams SearchAuditLogQueryParams) (AuditLogEntryListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.SearchAuditLogWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) SearchAuditLogWithContext(ctx context.Context, qParams SearchAuditLogQueryParams) (AuditLogEntryListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := AuditLogEntryListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/audit-log"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/audit-log", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) CreateToken(in TokenCreateRequest) (TokenSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateTokenWithContext(context.Background(), in)
+}
+
+func (c AuthClient) CreateTokenWithContext(ctx context.Context, in TokenCreateRequest) (TokenSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := TokenSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/tokens", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/tokens", nil, in, &body)
     return body, res, err
 }
 
@@ -826,147 +663,85 @@ type GetTokensQueryParams struct {
 }
 
 func (c AuthClient) GetTokens(qParams GetTokensQueryParams) (TokenListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetTokensWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) GetTokensWithContext(ctx context.Context, qParams GetTokensQueryParams) (TokenListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := TokenListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/tokens"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/tokens", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) GetCountry(code string) (CountrySingleResponse, *http.Response, error) {
+    return c.GetCountryWithContext(context.Background(), code)
+}
+
+func (c AuthClient) GetCountryWithContext(ctx context.Context, code string) (CountrySingleResponse, *http.Response, error) {
     body := CountrySingleResponse{}
-    res, j, err := c.Request("GET", "/countries/"+c.toStr(code), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/countries/"+core.ToStr(code), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) ChangePassword(in PasswordChangeRequest) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ChangePasswordWithContext(context.Background(), in)
+}
+
+func (c AuthClient) ChangePasswordWithContext(ctx context.Context, in PasswordChangeRequest) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/password-change", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/password-change", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) GetToken(id string) (TokenSingleResponse, *http.Response, error) {
+    return c.GetTokenWithContext(context.Background(), id)
+}
+
+func (c AuthClient) GetTokenWithContext(ctx context.Context, id string) (TokenSingleResponse, *http.Response, error) {
     body := TokenSingleResponse{}
-    res, j, err := c.Request("GET", "/tokens/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/tokens/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) DeleteToken(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteTokenWithContext(context.Background(), id)
+}
+
+func (c AuthClient) DeleteTokenWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/tokens/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/tokens/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) DeleteProjectInvite(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteProjectInviteWithContext(context.Background(), id)
+}
+
+func (c AuthClient) DeleteProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/project-invites/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/project-invites/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) ValidateToken(token string) (TokenValidationResponse, *http.Response, error) {
+    return c.ValidateTokenWithContext(context.Background(), token)
+}
+
+func (c AuthClient) ValidateTokenWithContext(ctx context.Context, token string) (TokenValidationResponse, *http.Response, error) {
     body := TokenValidationResponse{}
-    res, j, err := c.Request("GET", "/validate/"+c.toStr(token), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/validate/"+core.ToStr(token), nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) CreateProjectInvite(in ProjectInviteCreateRequest) (ProjectInviteSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateProjectInviteWithContext(context.Background(), in)
+}
+
+func (c AuthClient) CreateProjectInviteWithContext(ctx context.Context, in ProjectInviteCreateRequest) (ProjectInviteSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ProjectInviteSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/project-invites", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/project-invites", nil, in, &body)
     return body, res, err
 }
 
@@ -986,43 +761,24 @@ type GetProjectInvitesQueryParams struct {
 }
 
 func (c AuthClient) GetProjectInvites(qParams GetProjectInvitesQueryParams) (ProjectInviteListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetProjectInvitesWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) GetProjectInvitesWithContext(ctx context.Context, qParams GetProjectInvitesQueryParams) (ProjectInviteListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ProjectInviteListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/project-invites"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/project-invites", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) AddProjectMember(in ProjectMemberCreateRequest, id string) (ProjectMemberSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.AddProjectMemberWithContext(context.Background(), in, id)
+}
+
+func (c AuthClient) AddProjectMemberWithContext(ctx context.Context, in ProjectMemberCreateRequest, id string) (ProjectMemberSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ProjectMemberSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/projects/"+c.toStr(id)+"/members", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/projects/"+core.ToStr(id)+"/members", nil, in, &body)
     return body, res, err
 }
 
@@ -1040,94 +796,54 @@ type GetProjectMembersQueryParams struct {
 }
 
 func (c AuthClient) GetProjectMembers(id string, qParams GetProjectMembersQueryParams) (ProjectMemberListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetProjectMembersWithContext(context.Background(), id, qParams)
+}
+
+func (c AuthClient) GetProjectMembersWithContext(ctx context.Context, id string, qParams GetProjectMembersQueryParams) (ProjectMemberListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ProjectMemberListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/projects/"+c.toStr(id)+"/members"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/projects/"+core.ToStr(id)+"/members", qParams, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) SearchTransactionLog(in TransactionLogRequest) (TransactionLogResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.SearchTransactionLogWithContext(context.Background(), in)
+}
+
+func (c AuthClient) SearchTransactionLogWithContext(ctx context.Context, in TransactionLogRequest) (TransactionLogResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := TransactionLogResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/transaction-log", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/transaction-log", nil, in, &body)
     return body, res, err
 }
 
 func (c AuthClient) ValidateSelf() (TokenValidationResponse, *http.Response, error) {
+    return c.ValidateSelfWithContext(context.Background())
+}
+
+func (c AuthClient) ValidateSelfWithContext(ctx context.Context) (TokenValidationResponse, *http.Response, error) {
     body := TokenValidationResponse{}
-    res, j, err := c.Request("GET", "/validate/self", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/validate/self", nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) AcceptProjectInvite(id string) (EmptyResponse, *http.Response, error) {
+    return c.AcceptProjectInviteWithContext(context.Background(), id)
+}
+
+func (c AuthClient) AcceptProjectInviteWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/project-invites/"+c.toStr(id)+"/accept", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/project-invites/"+core.ToStr(id)+"/accept", nil, nil, &body)
     return body, res, err
 }
 
 func (c AuthClient) RemoveProjectMember(id string, user_id string) (EmptyResponse, *http.Response, error) {
+    return c.RemoveProjectMemberWithContext(context.Background(), id, user_id)
+}
+
+func (c AuthClient) RemoveProjectMemberWithContext(ctx context.Context, id string, user_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/projects/"+c.toStr(id)+"/members/"+c.toStr(user_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/projects/"+core.ToStr(id)+"/members/"+core.ToStr(user_id), nil, nil, &body)
     return body, res, err
 }
 
@@ -1140,24 +856,13 @@ type GetUserProjectMembershipsQueryParams struct {
 }
 
 func (c AuthClient) GetUserProjectMemberships(id string, qParams GetUserProjectMembershipsQueryParams) (ProjectMemberListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetUserProjectMembershipsWithContext(context.Background(), id, qParams)
+}
+
+func (c AuthClient) GetUserProjectMembershipsWithContext(ctx context.Context, id string, qParams GetUserProjectMembershipsQueryParams) (ProjectMemberListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ProjectMemberListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/users/"+c.toStr(id)+"/project_memberships"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/users/"+core.ToStr(id)+"/project_memberships", qParams, nil, &body)
     return body, res, err
 }
 
@@ -1170,24 +875,13 @@ type GetCountriesQueryParams struct {
 }
 
 func (c AuthClient) GetCountries(qParams GetCountriesQueryParams) (CountryListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetCountriesWithContext(context.Background(), qParams)
+}
+
+func (c AuthClient) GetCountriesWithContext(ctx context.Context, qParams GetCountriesQueryParams) (CountryListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := CountryListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/countries"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/countries", qParams, nil, &body)
     return body, res, err
 }
 
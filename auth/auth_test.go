@@ -0,0 +1,40 @@
+package auth_test
+
+import (
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestProjects(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Token = "token"
+    c := auth.NewClientWithUrl("token", s.ServiceUrl("auth"))
+
+    created, _, err := c.CreateProject(auth.ProjectCreateRequest{Title: "Default"})
+    if err != nil {
+        t.Fatal(err)
+    }
+    title := "Production"
+    if _, _, err := c.UpdateProject(auth.ProjectUpdateRequest{Title: &title}, created.Data.Id); err != nil {
+        t.Fatal(err)
+    }
+    got, _, err := c.GetProject(created.Data.Id, auth.GetProjectQueryParams{})
+    if err != nil || got.Data.Title != title {
+        t.Fatalf("got %+v, %v", got.Data, err)
+    }
+    if _, _, err := c.DeleteProject(created.Data.Id); err != nil {
+        t.Fatal(err)
+    }
+    list, _, err := c.GetProjects(auth.GetProjectsQueryParams{})
+    if err != nil || len(list.Data) != 0 {
+        t.Errorf("got %+v, %v after delete", list.Data, err)
+    }
+
+    _, _, err = auth.NewClientWithUrl("wrong", s.ServiceUrl("auth")).GetProjects(auth.GetProjectsQueryParams{})
+    if apiErr, ok := core.AsAPIError(err); !ok || apiErr.StatusCode != 401 {
+        t.Errorf("got %v, want an unauthorized error", err)
+    }
+}
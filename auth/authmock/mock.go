@@ -0,0 +1,565 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package authmock
+
+import (
+    "context"
+    "net/http"
+    "sync"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+)
+
+// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements auth.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+    CreateProjectFunc func(ctx context.Context, in auth.ProjectCreateRequest) (auth.ProjectSingleResponse, *http.Response, error)
+    GetProjectsFunc func(ctx context.Context, qParams auth.GetProjectsQueryParams) (auth.ProjectListResponse, *http.Response, error)
+    GetProjectFunc func(ctx context.Context, id string, qParams auth.GetProjectQueryParams) (auth.ProjectSingleResponse, *http.Response, error)
+    DeleteProjectFunc func(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error)
+    UpdateProjectFunc func(ctx context.Context, in auth.ProjectUpdateRequest, id string) (auth.ProjectSingleResponse, *http.Response, error)
+    LoginFunc func(ctx context.Context, in auth.LoginRequest) (auth.LoginResponse, *http.Response, error)
+    CreateUserFunc func(ctx context.Context, in auth.UserCreateRequest) (auth.UserSingleResponse, *http.Response, error)
+    GetUsersFunc func(ctx context.Context, qParams auth.GetUsersQueryParams) (auth.UserListResponse, *http.Response, error)
+    GetUserFunc func(ctx context.Context, id string) (auth.UserSingleResponse, *http.Response, error)
+    UpdateUserFunc func(ctx context.Context, in auth.UserUpdateRequest, id string) (auth.UserSingleResponse, *http.Response, error)
+    RequestPasswordResetFunc func(ctx context.Context, in auth.RequestPasswordResetRequest) (auth.EmptyResponse, *http.Response, error)
+    ExecutePasswordResetFunc func(ctx context.Context, in auth.ExecutePasswordResetRequest) (auth.EmptyResponse, *http.Response, error)
+    ChangeEmailFunc func(ctx context.Context, in auth.EmailChangeRequest) (auth.EmptyResponse, *http.Response, error)
+    RejectProjectInviteFunc func(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error)
+    InsertAuditLogEntryFunc func(ctx context.Context, in auth.AuditLogRequest) (auth.EmptyResponse, *http.Response, error)
+    SearchAuditLogFunc func(ctx context.Context, qParams auth.SearchAuditLogQueryParams) (auth.AuditLogEntryListResponse, *http.Response, error)
+    CreateTokenFunc func(ctx context.Context, in auth.TokenCreateRequest) (auth.TokenSingleResponse, *http.Response, error)
+    GetTokensFunc func(ctx context.Context, qParams auth.GetTokensQueryParams) (auth.TokenListResponse, *http.Response, error)
+    GetCountryFunc func(ctx context.Context, code string) (auth.CountrySingleResponse, *http.Response, error)
+    ChangePasswordFunc func(ctx context.Context, in auth.PasswordChangeRequest) (auth.EmptyResponse, *http.Response, error)
+    GetTokenFunc func(ctx context.Context, id string) (auth.TokenSingleResponse, *http.Response, error)
+    DeleteTokenFunc func(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error)
+    DeleteProjectInviteFunc func(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error)
+    ValidateTokenFunc func(ctx context.Context, token string) (auth.TokenValidationResponse, *http.Response, error)
+    CreateProjectInviteFunc func(ctx context.Context, in auth.ProjectInviteCreateRequest) (auth.ProjectInviteSingleResponse, *http.Response, error)
+    GetProjectInvitesFunc func(ctx context.Context, qParams auth.GetProjectInvitesQueryParams) (auth.ProjectInviteListResponse, *http.Response, error)
+    AddProjectMemberFunc func(ctx context.Context, in auth.ProjectMemberCreateRequest, id string) (auth.ProjectMemberSingleResponse, *http.Response, error)
+    GetProjectMembersFunc func(ctx context.Context, id string, qParams auth.GetProjectMembersQueryParams) (auth.ProjectMemberListResponse, *http.Response, error)
+    SearchTransactionLogFunc func(ctx context.Context, in auth.TransactionLogRequest) (auth.TransactionLogResponse, *http.Response, error)
+    ValidateSelfFunc func(ctx context.Context) (auth.TokenValidationResponse, *http.Response, error)
+    AcceptProjectInviteFunc func(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error)
+    RemoveProjectMemberFunc func(ctx context.Context, id string, user_id string) (auth.EmptyResponse, *http.Response, error)
+    GetUserProjectMembershipsFunc func(ctx context.Context, id string, qParams auth.GetUserProjectMembershipsQueryParams) (auth.ProjectMemberListResponse, *http.Response, error)
+    GetCountriesFunc func(ctx context.Context, qParams auth.GetCountriesQueryParams) (auth.CountryListResponse, *http.Response, error)
+}
+
+var _ auth.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+
+func (m *Mock) CreateProject(in auth.ProjectCreateRequest) (auth.ProjectSingleResponse, *http.Response, error) {
+    return m.CreateProjectWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateProjectWithContext(ctx context.Context, in auth.ProjectCreateRequest) (auth.ProjectSingleResponse, *http.Response, error) {
+    m.record("CreateProject", in)
+    if m.CreateProjectFunc != nil {
+        return m.CreateProjectFunc(ctx, in)
+    }
+    return auth.ProjectSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetProjects(qParams auth.GetProjectsQueryParams) (auth.ProjectListResponse, *http.Response, error) {
+    return m.GetProjectsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetProjectsWithContext(ctx context.Context, qParams auth.GetProjectsQueryParams) (auth.ProjectListResponse, *http.Response, error) {
+    m.record("GetProjects", qParams)
+    if m.GetProjectsFunc != nil {
+        return m.GetProjectsFunc(ctx, qParams)
+    }
+    return auth.ProjectListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllProjects(ctx context.Context, qParams auth.GetProjectsQueryParams) *auth.ProjectIterator {
+    return auth.NewProjectIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.Project, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetProjectsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetProject(id string, qParams auth.GetProjectQueryParams) (auth.ProjectSingleResponse, *http.Response, error) {
+    return m.GetProjectWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetProjectWithContext(ctx context.Context, id string, qParams auth.GetProjectQueryParams) (auth.ProjectSingleResponse, *http.Response, error) {
+    m.record("GetProject", id, qParams)
+    if m.GetProjectFunc != nil {
+        return m.GetProjectFunc(ctx, id, qParams)
+    }
+    return auth.ProjectSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteProject(id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.DeleteProjectWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteProjectWithContext(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("DeleteProject", id)
+    if m.DeleteProjectFunc != nil {
+        return m.DeleteProjectFunc(ctx, id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateProject(in auth.ProjectUpdateRequest, id string) (auth.ProjectSingleResponse, *http.Response, error) {
+    return m.UpdateProjectWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateProjectWithContext(ctx context.Context, in auth.ProjectUpdateRequest, id string) (auth.ProjectSingleResponse, *http.Response, error) {
+    m.record("UpdateProject", in, id)
+    if m.UpdateProjectFunc != nil {
+        return m.UpdateProjectFunc(ctx, in, id)
+    }
+    return auth.ProjectSingleResponse{}, nil, nil
+}
+
+func (m *Mock) Login(in auth.LoginRequest) (auth.LoginResponse, *http.Response, error) {
+    return m.LoginWithContext(context.Background(), in)
+}
+
+func (m *Mock) LoginWithContext(ctx context.Context, in auth.LoginRequest) (auth.LoginResponse, *http.Response, error) {
+    m.record("Login", in)
+    if m.LoginFunc != nil {
+        return m.LoginFunc(ctx, in)
+    }
+    return auth.LoginResponse{}, nil, nil
+}
+
+func (m *Mock) CreateUser(in auth.UserCreateRequest) (auth.UserSingleResponse, *http.Response, error) {
+    return m.CreateUserWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateUserWithContext(ctx context.Context, in auth.UserCreateRequest) (auth.UserSingleResponse, *http.Response, error) {
+    m.record("CreateUser", in)
+    if m.CreateUserFunc != nil {
+        return m.CreateUserFunc(ctx, in)
+    }
+    return auth.UserSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetUsers(qParams auth.GetUsersQueryParams) (auth.UserListResponse, *http.Response, error) {
+    return m.GetUsersWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetUsersWithContext(ctx context.Context, qParams auth.GetUsersQueryParams) (auth.UserListResponse, *http.Response, error) {
+    m.record("GetUsers", qParams)
+    if m.GetUsersFunc != nil {
+        return m.GetUsersFunc(ctx, qParams)
+    }
+    return auth.UserListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllUsers(ctx context.Context, qParams auth.GetUsersQueryParams) *auth.UserIterator {
+    return auth.NewUserIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.User, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetUsersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetUser(id string) (auth.UserSingleResponse, *http.Response, error) {
+    return m.GetUserWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetUserWithContext(ctx context.Context, id string) (auth.UserSingleResponse, *http.Response, error) {
+    m.record("GetUser", id)
+    if m.GetUserFunc != nil {
+        return m.GetUserFunc(ctx, id)
+    }
+    return auth.UserSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateUser(in auth.UserUpdateRequest, id string) (auth.UserSingleResponse, *http.Response, error) {
+    return m.UpdateUserWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateUserWithContext(ctx context.Context, in auth.UserUpdateRequest, id string) (auth.UserSingleResponse, *http.Response, error) {
+    m.record("UpdateUser", in, id)
+    if m.UpdateUserFunc != nil {
+        return m.UpdateUserFunc(ctx, in, id)
+    }
+    return auth.UserSingleResponse{}, nil, nil
+}
+
+func (m *Mock) RequestPasswordReset(in auth.RequestPasswordResetRequest) (auth.EmptyResponse, *http.Response, error) {
+    return m.RequestPasswordResetWithContext(context.Background(), in)
+}
+
+func (m *Mock) RequestPasswordResetWithContext(ctx context.Context, in auth.RequestPasswordResetRequest) (auth.EmptyResponse, *http.Response, error) {
+    m.record("RequestPasswordReset", in)
+    if m.RequestPasswordResetFunc != nil {
+        return m.RequestPasswordResetFunc(ctx, in)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) ExecutePasswordReset(in auth.ExecutePasswordResetRequest) (auth.EmptyResponse, *http.Response, error) {
+    return m.ExecutePasswordResetWithContext(context.Background(), in)
+}
+
+func (m *Mock) ExecutePasswordResetWithContext(ctx context.Context, in auth.ExecutePasswordResetRequest) (auth.EmptyResponse, *http.Response, error) {
+    m.record("ExecutePasswordReset", in)
+    if m.ExecutePasswordResetFunc != nil {
+        return m.ExecutePasswordResetFunc(ctx, in)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) ChangeEmail(in auth.EmailChangeRequest) (auth.EmptyResponse, *http.Response, error) {
+    return m.ChangeEmailWithContext(context.Background(), in)
+}
+
+func (m *Mock) ChangeEmailWithContext(ctx context.Context, in auth.EmailChangeRequest) (auth.EmptyResponse, *http.Response, error) {
+    m.record("ChangeEmail", in)
+    if m.ChangeEmailFunc != nil {
+        return m.ChangeEmailFunc(ctx, in)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) RejectProjectInvite(id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.RejectProjectInviteWithContext(context.Background(), id)
+}
+
+func (m *Mock) RejectProjectInviteWithContext(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("RejectProjectInvite", id)
+    if m.RejectProjectInviteFunc != nil {
+        return m.RejectProjectInviteFunc(ctx, id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) InsertAuditLogEntry(in auth.AuditLogRequest) (auth.EmptyResponse, *http.Response, error) {
+    return m.InsertAuditLogEntryWithContext(context.Background(), in)
+}
+
+func (m *Mock) InsertAuditLogEntryWithContext(ctx context.Context, in auth.AuditLogRequest) (auth.EmptyResponse, *http.Response, error) {
+    m.record("InsertAuditLogEntry", in)
+    if m.InsertAuditLogEntryFunc != nil {
+        return m.InsertAuditLogEntryFunc(ctx, in)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) SearchAuditLog(qParams auth.SearchAuditLogQueryParams) (auth.AuditLogEntryListResponse, *http.Response, error) {
+    return m.SearchAuditLogWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) SearchAuditLogWithContext(ctx context.Context, qParams auth.SearchAuditLogQueryParams) (auth.AuditLogEntryListResponse, *http.Response, error) {
+    m.record("SearchAuditLog", qParams)
+    if m.SearchAuditLogFunc != nil {
+        return m.SearchAuditLogFunc(ctx, qParams)
+    }
+    return auth.AuditLogEntryListResponse{}, nil, nil
+}
+
+func (m *Mock) SearchAllAuditLog(ctx context.Context, qParams auth.SearchAuditLogQueryParams) *auth.AuditLogEntryIterator {
+    return auth.NewAuditLogEntryIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.AuditLogEntry, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.SearchAuditLogWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateToken(in auth.TokenCreateRequest) (auth.TokenSingleResponse, *http.Response, error) {
+    return m.CreateTokenWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateTokenWithContext(ctx context.Context, in auth.TokenCreateRequest) (auth.TokenSingleResponse, *http.Response, error) {
+    m.record("CreateToken", in)
+    if m.CreateTokenFunc != nil {
+        return m.CreateTokenFunc(ctx, in)
+    }
+    return auth.TokenSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetTokens(qParams auth.GetTokensQueryParams) (auth.TokenListResponse, *http.Response, error) {
+    return m.GetTokensWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetTokensWithContext(ctx context.Context, qParams auth.GetTokensQueryParams) (auth.TokenListResponse, *http.Response, error) {
+    m.record("GetTokens", qParams)
+    if m.GetTokensFunc != nil {
+        return m.GetTokensFunc(ctx, qParams)
+    }
+    return auth.TokenListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllTokens(ctx context.Context, qParams auth.GetTokensQueryParams) *auth.TokenIterator {
+    return auth.NewTokenIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.Token, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetTokensWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetCountry(code string) (auth.CountrySingleResponse, *http.Response, error) {
+    return m.GetCountryWithContext(context.Background(), code)
+}
+
+func (m *Mock) GetCountryWithContext(ctx context.Context, code string) (auth.CountrySingleResponse, *http.Response, error) {
+    m.record("GetCountry", code)
+    if m.GetCountryFunc != nil {
+        return m.GetCountryFunc(ctx, code)
+    }
+    return auth.CountrySingleResponse{}, nil, nil
+}
+
+func (m *Mock) ChangePassword(in auth.PasswordChangeRequest) (auth.EmptyResponse, *http.Response, error) {
+    return m.ChangePasswordWithContext(context.Background(), in)
+}
+
+func (m *Mock) ChangePasswordWithContext(ctx context.Context, in auth.PasswordChangeRequest) (auth.EmptyResponse, *http.Response, error) {
+    m.record("ChangePassword", in)
+    if m.ChangePasswordFunc != nil {
+        return m.ChangePasswordFunc(ctx, in)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetToken(id string) (auth.TokenSingleResponse, *http.Response, error) {
+    return m.GetTokenWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetTokenWithContext(ctx context.Context, id string) (auth.TokenSingleResponse, *http.Response, error) {
+    m.record("GetToken", id)
+    if m.GetTokenFunc != nil {
+        return m.GetTokenFunc(ctx, id)
+    }
+    return auth.TokenSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteToken(id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.DeleteTokenWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteTokenWithContext(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("DeleteToken", id)
+    if m.DeleteTokenFunc != nil {
+        return m.DeleteTokenFunc(ctx, id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteProjectInvite(id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.DeleteProjectInviteWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteProjectInviteWithContext(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("DeleteProjectInvite", id)
+    if m.DeleteProjectInviteFunc != nil {
+        return m.DeleteProjectInviteFunc(ctx, id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) ValidateToken(token string) (auth.TokenValidationResponse, *http.Response, error) {
+    return m.ValidateTokenWithContext(context.Background(), token)
+}
+
+func (m *Mock) ValidateTokenWithContext(ctx context.Context, token string) (auth.TokenValidationResponse, *http.Response, error) {
+    m.record("ValidateToken", token)
+    if m.ValidateTokenFunc != nil {
+        return m.ValidateTokenFunc(ctx, token)
+    }
+    return auth.TokenValidationResponse{}, nil, nil
+}
+
+func (m *Mock) CreateProjectInvite(in auth.ProjectInviteCreateRequest) (auth.ProjectInviteSingleResponse, *http.Response, error) {
+    return m.CreateProjectInviteWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateProjectInviteWithContext(ctx context.Context, in auth.ProjectInviteCreateRequest) (auth.ProjectInviteSingleResponse, *http.Response, error) {
+    m.record("CreateProjectInvite", in)
+    if m.CreateProjectInviteFunc != nil {
+        return m.CreateProjectInviteFunc(ctx, in)
+    }
+    return auth.ProjectInviteSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetProjectInvites(qParams auth.GetProjectInvitesQueryParams) (auth.ProjectInviteListResponse, *http.Response, error) {
+    return m.GetProjectInvitesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetProjectInvitesWithContext(ctx context.Context, qParams auth.GetProjectInvitesQueryParams) (auth.ProjectInviteListResponse, *http.Response, error) {
+    m.record("GetProjectInvites", qParams)
+    if m.GetProjectInvitesFunc != nil {
+        return m.GetProjectInvitesFunc(ctx, qParams)
+    }
+    return auth.ProjectInviteListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllProjectInvites(ctx context.Context, qParams auth.GetProjectInvitesQueryParams) *auth.ProjectInviteIterator {
+    return auth.NewProjectInviteIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.ProjectInvite, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetProjectInvitesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) AddProjectMember(in auth.ProjectMemberCreateRequest, id string) (auth.ProjectMemberSingleResponse, *http.Response, error) {
+    return m.AddProjectMemberWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) AddProjectMemberWithContext(ctx context.Context, in auth.ProjectMemberCreateRequest, id string) (auth.ProjectMemberSingleResponse, *http.Response, error) {
+    m.record("AddProjectMember", in, id)
+    if m.AddProjectMemberFunc != nil {
+        return m.AddProjectMemberFunc(ctx, in, id)
+    }
+    return auth.ProjectMemberSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetProjectMembers(id string, qParams auth.GetProjectMembersQueryParams) (auth.ProjectMemberListResponse, *http.Response, error) {
+    return m.GetProjectMembersWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetProjectMembersWithContext(ctx context.Context, id string, qParams auth.GetProjectMembersQueryParams) (auth.ProjectMemberListResponse, *http.Response, error) {
+    m.record("GetProjectMembers", id, qParams)
+    if m.GetProjectMembersFunc != nil {
+        return m.GetProjectMembersFunc(ctx, id, qParams)
+    }
+    return auth.ProjectMemberListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllProjectMembers(ctx context.Context, id string, qParams auth.GetProjectMembersQueryParams) *auth.ProjectMemberIterator {
+    return auth.NewProjectMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.ProjectMember, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetProjectMembersWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) SearchTransactionLog(in auth.TransactionLogRequest) (auth.TransactionLogResponse, *http.Response, error) {
+    return m.SearchTransactionLogWithContext(context.Background(), in)
+}
+
+func (m *Mock) SearchTransactionLogWithContext(ctx context.Context, in auth.TransactionLogRequest) (auth.TransactionLogResponse, *http.Response, error) {
+    m.record("SearchTransactionLog", in)
+    if m.SearchTransactionLogFunc != nil {
+        return m.SearchTransactionLogFunc(ctx, in)
+    }
+    return auth.TransactionLogResponse{}, nil, nil
+}
+
+func (m *Mock) ValidateSelf() (auth.TokenValidationResponse, *http.Response, error) {
+    return m.ValidateSelfWithContext(context.Background())
+}
+
+func (m *Mock) ValidateSelfWithContext(ctx context.Context) (auth.TokenValidationResponse, *http.Response, error) {
+    m.record("ValidateSelf")
+    if m.ValidateSelfFunc != nil {
+        return m.ValidateSelfFunc(ctx)
+    }
+    return auth.TokenValidationResponse{}, nil, nil
+}
+
+func (m *Mock) AcceptProjectInvite(id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.AcceptProjectInviteWithContext(context.Background(), id)
+}
+
+func (m *Mock) AcceptProjectInviteWithContext(ctx context.Context, id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("AcceptProjectInvite", id)
+    if m.AcceptProjectInviteFunc != nil {
+        return m.AcceptProjectInviteFunc(ctx, id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) RemoveProjectMember(id string, user_id string) (auth.EmptyResponse, *http.Response, error) {
+    return m.RemoveProjectMemberWithContext(context.Background(), id, user_id)
+}
+
+func (m *Mock) RemoveProjectMemberWithContext(ctx context.Context, id string, user_id string) (auth.EmptyResponse, *http.Response, error) {
+    m.record("RemoveProjectMember", id, user_id)
+    if m.RemoveProjectMemberFunc != nil {
+        return m.RemoveProjectMemberFunc(ctx, id, user_id)
+    }
+    return auth.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetUserProjectMemberships(id string, qParams auth.GetUserProjectMembershipsQueryParams) (auth.ProjectMemberListResponse, *http.Response, error) {
+    return m.GetUserProjectMembershipsWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetUserProjectMembershipsWithContext(ctx context.Context, id string, qParams auth.GetUserProjectMembershipsQueryParams) (auth.ProjectMemberListResponse, *http.Response, error) {
+    m.record("GetUserProjectMemberships", id, qParams)
+    if m.GetUserProjectMembershipsFunc != nil {
+        return m.GetUserProjectMembershipsFunc(ctx, id, qParams)
+    }
+    return auth.ProjectMemberListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllUserProjectMemberships(ctx context.Context, id string, qParams auth.GetUserProjectMembershipsQueryParams) *auth.ProjectMemberIterator {
+    return auth.NewProjectMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.ProjectMember, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetUserProjectMembershipsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetCountries(qParams auth.GetCountriesQueryParams) (auth.CountryListResponse, *http.Response, error) {
+    return m.GetCountriesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetCountriesWithContext(ctx context.Context, qParams auth.GetCountriesQueryParams) (auth.CountryListResponse, *http.Response, error) {
+    m.record("GetCountries", qParams)
+    if m.GetCountriesFunc != nil {
+        return m.GetCountriesFunc(ctx, qParams)
+    }
+    return auth.CountryListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllCountries(ctx context.Context, qParams auth.GetCountriesQueryParams) *auth.CountryIterator {
+    return auth.NewCountryIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]auth.Country, *auth.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetCountriesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
@@ -0,0 +1,459 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package auth
+
+import (
+    "context"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// ProjectIterator iterates over the Project items of all pages of a list.
+type ProjectIterator struct {
+    pager *core.Pager
+    items []Project
+    current Project
+}
+
+// NewProjectIterator returns an iterator over the items returned by fetch, starting at page.
+func NewProjectIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Project, *ResponsePagination, error)) *ProjectIterator {
+    it := &ProjectIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ProjectIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ProjectIterator) Value() Project {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ProjectIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ProjectIterator) All() ([]Project, error) {
+    all := []Project{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// UserIterator iterates over the User items of all pages of a list.
+type UserIterator struct {
+    pager *core.Pager
+    items []User
+    current User
+}
+
+// NewUserIterator returns an iterator over the items returned by fetch, starting at page.
+func NewUserIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]User, *ResponsePagination, error)) *UserIterator {
+    it := &UserIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *UserIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *UserIterator) Value() User {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *UserIterator) All() ([]User, error) {
+    all := []User{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// AuditLogEntryIterator iterates over the AuditLogEntry items of all pages of a list.
+type AuditLogEntryIterator struct {
+    pager *core.Pager
+    items []AuditLogEntry
+    current AuditLogEntry
+}
+
+// NewAuditLogEntryIterator returns an iterator over the items returned by fetch, starting at page.
+func NewAuditLogEntryIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]AuditLogEntry, *ResponsePagination, error)) *AuditLogEntryIterator {
+    it := &AuditLogEntryIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *AuditLogEntryIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *AuditLogEntryIterator) Value() AuditLogEntry {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AuditLogEntryIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *AuditLogEntryIterator) All() ([]AuditLogEntry, error) {
+    all := []AuditLogEntry{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// TokenIterator iterates over the Token items of all pages of a list.
+type TokenIterator struct {
+    pager *core.Pager
+    items []Token
+    current Token
+}
+
+// NewTokenIterator returns an iterator over the items returned by fetch, starting at page.
+func NewTokenIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Token, *ResponsePagination, error)) *TokenIterator {
+    it := &TokenIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *TokenIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *TokenIterator) Value() Token {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TokenIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *TokenIterator) All() ([]Token, error) {
+    all := []Token{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ProjectInviteIterator iterates over the ProjectInvite items of all pages of a list.
+type ProjectInviteIterator struct {
+    pager *core.Pager
+    items []ProjectInvite
+    current ProjectInvite
+}
+
+// NewProjectInviteIterator returns an iterator over the items returned by fetch, starting at page.
+func NewProjectInviteIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ProjectInvite, *ResponsePagination, error)) *ProjectInviteIterator {
+    it := &ProjectInviteIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ProjectInviteIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ProjectInviteIterator) Value() ProjectInvite {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ProjectInviteIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ProjectInviteIterator) All() ([]ProjectInvite, error) {
+    all := []ProjectInvite{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ProjectMemberIterator iterates over the ProjectMember items of all pages of a list.
+type ProjectMemberIterator struct {
+    pager *core.Pager
+    items []ProjectMember
+    current ProjectMember
+}
+
+// NewProjectMemberIterator returns an iterator over the items returned by fetch, starting at page.
+func NewProjectMemberIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ProjectMember, *ResponsePagination, error)) *ProjectMemberIterator {
+    it := &ProjectMemberIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ProjectMemberIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ProjectMemberIterator) Value() ProjectMember {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ProjectMemberIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ProjectMemberIterator) All() ([]ProjectMember, error) {
+    all := []ProjectMember{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// CountryIterator iterates over the Country items of all pages of a list.
+type CountryIterator struct {
+    pager *core.Pager
+    items []Country
+    current Country
+}
+
+// NewCountryIterator returns an iterator over the items returned by fetch, starting at page.
+func NewCountryIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Country, *ResponsePagination, error)) *CountryIterator {
+    it := &CountryIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *CountryIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *CountryIterator) Value() Country {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *CountryIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *CountryIterator) All() ([]Country, error) {
+    all := []Country{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ListAllProjects iterates over the items of all pages of GetProjects.
+func (c AuthClient) ListAllProjects(ctx context.Context, qParams GetProjectsQueryParams) *ProjectIterator {
+    return NewProjectIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Project, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetProjectsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllUsers iterates over the items of all pages of GetUsers.
+func (c AuthClient) ListAllUsers(ctx context.Context, qParams GetUsersQueryParams) *UserIterator {
+    return NewUserIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]User, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetUsersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// SearchAllAuditLog iterates over the items of all pages of SearchAuditLog.
+func (c AuthClient) SearchAllAuditLog(ctx context.Context, qParams SearchAuditLogQueryParams) *AuditLogEntryIterator {
+    return NewAuditLogEntryIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]AuditLogEntry, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.SearchAuditLogWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllTokens iterates over the items of all pages of GetTokens.
+func (c AuthClient) ListAllTokens(ctx context.Context, qParams GetTokensQueryParams) *TokenIterator {
+    return NewTokenIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Token, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetTokensWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllProjectInvites iterates over the items of all pages of GetProjectInvites.
+func (c AuthClient) ListAllProjectInvites(ctx context.Context, qParams GetProjectInvitesQueryParams) *ProjectInviteIterator {
+    return NewProjectInviteIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ProjectInvite, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetProjectInvitesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllProjectMembers iterates over the items of all pages of GetProjectMembers.
+func (c AuthClient) ListAllProjectMembers(ctx context.Context, id string, qParams GetProjectMembersQueryParams) *ProjectMemberIterator {
+    return NewProjectMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ProjectMember, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetProjectMembersWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllUserProjectMemberships iterates over the items of all pages of GetUserProjectMemberships.
+func (c AuthClient) ListAllUserProjectMemberships(ctx context.Context, id string, qParams GetUserProjectMembershipsQueryParams) *ProjectMemberIterator {
+    return NewProjectMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ProjectMember, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetUserProjectMembershipsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllCountries iterates over the items of all pages of GetCountries.
+func (c AuthClient) ListAllCountries(ctx context.Context, qParams GetCountriesQueryParams) *CountryIterator {
+    return NewCountryIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Country, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetCountriesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
@@ -0,0 +1,95 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package billing
+
+//go:generate go run ../internal/cmd/apigen -client BillingClient
+
+import (
+    "context"
+    "io"
+    "net/http"
+)
+
+// API lists all methods of BillingClient, allowing it to be replaced by a mock.
+type API interface {
+    CreateDebitMandate(in DebitMandateCreateRequest) (DebitMandateSingleResponse, *http.Response, error)
+    CreateDebitMandateWithContext(ctx context.Context, in DebitMandateCreateRequest) (DebitMandateSingleResponse, *http.Response, error)
+    GetDebitMandates(qParams GetDebitMandatesQueryParams) (DebitMandateListResponse, *http.Response, error)
+    GetDebitMandatesWithContext(ctx context.Context, qParams GetDebitMandatesQueryParams) (DebitMandateListResponse, *http.Response, error)
+    ListAllDebitMandates(ctx context.Context, qParams GetDebitMandatesQueryParams) *DebitMandateIterator
+    CreateInvoicePosition(in PositionCreateRequest, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    CreateInvoicePositionWithContext(ctx context.Context, in PositionCreateRequest, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    GetInvoicePositions(id string, qParams GetInvoicePositionsQueryParams) (InvoicePositionListResponse, *http.Response, error)
+    GetInvoicePositionsWithContext(ctx context.Context, id string, qParams GetInvoicePositionsQueryParams) (InvoicePositionListResponse, *http.Response, error)
+    ListAllInvoicePositions(ctx context.Context, id string, qParams GetInvoicePositionsQueryParams) *PositionIterator
+    GetBillingPosition(id string) (BillingPositionSingleResponse, *http.Response, error)
+    GetBillingPositionWithContext(ctx context.Context, id string) (BillingPositionSingleResponse, *http.Response, error)
+    DeleteBillingPosition(id string) (EmptyResponse, *http.Response, error)
+    DeleteBillingPositionWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateBillingPosition(in BillingPositionUpdateRequest, id string) (BillingPositionSingleResponse, *http.Response, error)
+    UpdateBillingPositionWithContext(ctx context.Context, in BillingPositionUpdateRequest, id string) (BillingPositionSingleResponse, *http.Response, error)
+    GetDebitMandate(id string) (DebitMandateSingleResponse, *http.Response, error)
+    GetDebitMandateWithContext(ctx context.Context, id string) (DebitMandateSingleResponse, *http.Response, error)
+    CreateBillingPosition(in BillingPositionCreateRequest) (BillingPositionSingleResponse, *http.Response, error)
+    CreateBillingPositionWithContext(ctx context.Context, in BillingPositionCreateRequest) (BillingPositionSingleResponse, *http.Response, error)
+    GetBillingPositions(qParams GetBillingPositionsQueryParams) (BillingPositionListResponse, *http.Response, error)
+    GetBillingPositionsWithContext(ctx context.Context, qParams GetBillingPositionsQueryParams) (BillingPositionListResponse, *http.Response, error)
+    ListAllBillingPositions(ctx context.Context, qParams GetBillingPositionsQueryParams) *BillingPositionIterator
+    CreateCustomer(in CustomerCreateRequest) (CustomerSingleResponse, *http.Response, error)
+    CreateCustomerWithContext(ctx context.Context, in CustomerCreateRequest) (CustomerSingleResponse, *http.Response, error)
+    GetCustomers(qParams GetCustomersQueryParams) (CustomerListResponse, *http.Response, error)
+    GetCustomersWithContext(ctx context.Context, qParams GetCustomersQueryParams) (CustomerListResponse, *http.Response, error)
+    ListAllCustomers(ctx context.Context, qParams GetCustomersQueryParams) *CustomerIterator
+    GetInvoicePosition(invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    GetInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    DeleteInvoicePosition(invoice_id string, id string) (EmptyResponse, *http.Response, error)
+    DeleteInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (EmptyResponse, *http.Response, error)
+    UpdateInvoicePosition(in PositionUpdateRequest, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    UpdateInvoicePositionWithContext(ctx context.Context, in PositionUpdateRequest, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error)
+    CreateServiceContract(in ServiceContractCreateRequest) (ServiceContractSingleResponse, *http.Response, error)
+    CreateServiceContractWithContext(ctx context.Context, in ServiceContractCreateRequest) (ServiceContractSingleResponse, *http.Response, error)
+    GetServiceContracts(qParams GetServiceContractsQueryParams) (ServiceContractListResponse, *http.Response, error)
+    GetServiceContractsWithContext(ctx context.Context, qParams GetServiceContractsQueryParams) (ServiceContractListResponse, *http.Response, error)
+    ListAllServiceContracts(ctx context.Context, qParams GetServiceContractsQueryParams) *ServiceContractIterator
+    GetDebits(qParams GetDebitsQueryParams) (DebitListResponse, *http.Response, error)
+    GetDebitsWithContext(ctx context.Context, qParams GetDebitsQueryParams) (DebitListResponse, *http.Response, error)
+    ListAllDebits(ctx context.Context, qParams GetDebitsQueryParams) *DebitIterator
+    GetCustomer(id int) (CustomerSingleResponse, *http.Response, error)
+    GetCustomerWithContext(ctx context.Context, id int) (CustomerSingleResponse, *http.Response, error)
+    UpdateCustomer(in CustomerUpdateRequest, id int) (CustomerSingleResponse, *http.Response, error)
+    UpdateCustomerWithContext(ctx context.Context, in CustomerUpdateRequest, id int) (CustomerSingleResponse, *http.Response, error)
+    GetInvoice(id string) (InvoiceSingleResponse, *http.Response, error)
+    GetInvoiceWithContext(ctx context.Context, id string) (InvoiceSingleResponse, *http.Response, error)
+    DeleteInvoice(id string) (EmptyResponse, *http.Response, error)
+    DeleteInvoiceWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateInvoice(in InvoiceUpdateRequest, id string) (InvoiceSingleResponse, *http.Response, error)
+    UpdateInvoiceWithContext(ctx context.Context, in InvoiceUpdateRequest, id string) (InvoiceSingleResponse, *http.Response, error)
+    GetServiceContractPosition(contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    GetServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    DeleteServiceContractPosition(contract_id string, id string) (EmptyResponse, *http.Response, error)
+    DeleteServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (EmptyResponse, *http.Response, error)
+    UpdateServiceContractPosition(in PositionUpdateRequest, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    UpdateServiceContractPositionWithContext(ctx context.Context, in PositionUpdateRequest, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    CreateInvoice(in InvoiceCreateRequest) (InvoiceSingleResponse, *http.Response, error)
+    CreateInvoiceWithContext(ctx context.Context, in InvoiceCreateRequest) (InvoiceSingleResponse, *http.Response, error)
+    GetInvoices(qParams GetInvoicesQueryParams) (InvoiceListResponse, *http.Response, error)
+    GetInvoicesWithContext(ctx context.Context, qParams GetInvoicesQueryParams) (InvoiceListResponse, *http.Response, error)
+    ListAllInvoices(ctx context.Context, qParams GetInvoicesQueryParams) *InvoiceIterator
+    GetDebit(id string) (DebitSingleResponse, *http.Response, error)
+    GetDebitWithContext(ctx context.Context, id string) (DebitSingleResponse, *http.Response, error)
+    CreateServiceContractPosition(in PositionCreateRequest, contract_id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    CreateServiceContractPositionWithContext(ctx context.Context, in PositionCreateRequest, contract_id string) (ServiceContractPositionSingleResponse, *http.Response, error)
+    GetServiceContractPositions(contract_id string, qParams GetServiceContractPositionsQueryParams) (ServiceContractPositionListResponse, *http.Response, error)
+    GetServiceContractPositionsWithContext(ctx context.Context, contract_id string, qParams GetServiceContractPositionsQueryParams) (ServiceContractPositionListResponse, *http.Response, error)
+    ListAllServiceContractPositions(ctx context.Context, contract_id string, qParams GetServiceContractPositionsQueryParams) *ServiceContractPositionIterator
+    GetServiceContract(id string) (ServiceContractSingleResponse, *http.Response, error)
+    GetServiceContractWithContext(ctx context.Context, id string) (ServiceContractSingleResponse, *http.Response, error)
+    DeleteServiceContract(id string) (EmptyResponse, *http.Response, error)
+    DeleteServiceContractWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateServiceContract(in ServiceContractUpdateRequest, id string) (ServiceContractSingleResponse, *http.Response, error)
+    UpdateServiceContractWithContext(ctx context.Context, in ServiceContractUpdateRequest, id string) (ServiceContractSingleResponse, *http.Response, error)
+    GetInvoiceFile(id string, w io.Writer) (InvoiceFile, *http.Response, error)
+    GetInvoiceFileWithContext(ctx context.Context, id string, w io.Writer) (InvoiceFile, *http.Response, error)
+}
+
+var _ API = BillingClient{}
@@ -1,21 +1,13 @@
 package billing
 
 import (
-    "bytes"
-    "io/ioutil"
+    "context"
     "net/http"
-    "time"
-    "encoding/json"
-    "io"
-    "errors"
-    "strconv"
-    "github.com/google/go-querystring/query"
+    "github.com/lumaserv/lumaserv-api-go/core"
 )
 
 type BillingClient struct {
-    baseUrl string
-    apiKey  string
-    client  *http.Client
+    *core.Client
 }
 
 func NewClient (apiKey string) BillingClient {
@@ -28,58 +20,18 @@ func NewClientWithUrl (apiKey string, baseUrl string) BillingClient {
     }
 
     return BillingClient {
-        apiKey: apiKey,
-        baseUrl: baseUrl,
+        Client: core.NewClient(apiKey, baseUrl),
     }
 }
 
-func (c *BillingClient) SetHttpClient(client *http.Client) {
-    c.client = client
-}
-
-func (c *BillingClient) SetAccessToken(token string) {
-    c.apiKey = token
-}
-
-func (c *BillingClient) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
-    if c.client == nil {
-        c.client = &http.Client{
-            Timeout: time.Second * 5,
-        }
-    }
-
-    req, err := http.NewRequest(method, c.baseUrl+path, postBody)
-    if err != nil {
-        return nil, nil, err
-    }
+type ResponseMessages = core.ResponseMessages
 
-    req.Header.Add("Authorization", "Bearer "+c.apiKey)
-    req.Header.Add("User-Agent", "LUMASERV-go-client")
-    req.Header.Add("Accept", "application/json")
-    res, err := c.client.Do(req)
-    if err != nil {
-        return res, nil, err
-    }
-
-    if res.Body != nil {
-        defer res.Body.Close()
-    }
+type ResponseMessage = core.ResponseMessage
 
-    body, err := ioutil.ReadAll(res.Body)
+type ResponsePagination = core.ResponsePagination
 
-    return res, body, err
-}
+type ResponseMetadata = core.ResponseMetadata
 
-func (c BillingClient) toStr(in interface{}) string {
-    switch in.(type) {
-        case string:
-            return in.(string)
-        case int:
-            return strconv.Itoa(in.(int))
-    }
-
-    panic("Unhandled type in toStr")
-}
 type CustomerDetailed struct {
     AdditionalAddress *string `json:"additional_address"`
     City *string `json:"city"`
@@ -103,6 +55,25 @@ type CustomerDetailed struct {
 
 type BillingInterval string
 
+const (
+    BillingIntervalMonthly BillingInterval = "MONTHLY"
+    BillingIntervalQuarterly BillingInterval = "QUARTERLY"
+    BillingIntervalHalfYearly BillingInterval = "HALF_YEARLY"
+    BillingIntervalYearly BillingInterval = "YEARLY"
+)
+
+func (e BillingInterval) String() string {
+    return string(e)
+}
+
+func (e BillingInterval) IsValid() bool {
+    switch e {
+        case BillingIntervalMonthly, BillingIntervalQuarterly, BillingIntervalHalfYearly, BillingIntervalYearly:
+            return true
+    }
+    return false
+}
+
 type InvoiceDetailed struct {
     PaidAt string `json:"paid_at"`
     CreatedAt string `json:"created_at"`
@@ -188,17 +159,25 @@ type CreateRequestPosition struct {
     VatRate *float32 `json:"vat_rate"`
 }
 
-type ResponseMessage struct {
-    Message string `json:"message"`
-    Key string `json:"key"`
-}
-
 type ServiceContractInterval string
 
-type ResponsePagination struct {
-    Total int `json:"total"`
-    Page int `json:"page"`
-    PageSize int `json:"page_size"`
+const (
+    ServiceContractIntervalMonthly ServiceContractInterval = "MONTHLY"
+    ServiceContractIntervalQuarterly ServiceContractInterval = "QUARTERLY"
+    ServiceContractIntervalHalfYearly ServiceContractInterval = "HALF_YEARLY"
+    ServiceContractIntervalYearly ServiceContractInterval = "YEARLY"
+)
+
+func (e ServiceContractInterval) String() string {
+    return string(e)
+}
+
+func (e ServiceContractInterval) IsValid() bool {
+    switch e {
+        case ServiceContractIntervalMonthly, ServiceContractIntervalQuarterly, ServiceContractIntervalHalfYearly, ServiceContractIntervalYearly:
+            return true
+    }
+    return false
 }
 
 type BillingPosition struct {
@@ -243,18 +222,26 @@ type InvoiceCreateRequestPosition struct {
     Group string `json:"group"`
 }
 
-type ResponseMessages struct {
-    Warnings []ResponseMessage `json:"warnings"`
-    Errors []ResponseMessage `json:"errors"`
-    Infos []ResponseMessage `json:"infos"`
-}
-
 type InvoiceState string
 
-type ResponseMetadata struct {
-    TransactionId string `json:"transaction_id"`
-    BuildCommit string `json:"build_commit"`
-    BuildTimestamp string `json:"build_timestamp"`
+const (
+    InvoiceStateDraft InvoiceState = "DRAFT"
+    InvoiceStateOpen InvoiceState = "OPEN"
+    InvoiceStatePaid InvoiceState = "PAID"
+    InvoiceStateCancelled InvoiceState = "CANCELLED"
+    InvoiceStateRefunded InvoiceState = "REFUNDED"
+)
+
+func (e InvoiceState) String() string {
+    return string(e)
+}
+
+func (e InvoiceState) IsValid() bool {
+    switch e {
+        case InvoiceStateDraft, InvoiceStateOpen, InvoiceStatePaid, InvoiceStateCancelled, InvoiceStateRefunded:
+            return true
+    }
+    return false
 }
 
 type ServiceContractListResponse struct {
@@ -541,20 +528,12 @@ type BillingPositionUpdateRequest struct {
 }
 
 func (c BillingClient) CreateDebitMandate(in DebitMandateCreateRequest) (DebitMandateSingleResponse, *http.Response, error) {
+    return c.CreateDebitMandateWithContext(context.Background(), in)
+}
+
+func (c BillingClient) CreateDebitMandateWithContext(ctx context.Context, in DebitMandateCreateRequest) (DebitMandateSingleResponse, *http.Response, error) {
     body := DebitMandateSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/debit-mandates", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/debit-mandates", nil, in, &body)
     return body, res, err
 }
 
@@ -567,58 +546,22 @@ type GetDebitMandatesQueryParams struct {
 }
 
 func (c BillingClient) GetDebitMandates(qParams GetDebitMandatesQueryParams) (DebitMandateListResponse, *http.Response, error) {
-    body := DebitMandateListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/debit-mandates"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
-    return body, res, err
+    return c.GetDebitMandatesWithContext(context.Background(), qParams)
 }
 
-func (c BillingClient) GetInvoiceFile(id string) (FileSingleResponse, *http.Response, error) {
-    body := FileSingleResponse{}
-    res, j, err := c.Request("GET", "/invoices/"+c.toStr(id)+"/file", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+func (c BillingClient) GetDebitMandatesWithContext(ctx context.Context, qParams GetDebitMandatesQueryParams) (DebitMandateListResponse, *http.Response, error) {
+    body := DebitMandateListResponse{}
+    res, err := c.DoWithContext(ctx, "GET", "/debit-mandates", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateInvoicePosition(in PositionCreateRequest, id string) (InvoicePositionSingleResponse, *http.Response, error) {
+    return c.CreateInvoicePositionWithContext(context.Background(), in, id)
+}
+
+func (c BillingClient) CreateInvoicePositionWithContext(ctx context.Context, in PositionCreateRequest, id string) (InvoicePositionSingleResponse, *http.Response, error) {
     body := InvoicePositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/invoices/"+c.toStr(id)+"/positions", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/invoices/"+core.ToStr(id)+"/positions", nil, in, &body)
     return body, res, err
 }
 
@@ -631,110 +574,62 @@ type GetInvoicePositionsQueryParams struct {
 }
 
 func (c BillingClient) GetInvoicePositions(id string, qParams GetInvoicePositionsQueryParams) (InvoicePositionListResponse, *http.Response, error) {
+    return c.GetInvoicePositionsWithContext(context.Background(), id, qParams)
+}
+
+func (c BillingClient) GetInvoicePositionsWithContext(ctx context.Context, id string, qParams GetInvoicePositionsQueryParams) (InvoicePositionListResponse, *http.Response, error) {
     body := InvoicePositionListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/invoices/"+c.toStr(id)+"/positions"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/invoices/"+core.ToStr(id)+"/positions", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetBillingPosition(id string) (BillingPositionSingleResponse, *http.Response, error) {
+    return c.GetBillingPositionWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetBillingPositionWithContext(ctx context.Context, id string) (BillingPositionSingleResponse, *http.Response, error) {
     body := BillingPositionSingleResponse{}
-    res, j, err := c.Request("GET", "/billing-positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/billing-positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) DeleteBillingPosition(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteBillingPositionWithContext(context.Background(), id)
+}
+
+func (c BillingClient) DeleteBillingPositionWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/billing-positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/billing-positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateBillingPosition(in BillingPositionUpdateRequest, id string) (BillingPositionSingleResponse, *http.Response, error) {
+    return c.UpdateBillingPositionWithContext(context.Background(), in, id)
+}
+
+func (c BillingClient) UpdateBillingPositionWithContext(ctx context.Context, in BillingPositionUpdateRequest, id string) (BillingPositionSingleResponse, *http.Response, error) {
     body := BillingPositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/billing-positions/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/billing-positions/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetDebitMandate(id string) (DebitMandateSingleResponse, *http.Response, error) {
+    return c.GetDebitMandateWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetDebitMandateWithContext(ctx context.Context, id string) (DebitMandateSingleResponse, *http.Response, error) {
     body := DebitMandateSingleResponse{}
-    res, j, err := c.Request("GET", "/debit-mandates/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/debit-mandates/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateBillingPosition(in BillingPositionCreateRequest) (BillingPositionSingleResponse, *http.Response, error) {
+    return c.CreateBillingPositionWithContext(context.Background(), in)
+}
+
+func (c BillingClient) CreateBillingPositionWithContext(ctx context.Context, in BillingPositionCreateRequest) (BillingPositionSingleResponse, *http.Response, error) {
     body := BillingPositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/billing-positions", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/billing-positions", nil, in, &body)
     return body, res, err
 }
 
@@ -757,41 +652,22 @@ type GetBillingPositionsQueryParams struct {
 }
 
 func (c BillingClient) GetBillingPositions(qParams GetBillingPositionsQueryParams) (BillingPositionListResponse, *http.Response, error) {
+    return c.GetBillingPositionsWithContext(context.Background(), qParams)
+}
+
+func (c BillingClient) GetBillingPositionsWithContext(ctx context.Context, qParams GetBillingPositionsQueryParams) (BillingPositionListResponse, *http.Response, error) {
     body := BillingPositionListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/billing-positions"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/billing-positions", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateCustomer(in CustomerCreateRequest) (CustomerSingleResponse, *http.Response, error) {
+    return c.CreateCustomerWithContext(context.Background(), in)
+}
+
+func (c BillingClient) CreateCustomerWithContext(ctx context.Context, in CustomerCreateRequest) (CustomerSingleResponse, *http.Response, error) {
     body := CustomerSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/customers", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/customers", nil, in, &body)
     return body, res, err
 }
 
@@ -804,93 +680,52 @@ type GetCustomersQueryParams struct {
 }
 
 func (c BillingClient) GetCustomers(qParams GetCustomersQueryParams) (CustomerListResponse, *http.Response, error) {
+    return c.GetCustomersWithContext(context.Background(), qParams)
+}
+
+func (c BillingClient) GetCustomersWithContext(ctx context.Context, qParams GetCustomersQueryParams) (CustomerListResponse, *http.Response, error) {
     body := CustomerListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/customers"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/customers", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetInvoicePosition(invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error) {
+    return c.GetInvoicePositionWithContext(context.Background(), invoice_id, id)
+}
+
+func (c BillingClient) GetInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error) {
     body := InvoicePositionSingleResponse{}
-    res, j, err := c.Request("GET", "/invoices/"+c.toStr(invoice_id)+"/positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/invoices/"+core.ToStr(invoice_id)+"/positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) DeleteInvoicePosition(invoice_id string, id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteInvoicePositionWithContext(context.Background(), invoice_id, id)
+}
+
+func (c BillingClient) DeleteInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/invoices/"+c.toStr(invoice_id)+"/positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/invoices/"+core.ToStr(invoice_id)+"/positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateInvoicePosition(in PositionUpdateRequest, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error) {
+    return c.UpdateInvoicePositionWithContext(context.Background(), in, invoice_id, id)
+}
+
+func (c BillingClient) UpdateInvoicePositionWithContext(ctx context.Context, in PositionUpdateRequest, invoice_id string, id string) (InvoicePositionSingleResponse, *http.Response, error) {
     body := InvoicePositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/invoices/"+c.toStr(invoice_id)+"/positions/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/invoices/"+core.ToStr(invoice_id)+"/positions/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateServiceContract(in ServiceContractCreateRequest) (ServiceContractSingleResponse, *http.Response, error) {
+    return c.CreateServiceContractWithContext(context.Background(), in)
+}
+
+func (c BillingClient) CreateServiceContractWithContext(ctx context.Context, in ServiceContractCreateRequest) (ServiceContractSingleResponse, *http.Response, error) {
     body := ServiceContractSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/service-contracts", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/service-contracts", nil, in, &body)
     return body, res, err
 }
 
@@ -908,23 +743,12 @@ type GetServiceContractsQueryParams struct {
 }
 
 func (c BillingClient) GetServiceContracts(qParams GetServiceContractsQueryParams) (ServiceContractListResponse, *http.Response, error) {
+    return c.GetServiceContractsWithContext(context.Background(), qParams)
+}
+
+func (c BillingClient) GetServiceContractsWithContext(ctx context.Context, qParams GetServiceContractsQueryParams) (ServiceContractListResponse, *http.Response, error) {
     body := ServiceContractListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/service-contracts"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/service-contracts", qParams, nil, &body)
     return body, res, err
 }
 
@@ -937,180 +761,102 @@ type GetDebitsQueryParams struct {
 }
 
 func (c BillingClient) GetDebits(qParams GetDebitsQueryParams) (DebitListResponse, *http.Response, error) {
+    return c.GetDebitsWithContext(context.Background(), qParams)
+}
+
+func (c BillingClient) GetDebitsWithContext(ctx context.Context, qParams GetDebitsQueryParams) (DebitListResponse, *http.Response, error) {
     body := DebitListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/debits"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/debits", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetCustomer(id int) (CustomerSingleResponse, *http.Response, error) {
+    return c.GetCustomerWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetCustomerWithContext(ctx context.Context, id int) (CustomerSingleResponse, *http.Response, error) {
     body := CustomerSingleResponse{}
-    res, j, err := c.Request("GET", "/customers/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/customers/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateCustomer(in CustomerUpdateRequest, id int) (CustomerSingleResponse, *http.Response, error) {
+    return c.UpdateCustomerWithContext(context.Background(), in, id)
+}
+
+func (c BillingClient) UpdateCustomerWithContext(ctx context.Context, in CustomerUpdateRequest, id int) (CustomerSingleResponse, *http.Response, error) {
     body := CustomerSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/customers/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/customers/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetInvoice(id string) (InvoiceSingleResponse, *http.Response, error) {
+    return c.GetInvoiceWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetInvoiceWithContext(ctx context.Context, id string) (InvoiceSingleResponse, *http.Response, error) {
     body := InvoiceSingleResponse{}
-    res, j, err := c.Request("GET", "/invoices/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/invoices/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) DeleteInvoice(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteInvoiceWithContext(context.Background(), id)
+}
+
+func (c BillingClient) DeleteInvoiceWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/invoices/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/invoices/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateInvoice(in InvoiceUpdateRequest, id string) (InvoiceSingleResponse, *http.Response, error) {
+    return c.UpdateInvoiceWithContext(context.Background(), in, id)
+}
+
+func (c BillingClient) UpdateInvoiceWithContext(ctx context.Context, in InvoiceUpdateRequest, id string) (InvoiceSingleResponse, *http.Response, error) {
     body := InvoiceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/invoices/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/invoices/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetServiceContractPosition(contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
+    return c.GetServiceContractPositionWithContext(context.Background(), contract_id, id)
+}
+
+func (c BillingClient) GetServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
     body := ServiceContractPositionSingleResponse{}
-    res, j, err := c.Request("GET", "/service-contracts/"+c.toStr(contract_id)+"/positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/service-contracts/"+core.ToStr(contract_id)+"/positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) DeleteServiceContractPosition(contract_id string, id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServiceContractPositionWithContext(context.Background(), contract_id, id)
+}
+
+func (c BillingClient) DeleteServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/service-contracts/"+c.toStr(contract_id)+"/positions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/service-contracts/"+core.ToStr(contract_id)+"/positions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateServiceContractPosition(in PositionUpdateRequest, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
+    return c.UpdateServiceContractPositionWithContext(context.Background(), in, contract_id, id)
+}
+
+func (c BillingClient) UpdateServiceContractPositionWithContext(ctx context.Context, in PositionUpdateRequest, contract_id string, id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
     body := ServiceContractPositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/service-contracts/"+c.toStr(contract_id)+"/positions/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/service-contracts/"+core.ToStr(contract_id)+"/positions/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateInvoice(in InvoiceCreateRequest) (InvoiceSingleResponse, *http.Response, error) {
+    return c.CreateInvoiceWithContext(context.Background(), in)
+}
+
+func (c BillingClient) CreateInvoiceWithContext(ctx context.Context, in InvoiceCreateRequest) (InvoiceSingleResponse, *http.Response, error) {
     body := InvoiceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/invoices", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/invoices", nil, in, &body)
     return body, res, err
 }
 
@@ -1128,58 +874,32 @@ type GetInvoicesQueryParams struct {
 }
 
 func (c BillingClient) GetInvoices(qParams GetInvoicesQueryParams) (InvoiceListResponse, *http.Response, error) {
+    return c.GetInvoicesWithContext(context.Background(), qParams)
+}
+
+func (c BillingClient) GetInvoicesWithContext(ctx context.Context, qParams GetInvoicesQueryParams) (InvoiceListResponse, *http.Response, error) {
     body := InvoiceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/invoices"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/invoices", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetDebit(id string) (DebitSingleResponse, *http.Response, error) {
+    return c.GetDebitWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetDebitWithContext(ctx context.Context, id string) (DebitSingleResponse, *http.Response, error) {
     body := DebitSingleResponse{}
-    res, j, err := c.Request("GET", "/debits/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/debits/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) CreateServiceContractPosition(in PositionCreateRequest, contract_id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
+    return c.CreateServiceContractPositionWithContext(context.Background(), in, contract_id)
+}
+
+func (c BillingClient) CreateServiceContractPositionWithContext(ctx context.Context, in PositionCreateRequest, contract_id string) (ServiceContractPositionSingleResponse, *http.Response, error) {
     body := ServiceContractPositionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/service-contracts/"+c.toStr(contract_id)+"/positions", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/service-contracts/"+core.ToStr(contract_id)+"/positions", nil, in, &body)
     return body, res, err
 }
 
@@ -1192,75 +912,42 @@ type GetServiceContractPositionsQueryParams struct {
 }
 
 func (c BillingClient) GetServiceContractPositions(contract_id string, qParams GetServiceContractPositionsQueryParams) (ServiceContractPositionListResponse, *http.Response, error) {
+    return c.GetServiceContractPositionsWithContext(context.Background(), contract_id, qParams)
+}
+
+func (c BillingClient) GetServiceContractPositionsWithContext(ctx context.Context, contract_id string, qParams GetServiceContractPositionsQueryParams) (ServiceContractPositionListResponse, *http.Response, error) {
     body := ServiceContractPositionListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/service-contracts/"+c.toStr(contract_id)+"/positions"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/service-contracts/"+core.ToStr(contract_id)+"/positions", qParams, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) GetServiceContract(id string) (ServiceContractSingleResponse, *http.Response, error) {
+    return c.GetServiceContractWithContext(context.Background(), id)
+}
+
+func (c BillingClient) GetServiceContractWithContext(ctx context.Context, id string) (ServiceContractSingleResponse, *http.Response, error) {
     body := ServiceContractSingleResponse{}
-    res, j, err := c.Request("GET", "/service-contracts/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/service-contracts/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) DeleteServiceContract(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServiceContractWithContext(context.Background(), id)
+}
+
+func (c BillingClient) DeleteServiceContractWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/service-contracts/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/service-contracts/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c BillingClient) UpdateServiceContract(in ServiceContractUpdateRequest, id string) (ServiceContractSingleResponse, *http.Response, error) {
+    return c.UpdateServiceContractWithContext(context.Background(), in, id)
+}
+
+func (c BillingClient) UpdateServiceContractWithContext(ctx context.Context, in ServiceContractUpdateRequest, id string) (ServiceContractSingleResponse, *http.Response, error) {
     body := ServiceContractSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/service-contracts/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/service-contracts/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
@@ -0,0 +1,77 @@
+package billing_test
+
+import (
+    "bytes"
+    "context"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/billing"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestInvoices(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/billing/invoices",
+        billing.Invoice{Id: "i1", CreatedAt: "2026-01-10T00:00:00Z"},
+        billing.Invoice{Id: "i2", CreatedAt: "2026-02-10T00:00:00Z"},
+        billing.Invoice{Id: "i3", CreatedAt: "2026-03-10T00:00:00Z"},
+    )
+    c := billing.NewClientWithUrl("token", s.ServiceUrl("billing"))
+
+    pageSize := 2
+    page := 2
+    list, _, err := c.GetInvoices(billing.GetInvoicesQueryParams{PageSize: &pageSize, Page: &page})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(list.Data) != 1 || list.Data[0].Id != "i3" || list.Pagination.Total != 3 {
+        t.Errorf("got %+v, %+v", list.Data, list.Pagination)
+    }
+
+    buf := bytes.Buffer{}
+    file, _, err := c.GetInvoiceFile("i2", &buf)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if file.Filename != "i2.pdf" || file.Size != int64(buf.Len()) || !strings.HasPrefix(buf.String(), "%PDF") {
+        t.Errorf("got %+v with %q", file, buf.String())
+    }
+}
+
+func TestDownloadInvoices(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/billing/invoices",
+        billing.Invoice{Id: "i1", CreatedAt: "2026-03-01T08:00:00Z"},
+        billing.Invoice{Id: "i2", CreatedAt: "2026-03-31T18:00:00Z"},
+        billing.Invoice{Id: "i3", CreatedAt: "2026-04-01T08:00:00Z"},
+    )
+    c := billing.NewClientWithUrl("token", s.ServiceUrl("billing"))
+
+    to, _ := time.Parse("2006-01-02", "2026-03-31")
+    dir := t.TempDir()
+    results, err := c.DownloadInvoices(context.Background(), dir, billing.InvoiceArchiveOptions{To: to})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(results) != 2 || results[0].Invoice.Id != "i1" || results[1].Invoice.Id != "i2" {
+        t.Fatalf("got %+v", results)
+    }
+    for _, result := range results {
+        if result.Err != nil {
+            t.Errorf("%s: %v", result.Invoice.Id, result.Err)
+        }
+        if _, err := os.Stat(filepath.Join(dir, "invoice-"+result.Invoice.Id+".pdf")); err != nil {
+            t.Error(err)
+        }
+    }
+
+    results, _ = c.DownloadInvoices(context.Background(), dir, billing.InvoiceArchiveOptions{To: to})
+    if len(results) != 2 || !results[0].Skipped {
+        t.Errorf("existing files not skipped: %+v", results)
+    }
+}
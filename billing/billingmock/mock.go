@@ -0,0 +1,579 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package billingmock
+
+import (
+    "context"
+    "io"
+    "net/http"
+    "sync"
+    "github.com/lumaserv/lumaserv-api-go/billing"
+)
+
+// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements billing.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+    CreateDebitMandateFunc func(ctx context.Context, in billing.DebitMandateCreateRequest) (billing.DebitMandateSingleResponse, *http.Response, error)
+    GetDebitMandatesFunc func(ctx context.Context, qParams billing.GetDebitMandatesQueryParams) (billing.DebitMandateListResponse, *http.Response, error)
+    CreateInvoicePositionFunc func(ctx context.Context, in billing.PositionCreateRequest, id string) (billing.InvoicePositionSingleResponse, *http.Response, error)
+    GetInvoicePositionsFunc func(ctx context.Context, id string, qParams billing.GetInvoicePositionsQueryParams) (billing.InvoicePositionListResponse, *http.Response, error)
+    GetBillingPositionFunc func(ctx context.Context, id string) (billing.BillingPositionSingleResponse, *http.Response, error)
+    DeleteBillingPositionFunc func(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error)
+    UpdateBillingPositionFunc func(ctx context.Context, in billing.BillingPositionUpdateRequest, id string) (billing.BillingPositionSingleResponse, *http.Response, error)
+    GetDebitMandateFunc func(ctx context.Context, id string) (billing.DebitMandateSingleResponse, *http.Response, error)
+    CreateBillingPositionFunc func(ctx context.Context, in billing.BillingPositionCreateRequest) (billing.BillingPositionSingleResponse, *http.Response, error)
+    GetBillingPositionsFunc func(ctx context.Context, qParams billing.GetBillingPositionsQueryParams) (billing.BillingPositionListResponse, *http.Response, error)
+    CreateCustomerFunc func(ctx context.Context, in billing.CustomerCreateRequest) (billing.CustomerSingleResponse, *http.Response, error)
+    GetCustomersFunc func(ctx context.Context, qParams billing.GetCustomersQueryParams) (billing.CustomerListResponse, *http.Response, error)
+    GetInvoicePositionFunc func(ctx context.Context, invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error)
+    DeleteInvoicePositionFunc func(ctx context.Context, invoice_id string, id string) (billing.EmptyResponse, *http.Response, error)
+    UpdateInvoicePositionFunc func(ctx context.Context, in billing.PositionUpdateRequest, invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error)
+    CreateServiceContractFunc func(ctx context.Context, in billing.ServiceContractCreateRequest) (billing.ServiceContractSingleResponse, *http.Response, error)
+    GetServiceContractsFunc func(ctx context.Context, qParams billing.GetServiceContractsQueryParams) (billing.ServiceContractListResponse, *http.Response, error)
+    GetDebitsFunc func(ctx context.Context, qParams billing.GetDebitsQueryParams) (billing.DebitListResponse, *http.Response, error)
+    GetCustomerFunc func(ctx context.Context, id int) (billing.CustomerSingleResponse, *http.Response, error)
+    UpdateCustomerFunc func(ctx context.Context, in billing.CustomerUpdateRequest, id int) (billing.CustomerSingleResponse, *http.Response, error)
+    GetInvoiceFunc func(ctx context.Context, id string) (billing.InvoiceSingleResponse, *http.Response, error)
+    DeleteInvoiceFunc func(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error)
+    UpdateInvoiceFunc func(ctx context.Context, in billing.InvoiceUpdateRequest, id string) (billing.InvoiceSingleResponse, *http.Response, error)
+    GetServiceContractPositionFunc func(ctx context.Context, contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error)
+    DeleteServiceContractPositionFunc func(ctx context.Context, contract_id string, id string) (billing.EmptyResponse, *http.Response, error)
+    UpdateServiceContractPositionFunc func(ctx context.Context, in billing.PositionUpdateRequest, contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error)
+    CreateInvoiceFunc func(ctx context.Context, in billing.InvoiceCreateRequest) (billing.InvoiceSingleResponse, *http.Response, error)
+    GetInvoicesFunc func(ctx context.Context, qParams billing.GetInvoicesQueryParams) (billing.InvoiceListResponse, *http.Response, error)
+    GetDebitFunc func(ctx context.Context, id string) (billing.DebitSingleResponse, *http.Response, error)
+    CreateServiceContractPositionFunc func(ctx context.Context, in billing.PositionCreateRequest, contract_id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error)
+    GetServiceContractPositionsFunc func(ctx context.Context, contract_id string, qParams billing.GetServiceContractPositionsQueryParams) (billing.ServiceContractPositionListResponse, *http.Response, error)
+    GetServiceContractFunc func(ctx context.Context, id string) (billing.ServiceContractSingleResponse, *http.Response, error)
+    DeleteServiceContractFunc func(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error)
+    UpdateServiceContractFunc func(ctx context.Context, in billing.ServiceContractUpdateRequest, id string) (billing.ServiceContractSingleResponse, *http.Response, error)
+    GetInvoiceFileFunc func(ctx context.Context, id string, w io.Writer) (billing.InvoiceFile, *http.Response, error)
+}
+
+var _ billing.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+
+func (m *Mock) CreateDebitMandate(in billing.DebitMandateCreateRequest) (billing.DebitMandateSingleResponse, *http.Response, error) {
+    return m.CreateDebitMandateWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateDebitMandateWithContext(ctx context.Context, in billing.DebitMandateCreateRequest) (billing.DebitMandateSingleResponse, *http.Response, error) {
+    m.record("CreateDebitMandate", in)
+    if m.CreateDebitMandateFunc != nil {
+        return m.CreateDebitMandateFunc(ctx, in)
+    }
+    return billing.DebitMandateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetDebitMandates(qParams billing.GetDebitMandatesQueryParams) (billing.DebitMandateListResponse, *http.Response, error) {
+    return m.GetDebitMandatesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDebitMandatesWithContext(ctx context.Context, qParams billing.GetDebitMandatesQueryParams) (billing.DebitMandateListResponse, *http.Response, error) {
+    m.record("GetDebitMandates", qParams)
+    if m.GetDebitMandatesFunc != nil {
+        return m.GetDebitMandatesFunc(ctx, qParams)
+    }
+    return billing.DebitMandateListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDebitMandates(ctx context.Context, qParams billing.GetDebitMandatesQueryParams) *billing.DebitMandateIterator {
+    return billing.NewDebitMandateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.DebitMandate, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDebitMandatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateInvoicePosition(in billing.PositionCreateRequest, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    return m.CreateInvoicePositionWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateInvoicePositionWithContext(ctx context.Context, in billing.PositionCreateRequest, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    m.record("CreateInvoicePosition", in, id)
+    if m.CreateInvoicePositionFunc != nil {
+        return m.CreateInvoicePositionFunc(ctx, in, id)
+    }
+    return billing.InvoicePositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetInvoicePositions(id string, qParams billing.GetInvoicePositionsQueryParams) (billing.InvoicePositionListResponse, *http.Response, error) {
+    return m.GetInvoicePositionsWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetInvoicePositionsWithContext(ctx context.Context, id string, qParams billing.GetInvoicePositionsQueryParams) (billing.InvoicePositionListResponse, *http.Response, error) {
+    m.record("GetInvoicePositions", id, qParams)
+    if m.GetInvoicePositionsFunc != nil {
+        return m.GetInvoicePositionsFunc(ctx, id, qParams)
+    }
+    return billing.InvoicePositionListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllInvoicePositions(ctx context.Context, id string, qParams billing.GetInvoicePositionsQueryParams) *billing.PositionIterator {
+    return billing.NewPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.Position, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetInvoicePositionsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetBillingPosition(id string) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    return m.GetBillingPositionWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetBillingPositionWithContext(ctx context.Context, id string) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    m.record("GetBillingPosition", id)
+    if m.GetBillingPositionFunc != nil {
+        return m.GetBillingPositionFunc(ctx, id)
+    }
+    return billing.BillingPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteBillingPosition(id string) (billing.EmptyResponse, *http.Response, error) {
+    return m.DeleteBillingPositionWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteBillingPositionWithContext(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error) {
+    m.record("DeleteBillingPosition", id)
+    if m.DeleteBillingPositionFunc != nil {
+        return m.DeleteBillingPositionFunc(ctx, id)
+    }
+    return billing.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateBillingPosition(in billing.BillingPositionUpdateRequest, id string) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    return m.UpdateBillingPositionWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateBillingPositionWithContext(ctx context.Context, in billing.BillingPositionUpdateRequest, id string) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    m.record("UpdateBillingPosition", in, id)
+    if m.UpdateBillingPositionFunc != nil {
+        return m.UpdateBillingPositionFunc(ctx, in, id)
+    }
+    return billing.BillingPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetDebitMandate(id string) (billing.DebitMandateSingleResponse, *http.Response, error) {
+    return m.GetDebitMandateWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetDebitMandateWithContext(ctx context.Context, id string) (billing.DebitMandateSingleResponse, *http.Response, error) {
+    m.record("GetDebitMandate", id)
+    if m.GetDebitMandateFunc != nil {
+        return m.GetDebitMandateFunc(ctx, id)
+    }
+    return billing.DebitMandateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateBillingPosition(in billing.BillingPositionCreateRequest) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    return m.CreateBillingPositionWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateBillingPositionWithContext(ctx context.Context, in billing.BillingPositionCreateRequest) (billing.BillingPositionSingleResponse, *http.Response, error) {
+    m.record("CreateBillingPosition", in)
+    if m.CreateBillingPositionFunc != nil {
+        return m.CreateBillingPositionFunc(ctx, in)
+    }
+    return billing.BillingPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetBillingPositions(qParams billing.GetBillingPositionsQueryParams) (billing.BillingPositionListResponse, *http.Response, error) {
+    return m.GetBillingPositionsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetBillingPositionsWithContext(ctx context.Context, qParams billing.GetBillingPositionsQueryParams) (billing.BillingPositionListResponse, *http.Response, error) {
+    m.record("GetBillingPositions", qParams)
+    if m.GetBillingPositionsFunc != nil {
+        return m.GetBillingPositionsFunc(ctx, qParams)
+    }
+    return billing.BillingPositionListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllBillingPositions(ctx context.Context, qParams billing.GetBillingPositionsQueryParams) *billing.BillingPositionIterator {
+    return billing.NewBillingPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.BillingPosition, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetBillingPositionsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateCustomer(in billing.CustomerCreateRequest) (billing.CustomerSingleResponse, *http.Response, error) {
+    return m.CreateCustomerWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateCustomerWithContext(ctx context.Context, in billing.CustomerCreateRequest) (billing.CustomerSingleResponse, *http.Response, error) {
+    m.record("CreateCustomer", in)
+    if m.CreateCustomerFunc != nil {
+        return m.CreateCustomerFunc(ctx, in)
+    }
+    return billing.CustomerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetCustomers(qParams billing.GetCustomersQueryParams) (billing.CustomerListResponse, *http.Response, error) {
+    return m.GetCustomersWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetCustomersWithContext(ctx context.Context, qParams billing.GetCustomersQueryParams) (billing.CustomerListResponse, *http.Response, error) {
+    m.record("GetCustomers", qParams)
+    if m.GetCustomersFunc != nil {
+        return m.GetCustomersFunc(ctx, qParams)
+    }
+    return billing.CustomerListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllCustomers(ctx context.Context, qParams billing.GetCustomersQueryParams) *billing.CustomerIterator {
+    return billing.NewCustomerIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.Customer, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetCustomersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetInvoicePosition(invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    return m.GetInvoicePositionWithContext(context.Background(), invoice_id, id)
+}
+
+func (m *Mock) GetInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    m.record("GetInvoicePosition", invoice_id, id)
+    if m.GetInvoicePositionFunc != nil {
+        return m.GetInvoicePositionFunc(ctx, invoice_id, id)
+    }
+    return billing.InvoicePositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteInvoicePosition(invoice_id string, id string) (billing.EmptyResponse, *http.Response, error) {
+    return m.DeleteInvoicePositionWithContext(context.Background(), invoice_id, id)
+}
+
+func (m *Mock) DeleteInvoicePositionWithContext(ctx context.Context, invoice_id string, id string) (billing.EmptyResponse, *http.Response, error) {
+    m.record("DeleteInvoicePosition", invoice_id, id)
+    if m.DeleteInvoicePositionFunc != nil {
+        return m.DeleteInvoicePositionFunc(ctx, invoice_id, id)
+    }
+    return billing.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateInvoicePosition(in billing.PositionUpdateRequest, invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    return m.UpdateInvoicePositionWithContext(context.Background(), in, invoice_id, id)
+}
+
+func (m *Mock) UpdateInvoicePositionWithContext(ctx context.Context, in billing.PositionUpdateRequest, invoice_id string, id string) (billing.InvoicePositionSingleResponse, *http.Response, error) {
+    m.record("UpdateInvoicePosition", in, invoice_id, id)
+    if m.UpdateInvoicePositionFunc != nil {
+        return m.UpdateInvoicePositionFunc(ctx, in, invoice_id, id)
+    }
+    return billing.InvoicePositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServiceContract(in billing.ServiceContractCreateRequest) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    return m.CreateServiceContractWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServiceContractWithContext(ctx context.Context, in billing.ServiceContractCreateRequest) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    m.record("CreateServiceContract", in)
+    if m.CreateServiceContractFunc != nil {
+        return m.CreateServiceContractFunc(ctx, in)
+    }
+    return billing.ServiceContractSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServiceContracts(qParams billing.GetServiceContractsQueryParams) (billing.ServiceContractListResponse, *http.Response, error) {
+    return m.GetServiceContractsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServiceContractsWithContext(ctx context.Context, qParams billing.GetServiceContractsQueryParams) (billing.ServiceContractListResponse, *http.Response, error) {
+    m.record("GetServiceContracts", qParams)
+    if m.GetServiceContractsFunc != nil {
+        return m.GetServiceContractsFunc(ctx, qParams)
+    }
+    return billing.ServiceContractListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServiceContracts(ctx context.Context, qParams billing.GetServiceContractsQueryParams) *billing.ServiceContractIterator {
+    return billing.NewServiceContractIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.ServiceContract, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServiceContractsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetDebits(qParams billing.GetDebitsQueryParams) (billing.DebitListResponse, *http.Response, error) {
+    return m.GetDebitsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDebitsWithContext(ctx context.Context, qParams billing.GetDebitsQueryParams) (billing.DebitListResponse, *http.Response, error) {
+    m.record("GetDebits", qParams)
+    if m.GetDebitsFunc != nil {
+        return m.GetDebitsFunc(ctx, qParams)
+    }
+    return billing.DebitListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDebits(ctx context.Context, qParams billing.GetDebitsQueryParams) *billing.DebitIterator {
+    return billing.NewDebitIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.Debit, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDebitsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetCustomer(id int) (billing.CustomerSingleResponse, *http.Response, error) {
+    return m.GetCustomerWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetCustomerWithContext(ctx context.Context, id int) (billing.CustomerSingleResponse, *http.Response, error) {
+    m.record("GetCustomer", id)
+    if m.GetCustomerFunc != nil {
+        return m.GetCustomerFunc(ctx, id)
+    }
+    return billing.CustomerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateCustomer(in billing.CustomerUpdateRequest, id int) (billing.CustomerSingleResponse, *http.Response, error) {
+    return m.UpdateCustomerWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateCustomerWithContext(ctx context.Context, in billing.CustomerUpdateRequest, id int) (billing.CustomerSingleResponse, *http.Response, error) {
+    m.record("UpdateCustomer", in, id)
+    if m.UpdateCustomerFunc != nil {
+        return m.UpdateCustomerFunc(ctx, in, id)
+    }
+    return billing.CustomerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetInvoice(id string) (billing.InvoiceSingleResponse, *http.Response, error) {
+    return m.GetInvoiceWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetInvoiceWithContext(ctx context.Context, id string) (billing.InvoiceSingleResponse, *http.Response, error) {
+    m.record("GetInvoice", id)
+    if m.GetInvoiceFunc != nil {
+        return m.GetInvoiceFunc(ctx, id)
+    }
+    return billing.InvoiceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteInvoice(id string) (billing.EmptyResponse, *http.Response, error) {
+    return m.DeleteInvoiceWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteInvoiceWithContext(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error) {
+    m.record("DeleteInvoice", id)
+    if m.DeleteInvoiceFunc != nil {
+        return m.DeleteInvoiceFunc(ctx, id)
+    }
+    return billing.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateInvoice(in billing.InvoiceUpdateRequest, id string) (billing.InvoiceSingleResponse, *http.Response, error) {
+    return m.UpdateInvoiceWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateInvoiceWithContext(ctx context.Context, in billing.InvoiceUpdateRequest, id string) (billing.InvoiceSingleResponse, *http.Response, error) {
+    m.record("UpdateInvoice", in, id)
+    if m.UpdateInvoiceFunc != nil {
+        return m.UpdateInvoiceFunc(ctx, in, id)
+    }
+    return billing.InvoiceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServiceContractPosition(contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    return m.GetServiceContractPositionWithContext(context.Background(), contract_id, id)
+}
+
+func (m *Mock) GetServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    m.record("GetServiceContractPosition", contract_id, id)
+    if m.GetServiceContractPositionFunc != nil {
+        return m.GetServiceContractPositionFunc(ctx, contract_id, id)
+    }
+    return billing.ServiceContractPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServiceContractPosition(contract_id string, id string) (billing.EmptyResponse, *http.Response, error) {
+    return m.DeleteServiceContractPositionWithContext(context.Background(), contract_id, id)
+}
+
+func (m *Mock) DeleteServiceContractPositionWithContext(ctx context.Context, contract_id string, id string) (billing.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServiceContractPosition", contract_id, id)
+    if m.DeleteServiceContractPositionFunc != nil {
+        return m.DeleteServiceContractPositionFunc(ctx, contract_id, id)
+    }
+    return billing.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServiceContractPosition(in billing.PositionUpdateRequest, contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    return m.UpdateServiceContractPositionWithContext(context.Background(), in, contract_id, id)
+}
+
+func (m *Mock) UpdateServiceContractPositionWithContext(ctx context.Context, in billing.PositionUpdateRequest, contract_id string, id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    m.record("UpdateServiceContractPosition", in, contract_id, id)
+    if m.UpdateServiceContractPositionFunc != nil {
+        return m.UpdateServiceContractPositionFunc(ctx, in, contract_id, id)
+    }
+    return billing.ServiceContractPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateInvoice(in billing.InvoiceCreateRequest) (billing.InvoiceSingleResponse, *http.Response, error) {
+    return m.CreateInvoiceWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateInvoiceWithContext(ctx context.Context, in billing.InvoiceCreateRequest) (billing.InvoiceSingleResponse, *http.Response, error) {
+    m.record("CreateInvoice", in)
+    if m.CreateInvoiceFunc != nil {
+        return m.CreateInvoiceFunc(ctx, in)
+    }
+    return billing.InvoiceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetInvoices(qParams billing.GetInvoicesQueryParams) (billing.InvoiceListResponse, *http.Response, error) {
+    return m.GetInvoicesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetInvoicesWithContext(ctx context.Context, qParams billing.GetInvoicesQueryParams) (billing.InvoiceListResponse, *http.Response, error) {
+    m.record("GetInvoices", qParams)
+    if m.GetInvoicesFunc != nil {
+        return m.GetInvoicesFunc(ctx, qParams)
+    }
+    return billing.InvoiceListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllInvoices(ctx context.Context, qParams billing.GetInvoicesQueryParams) *billing.InvoiceIterator {
+    return billing.NewInvoiceIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.Invoice, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetInvoicesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetDebit(id string) (billing.DebitSingleResponse, *http.Response, error) {
+    return m.GetDebitWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetDebitWithContext(ctx context.Context, id string) (billing.DebitSingleResponse, *http.Response, error) {
+    m.record("GetDebit", id)
+    if m.GetDebitFunc != nil {
+        return m.GetDebitFunc(ctx, id)
+    }
+    return billing.DebitSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServiceContractPosition(in billing.PositionCreateRequest, contract_id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    return m.CreateServiceContractPositionWithContext(context.Background(), in, contract_id)
+}
+
+func (m *Mock) CreateServiceContractPositionWithContext(ctx context.Context, in billing.PositionCreateRequest, contract_id string) (billing.ServiceContractPositionSingleResponse, *http.Response, error) {
+    m.record("CreateServiceContractPosition", in, contract_id)
+    if m.CreateServiceContractPositionFunc != nil {
+        return m.CreateServiceContractPositionFunc(ctx, in, contract_id)
+    }
+    return billing.ServiceContractPositionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServiceContractPositions(contract_id string, qParams billing.GetServiceContractPositionsQueryParams) (billing.ServiceContractPositionListResponse, *http.Response, error) {
+    return m.GetServiceContractPositionsWithContext(context.Background(), contract_id, qParams)
+}
+
+func (m *Mock) GetServiceContractPositionsWithContext(ctx context.Context, contract_id string, qParams billing.GetServiceContractPositionsQueryParams) (billing.ServiceContractPositionListResponse, *http.Response, error) {
+    m.record("GetServiceContractPositions", contract_id, qParams)
+    if m.GetServiceContractPositionsFunc != nil {
+        return m.GetServiceContractPositionsFunc(ctx, contract_id, qParams)
+    }
+    return billing.ServiceContractPositionListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServiceContractPositions(ctx context.Context, contract_id string, qParams billing.GetServiceContractPositionsQueryParams) *billing.ServiceContractPositionIterator {
+    return billing.NewServiceContractPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]billing.ServiceContractPosition, *billing.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServiceContractPositionsWithContext(ctx, contract_id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServiceContract(id string) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    return m.GetServiceContractWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServiceContractWithContext(ctx context.Context, id string) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    m.record("GetServiceContract", id)
+    if m.GetServiceContractFunc != nil {
+        return m.GetServiceContractFunc(ctx, id)
+    }
+    return billing.ServiceContractSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServiceContract(id string) (billing.EmptyResponse, *http.Response, error) {
+    return m.DeleteServiceContractWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServiceContractWithContext(ctx context.Context, id string) (billing.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServiceContract", id)
+    if m.DeleteServiceContractFunc != nil {
+        return m.DeleteServiceContractFunc(ctx, id)
+    }
+    return billing.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServiceContract(in billing.ServiceContractUpdateRequest, id string) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    return m.UpdateServiceContractWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServiceContractWithContext(ctx context.Context, in billing.ServiceContractUpdateRequest, id string) (billing.ServiceContractSingleResponse, *http.Response, error) {
+    m.record("UpdateServiceContract", in, id)
+    if m.UpdateServiceContractFunc != nil {
+        return m.UpdateServiceContractFunc(ctx, in, id)
+    }
+    return billing.ServiceContractSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetInvoiceFile(id string, w io.Writer) (billing.InvoiceFile, *http.Response, error) {
+    return m.GetInvoiceFileWithContext(context.Background(), id, w)
+}
+
+func (m *Mock) GetInvoiceFileWithContext(ctx context.Context, id string, w io.Writer) (billing.InvoiceFile, *http.Response, error) {
+    m.record("GetInvoiceFile", id, w)
+    if m.GetInvoiceFileFunc != nil {
+        return m.GetInvoiceFileFunc(ctx, id, w)
+    }
+    return billing.InvoiceFile{}, nil, nil
+}
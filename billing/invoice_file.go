@@ -0,0 +1,177 @@
+package billing
+
+import (
+    "context"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "mime"
+    "net/http"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// InvoiceFile describes an invoice document downloaded by GetInvoiceFile.
+type InvoiceFile struct {
+    ContentType string
+    Filename string
+    Size int64
+}
+
+type countingWriter struct {
+    w io.Writer
+    n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+    n, err := cw.w.Write(p)
+    cw.n += int64(n)
+    return n, err
+}
+
+// GetInvoiceFile streams the document (usually a PDF) of the invoice to w.
+func (c BillingClient) GetInvoiceFile(id string, w io.Writer) (InvoiceFile, *http.Response, error) {
+    return c.GetInvoiceFileWithContext(context.Background(), id, w)
+}
+
+func (c BillingClient) GetInvoiceFileWithContext(ctx context.Context, id string, w io.Writer) (InvoiceFile, *http.Response, error) {
+    file := InvoiceFile{}
+    cw := &countingWriter{w: w}
+    res, err := c.DownloadWithContext(ctx, "/invoices/"+core.ToStr(id)+"/file", "application/pdf, */*", cw)
+    file.Size = cw.n
+    if res != nil {
+        file.ContentType = res.Header.Get("Content-Type")
+        file.Filename = invoiceFilename(res.Header.Get("Content-Disposition"), id)
+    }
+    return file, res, err
+}
+
+func invoiceFilename(disposition string, id string) string {
+    if _, params, err := mime.ParseMediaType(disposition); err == nil {
+        if name := filepath.Base(params["filename"]); len(params["filename"]) > 0 && name != "." && name != "/" {
+            return name
+        }
+    }
+    return "invoice-" + id + ".pdf"
+}
+
+// InvoiceArchiveOptions selects the invoices downloaded by DownloadInvoices. Zero values
+// do not restrict the selection.
+type InvoiceArchiveOptions struct {
+    CustomerId string
+    // From and To bound the creation date of the invoices, both inclusive. A To without a
+    // time of day, like a date parsed from "2006-01-02", includes that whole day.
+    From time.Time
+    To time.Time
+    States []InvoiceState
+    // Overwrite replaces files that already exist in the directory instead of skipping them.
+    Overwrite bool
+}
+
+// InvoiceDownload is the result of downloading a single invoice to the archive.
+type InvoiceDownload struct {
+    Invoice Invoice
+    Path string
+    Skipped bool
+    Err error
+}
+
+func (o InvoiceArchiveOptions) matches(invoice Invoice) bool {
+    if len(o.States) > 0 {
+        found := false
+        for _, state := range o.States {
+            if invoice.State == state {
+                found = true
+            }
+        }
+        if !found {
+            return false
+        }
+    }
+    if o.From.IsZero() && o.To.IsZero() {
+        return true
+    }
+    createdAt, err := parseInvoiceDate(invoice.CreatedAt)
+    if err != nil {
+        return false
+    }
+    if !o.From.IsZero() && createdAt.Before(o.From) {
+        return false
+    }
+    if !o.To.IsZero() && !createdAt.Before(o.end()) {
+        return false
+    }
+    return true
+}
+
+// end returns the exclusive upper bound of the creation date.
+func (o InvoiceArchiveOptions) end() time.Time {
+    if o.To.Equal(time.Date(o.To.Year(), o.To.Month(), o.To.Day(), 0, 0, 0, 0, o.To.Location())) {
+        return o.To.AddDate(0, 0, 1)
+    }
+    return o.To.Add(time.Nanosecond)
+}
+
+func parseInvoiceDate(value string) (time.Time, error) {
+    if t, err := time.Parse(time.RFC3339, value); err == nil {
+        return t, nil
+    }
+    return time.Parse("2006-01-02", value)
+}
+
+// DownloadInvoices downloads the documents of all invoices matching opts into dir. Files
+// are named invoice-<id>.pdf, failures of single invoices are reported in the results
+// and do not abort the download of the remaining ones.
+func (c BillingClient) DownloadInvoices(ctx context.Context, dir string, opts InvoiceArchiveOptions) ([]InvoiceDownload, error) {
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return nil, err
+    }
+
+    qParams := GetInvoicesQueryParams{}
+    if len(opts.CustomerId) > 0 {
+        qParams.Filter = &GetInvoicesQueryParamsFilter{CustomerId: &opts.CustomerId}
+    }
+
+    results := []InvoiceDownload{}
+    it := c.ListAllInvoices(ctx, qParams)
+    for it.Next() {
+        invoice := it.Value()
+        if !opts.matches(invoice) {
+            continue
+        }
+        result := InvoiceDownload{
+            Invoice: invoice,
+            Path: filepath.Join(dir, "invoice-"+invoice.Id+".pdf"),
+        }
+        if _, err := os.Stat(result.Path); err == nil && !opts.Overwrite {
+            result.Skipped = true
+        } else {
+            result.Err = c.downloadInvoice(ctx, invoice.Id, result.Path)
+        }
+        results = append(results, result)
+    }
+    return results, it.Err()
+}
+
+func (c BillingClient) downloadInvoice(ctx context.Context, id string, path string) error {
+    tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+    if err != nil {
+        return err
+    }
+    defer os.Remove(tmp.Name())
+
+    file, _, err := c.GetInvoiceFileWithContext(ctx, id, tmp)
+    if closeErr := tmp.Close(); err == nil {
+        err = closeErr
+    }
+    if err != nil {
+        return fmt.Errorf("downloading invoice %s: %w", id, err)
+    }
+    if len(file.ContentType) > 0 && !strings.HasPrefix(file.ContentType, "application/pdf") {
+        return fmt.Errorf("downloading invoice %s: unexpected content type %s", id, file.ContentType)
+    }
+    return os.Rename(tmp.Name(), path)
+}
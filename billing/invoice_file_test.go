@@ -0,0 +1,38 @@
+package billing
+
+import (
+    "testing"
+    "time"
+)
+
+func TestInvoiceArchiveOptionsMatches(t *testing.T) {
+    day := func(s string) time.Time {
+        d, _ := time.Parse("2006-01-02", s)
+        return d
+    }
+    tests := []struct {
+        name string
+        opts InvoiceArchiveOptions
+        createdAt string
+        state InvoiceState
+        want bool
+    }{
+        {"no restriction", InvoiceArchiveOptions{}, "", "", true},
+        {"date-only To includes the day", InvoiceArchiveOptions{To: day("2026-03-31")}, "2026-03-31T18:30:00Z", "", true},
+        {"date-only To excludes the next day", InvoiceArchiveOptions{To: day("2026-03-31")}, "2026-04-01T00:00:00Z", "", false},
+        {"To with time is inclusive", InvoiceArchiveOptions{To: time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)}, "2026-03-31T12:00:00Z", "", true},
+        {"To with time excludes later", InvoiceArchiveOptions{To: time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)}, "2026-03-31T12:00:01Z", "", false},
+        {"From is inclusive", InvoiceArchiveOptions{From: day("2026-03-01")}, "2026-03-01T00:00:00Z", "", true},
+        {"before From", InvoiceArchiveOptions{From: day("2026-03-01")}, "2026-02-28T23:59:59Z", "", false},
+        {"date-only created_at", InvoiceArchiveOptions{From: day("2026-03-01"), To: day("2026-03-01")}, "2026-03-01", "", true},
+        {"unparsable created_at", InvoiceArchiveOptions{From: day("2026-03-01")}, "yesterday", "", false},
+        {"state", InvoiceArchiveOptions{States: []InvoiceState{"PAID"}}, "", "PAID", true},
+        {"other state", InvoiceArchiveOptions{States: []InvoiceState{"PAID"}}, "", "UNPAID", false},
+    }
+    for _, tt := range tests {
+        got := tt.opts.matches(Invoice{CreatedAt: tt.createdAt, State: tt.state})
+        if got != tt.want {
+            t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+        }
+    }
+}
@@ -0,0 +1,512 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package billing
+
+import (
+    "context"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// DebitMandateIterator iterates over the DebitMandate items of all pages of a list.
+type DebitMandateIterator struct {
+    pager *core.Pager
+    items []DebitMandate
+    current DebitMandate
+}
+
+// NewDebitMandateIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDebitMandateIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]DebitMandate, *ResponsePagination, error)) *DebitMandateIterator {
+    it := &DebitMandateIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DebitMandateIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DebitMandateIterator) Value() DebitMandate {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DebitMandateIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DebitMandateIterator) All() ([]DebitMandate, error) {
+    all := []DebitMandate{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// PositionIterator iterates over the Position items of all pages of a list.
+type PositionIterator struct {
+    pager *core.Pager
+    items []Position
+    current Position
+}
+
+// NewPositionIterator returns an iterator over the items returned by fetch, starting at page.
+func NewPositionIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Position, *ResponsePagination, error)) *PositionIterator {
+    it := &PositionIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *PositionIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *PositionIterator) Value() Position {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *PositionIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *PositionIterator) All() ([]Position, error) {
+    all := []Position{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// BillingPositionIterator iterates over the BillingPosition items of all pages of a list.
+type BillingPositionIterator struct {
+    pager *core.Pager
+    items []BillingPosition
+    current BillingPosition
+}
+
+// NewBillingPositionIterator returns an iterator over the items returned by fetch, starting at page.
+func NewBillingPositionIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]BillingPosition, *ResponsePagination, error)) *BillingPositionIterator {
+    it := &BillingPositionIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *BillingPositionIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *BillingPositionIterator) Value() BillingPosition {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *BillingPositionIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *BillingPositionIterator) All() ([]BillingPosition, error) {
+    all := []BillingPosition{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// CustomerIterator iterates over the Customer items of all pages of a list.
+type CustomerIterator struct {
+    pager *core.Pager
+    items []Customer
+    current Customer
+}
+
+// NewCustomerIterator returns an iterator over the items returned by fetch, starting at page.
+func NewCustomerIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Customer, *ResponsePagination, error)) *CustomerIterator {
+    it := &CustomerIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *CustomerIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *CustomerIterator) Value() Customer {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *CustomerIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *CustomerIterator) All() ([]Customer, error) {
+    all := []Customer{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServiceContractIterator iterates over the ServiceContract items of all pages of a list.
+type ServiceContractIterator struct {
+    pager *core.Pager
+    items []ServiceContract
+    current ServiceContract
+}
+
+// NewServiceContractIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServiceContractIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServiceContract, *ResponsePagination, error)) *ServiceContractIterator {
+    it := &ServiceContractIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServiceContractIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServiceContractIterator) Value() ServiceContract {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServiceContractIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServiceContractIterator) All() ([]ServiceContract, error) {
+    all := []ServiceContract{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// DebitIterator iterates over the Debit items of all pages of a list.
+type DebitIterator struct {
+    pager *core.Pager
+    items []Debit
+    current Debit
+}
+
+// NewDebitIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDebitIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Debit, *ResponsePagination, error)) *DebitIterator {
+    it := &DebitIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DebitIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DebitIterator) Value() Debit {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DebitIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DebitIterator) All() ([]Debit, error) {
+    all := []Debit{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// InvoiceIterator iterates over the Invoice items of all pages of a list.
+type InvoiceIterator struct {
+    pager *core.Pager
+    items []Invoice
+    current Invoice
+}
+
+// NewInvoiceIterator returns an iterator over the items returned by fetch, starting at page.
+func NewInvoiceIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Invoice, *ResponsePagination, error)) *InvoiceIterator {
+    it := &InvoiceIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *InvoiceIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *InvoiceIterator) Value() Invoice {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *InvoiceIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *InvoiceIterator) All() ([]Invoice, error) {
+    all := []Invoice{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServiceContractPositionIterator iterates over the ServiceContractPosition items of all pages of a list.
+type ServiceContractPositionIterator struct {
+    pager *core.Pager
+    items []ServiceContractPosition
+    current ServiceContractPosition
+}
+
+// NewServiceContractPositionIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServiceContractPositionIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServiceContractPosition, *ResponsePagination, error)) *ServiceContractPositionIterator {
+    it := &ServiceContractPositionIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServiceContractPositionIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServiceContractPositionIterator) Value() ServiceContractPosition {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServiceContractPositionIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServiceContractPositionIterator) All() ([]ServiceContractPosition, error) {
+    all := []ServiceContractPosition{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ListAllDebitMandates iterates over the items of all pages of GetDebitMandates.
+func (c BillingClient) ListAllDebitMandates(ctx context.Context, qParams GetDebitMandatesQueryParams) *DebitMandateIterator {
+    return NewDebitMandateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]DebitMandate, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDebitMandatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllInvoicePositions iterates over the items of all pages of GetInvoicePositions.
+func (c BillingClient) ListAllInvoicePositions(ctx context.Context, id string, qParams GetInvoicePositionsQueryParams) *PositionIterator {
+    return NewPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Position, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetInvoicePositionsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllBillingPositions iterates over the items of all pages of GetBillingPositions.
+func (c BillingClient) ListAllBillingPositions(ctx context.Context, qParams GetBillingPositionsQueryParams) *BillingPositionIterator {
+    return NewBillingPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]BillingPosition, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetBillingPositionsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllCustomers iterates over the items of all pages of GetCustomers.
+func (c BillingClient) ListAllCustomers(ctx context.Context, qParams GetCustomersQueryParams) *CustomerIterator {
+    return NewCustomerIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Customer, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetCustomersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServiceContracts iterates over the items of all pages of GetServiceContracts.
+func (c BillingClient) ListAllServiceContracts(ctx context.Context, qParams GetServiceContractsQueryParams) *ServiceContractIterator {
+    return NewServiceContractIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServiceContract, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServiceContractsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllDebits iterates over the items of all pages of GetDebits.
+func (c BillingClient) ListAllDebits(ctx context.Context, qParams GetDebitsQueryParams) *DebitIterator {
+    return NewDebitIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Debit, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDebitsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllInvoices iterates over the items of all pages of GetInvoices.
+func (c BillingClient) ListAllInvoices(ctx context.Context, qParams GetInvoicesQueryParams) *InvoiceIterator {
+    return NewInvoiceIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Invoice, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetInvoicesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServiceContractPositions iterates over the items of all pages of GetServiceContractPositions.
+func (c BillingClient) ListAllServiceContractPositions(ctx context.Context, contract_id string, qParams GetServiceContractPositionsQueryParams) *ServiceContractPositionIterator {
+    return NewServiceContractPositionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServiceContractPosition, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServiceContractPositionsWithContext(ctx, contract_id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
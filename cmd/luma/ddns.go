@@ -0,0 +1,100 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "strings"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/ddns"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+var ddnsCommand = &command{
+    name: "ddns",
+    usage: "<zone> --host <name> [--host <name>] [--ipv4-url <url>] [--ipv6-url <url>] [--no-ipv4] [--no-ipv6] [--interface <name>] [--interval 5m] [--once]",
+    run: ddnsRun,
+}
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+    return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+    *s = append(*s, value)
+    return nil
+}
+
+func ddnsRun(a *app, args []string) error {
+    fs := flag.NewFlagSet("ddns", flag.ContinueOnError)
+    hosts := &stringsFlag{}
+    fs.Var(hosts, "host", "record name relative to the zone, can be repeated, @ for the apex")
+    ipv4Url := fs.String("ipv4-url", ddns.DefaultIPv4Url, "service returning the public IPv4 address")
+    ipv6Url := fs.String("ipv6-url", ddns.DefaultIPv6Url, "service returning the public IPv6 address")
+    noIPv4 := fs.Bool("no-ipv4", false, "do not update A records")
+    noIPv6 := fs.Bool("no-ipv6", false, "do not update AAAA records")
+    iface := fs.String("interface", "", "read the addresses from a network interface instead of the url services")
+    ttl := fs.Int("ttl", 0, "time to live of the records in seconds")
+    interval := fs.Duration("interval", time.Minute*5, "time between address checks")
+    once := fs.Bool("once", false, "update once and exit")
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    if len(*hosts) == 0 {
+        return usagef("ddns: at least one --host is required")
+    }
+
+    config := ddns.Config{
+        Zone: pos[0],
+        Interval: *interval,
+        OnUpdate: func(record domain.DNSRecord) {
+            fmt.Fprintf(a.out, "%s %s %s\n", recordLabel(record.Name), record.Type, record.Data)
+        },
+        OnError: func(err error) {
+            fmt.Fprintln(a.errOut, "luma: ddns:", err)
+        },
+    }
+    for _, host := range *hosts {
+        if host == "@" {
+            host = ""
+        }
+        config.Hostnames = append(config.Hostnames, host)
+    }
+    if *ttl > 0 {
+        config.Ttl = ttl
+    }
+    if !*noIPv4 {
+        config.IPv4 = ddns.HTTPSource("tcp4", *ipv4Url)
+        if len(*iface) > 0 {
+            config.IPv4 = ddns.InterfaceSource("tcp4", *iface)
+        }
+    }
+    if !*noIPv6 {
+        config.IPv6 = ddns.HTTPSource("tcp6", *ipv6Url)
+        if len(*iface) > 0 {
+            config.IPv6 = ddns.InterfaceSource("tcp6", *iface)
+        }
+    }
+    if config.IPv4 == nil && config.IPv6 == nil {
+        return usagef("ddns: --no-ipv4 and --no-ipv6 can not be combined")
+    }
+
+    updater := ddns.NewUpdater(a.domain, config)
+    if *once {
+        _, err := updater.Update(a.ctx)
+        return err
+    }
+    if err := updater.Run(a.ctx); err != a.ctx.Err() {
+        return err
+    }
+    return nil
+}
+
+func recordLabel(name string) string {
+    if len(name) == 0 {
+        return "@"
+    }
+    return name
+}
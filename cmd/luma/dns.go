@@ -0,0 +1,203 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+var dnsCommand = &command{
+    name: "dns",
+    sub: []*command{
+        {name: "zone", sub: []*command{
+            {name: "list", usage: "", run: dnsZoneList},
+            {name: "export", usage: "<zone> [--file <path>]", run: dnsZoneExport},
+            {name: "import", usage: "<zone> [--file <path>] [--replace]", run: dnsZoneImport},
+        }},
+        {name: "sync", usage: "<zone> --file <zonefile> [--dry-run] [--delete-protected]", run: dnsSync},
+        {name: "record", sub: []*command{
+            {name: "list", usage: "<zone>", run: dnsRecordList},
+            {name: "add", usage: "<zone> --name <name> --type <type> --data <data> [--ttl <ttl>]", run: dnsRecordAdd},
+            {name: "delete", usage: "<zone> <id>", run: dnsRecordDelete},
+        }},
+    },
+}
+
+func dnsZoneList(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns zone list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    zones, err := a.domain.ListAllDNSZones(a.ctx, domain.GetDNSZonesQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, z := range zones {
+        rows = append(rows, []string{z.Name, z.Type, z.Ns1, z.Ns2, labelStr(z.Labels)})
+    }
+    return a.print(zones, []string{"NAME", "TYPE", "NS1", "NS2", "LABELS"}, rows)
+}
+
+func dnsZoneExport(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns zone export", flag.ContinueOnError)
+    file := fs.String("file", "", "write the zone file to path instead of stdout")
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    w := a.out
+    if len(*file) > 0 {
+        f, err := os.Create(*file)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+        w = f
+    }
+    return a.domain.ExportZoneFile(a.ctx, pos[0], w)
+}
+
+func dnsZoneImport(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns zone import", flag.ContinueOnError)
+    file := fs.String("file", "", "read the zone file from path instead of stdin")
+    replace := fs.Bool("replace", false, "atomically replace all records of the zone")
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    var r io.Reader = os.Stdin
+    if len(*file) > 0 {
+        f, err := os.Open(*file)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+        r = f
+    }
+    if *replace {
+        desired, err := domain.ParseZoneFile(r, pos[0])
+        if err != nil {
+            return err
+        }
+        a.printSkipped(desired.Skipped)
+        records, err := a.domain.ReplaceDNSZoneRecords(a.ctx, pos[0], desired.Records)
+        if err != nil {
+            return err
+        }
+        return a.printRecords(records)
+    }
+    records, skipped, err := a.domain.ImportZoneFile(a.ctx, pos[0], r)
+    a.printSkipped(skipped)
+    if printErr := a.printRecords(records); err == nil {
+        err = printErr
+    }
+    return err
+}
+
+func dnsSync(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns sync", flag.ContinueOnError)
+    file := fs.String("file", "", "zone file with the desired records, - for stdin")
+    dryRun := fs.Bool("dry-run", false, "only print the changes")
+    deleteProtected := fs.Bool("delete-protected", false, "also delete NS and SOA records missing from the zone file")
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    if len(*file) == 0 {
+        return usagef("dns sync: --file is required")
+    }
+    var r io.Reader = os.Stdin
+    if *file != "-" {
+        f, err := os.Open(*file)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+        r = f
+    }
+    desired, err := domain.ParseZoneFile(r, pos[0])
+    if err != nil {
+        return err
+    }
+    a.printSkipped(desired.Skipped)
+
+    opts := domain.DNSSyncOptions{DryRun: *dryRun, DeleteProtected: *deleteProtected}
+    plan, err := a.domain.PlanDNSZoneRecords(a.ctx, pos[0], desired.Records, opts)
+    if err != nil {
+        return err
+    }
+    if err := plan.WriteDiff(a.out); err != nil {
+        return err
+    }
+    if opts.DryRun || plan.Empty() {
+        return nil
+    }
+    _, err = a.domain.ApplyDNSPlan(a.ctx, plan)
+    return err
+}
+
+// printSkipped reports records of the zone file that are not supported by the API.
+func (a *app) printSkipped(skipped []*domain.ZoneFileError) {
+    for _, e := range skipped {
+        fmt.Fprintln(a.errOut, "luma: zone file: skipped", e)
+    }
+}
+
+func (a *app) printRecords(records []domain.DNSRecord) error {
+    rows := [][]string{}
+    for _, r := range records {
+        rows = append(rows, []string{r.Id, r.Name, r.Type, intStr(r.Ttl), r.Data})
+    }
+    return a.print(records, []string{"ID", "NAME", "TYPE", "TTL", "DATA"}, rows)
+}
+
+func dnsRecordList(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns record list", flag.ContinueOnError)
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    records, err := a.domain.ListAllDNSZoneRecords(a.ctx, pos[0], domain.GetDNSZoneRecordsQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    return a.printRecords(records)
+}
+
+func dnsRecordAdd(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns record add", flag.ContinueOnError)
+    name := fs.String("name", "", "record name relative to the zone, empty for the apex")
+    recordType := fs.String("type", "", "record type, e.g. A or TXT")
+    data := fs.String("data", "", "record data")
+    ttl := fs.Int("ttl", 0, "time to live in seconds, zone default if omitted")
+    pos, err := parseFlags(fs, args, "zone")
+    if err != nil {
+        return err
+    }
+    if len(*recordType) == 0 || len(*data) == 0 {
+        return usagef("dns record add: --type and --data are required")
+    }
+
+    in := domain.DNSRecordCreateRequest{Name: *name, Type: *recordType, Data: *data}
+    if *ttl > 0 {
+        in.Ttl = ttl
+    }
+    record, err := a.domain.CreateValidDNSZoneRecord(a.ctx, pos[0], in)
+    if err != nil {
+        return err
+    }
+    return a.printRecords([]domain.DNSRecord{record})
+}
+
+func dnsRecordDelete(a *app, args []string) error {
+    fs := flag.NewFlagSet("dns record delete", flag.ContinueOnError)
+    pos, err := parseFlags(fs, args, "zone", "id")
+    if err != nil {
+        return err
+    }
+    _, _, err = a.domain.DeleteDNSRecordWithContext(a.ctx, pos[0], pos[1])
+    return err
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "strconv"
+    "strings"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+var domainCommand = &command{
+    name: "domain",
+    sub: []*command{
+        {name: "list", usage: "", run: domainList},
+        {name: "check", usage: "<name>", run: domainCheck},
+        {name: "search", usage: "[<label> --tld <tld> [--tld <tld>]] [--file <path>] [--workers 8] [--sort name|price|availability]", run: domainSearch},
+        {name: "transfer-in", usage: "<name> --authinfo <code> --owner <handle> [--admin <handle>] [--tech <handle>] [--zone <handle>] [--ns <host>] [--state <file>] [--wait]", run: domainTransferIn},
+        {name: "transfer-out", usage: "<name> [--state <file>] [--wait] [--cancel]", run: domainTransferOut},
+        {name: "monitor", usage: "[--days 30] [--all-projects] [--prometheus] [--webhook <url>] [--all]", run: domainMonitor},
+    },
+}
+
+func domainList(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    domains, err := a.domain.ListAllDomains(a.ctx, domain.GetDomainsQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, d := range domains {
+        rows = append(rows, []string{d.Name, d.Status.String(), str(d.ExpireAt), labelStr(d.Labels)})
+    }
+    return a.print(domains, []string{"NAME", "STATUS", "EXPIRES", "LABELS"}, rows)
+}
+
+func domainCheck(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain check", flag.ContinueOnError)
+    pos, err := parseFlags(fs, args, "name")
+    if err != nil {
+        return err
+    }
+    res, _, err := a.domain.CheckDomainWithContext(a.ctx, pos[0])
+    if err != nil {
+        return err
+    }
+    result := struct {
+        Name string `json:"name"`
+        Available bool `json:"available"`
+    }{pos[0], res.Data.Available}
+    return a.print(result, []string{"NAME", "AVAILABLE"}, [][]string{{result.Name, strconv.FormatBool(result.Available)}})
+}
+
+func domainMonitor(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain monitor", flag.ContinueOnError)
+    days := fs.Int("days", 30, "report domains expiring within this number of days")
+    allProjects := fs.Bool("all-projects", false, "scan the domains of all projects of the account")
+    prometheus := fs.Bool("prometheus", false, "write Prometheus gauges instead of the report")
+    webhook := fs.String("webhook", "", "post domains needing attention to this webhook url")
+    all := fs.Bool("all", false, "list all domains instead of only those needing attention")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+
+    opts := domain.DomainMonitorOptions{ExpiryDays: *days}
+    if *allProjects {
+        projects, err := a.auth.ListAllProjects(a.ctx, auth.GetProjectsQueryParams{}).All()
+        if err != nil {
+            return err
+        }
+        for _, p := range projects {
+            opts.ProjectIds = append(opts.ProjectIds, p.Id)
+        }
+    }
+    report, err := a.domain.ScanDomains(a.ctx, opts)
+    if err != nil {
+        return err
+    }
+    if len(*webhook) > 0 {
+        if err := report.SendWebhook(a.ctx, nil, *webhook); err != nil {
+            return err
+        }
+    }
+    if *prometheus {
+        return report.WritePrometheus(a.out)
+    }
+
+    states := report.Domains
+    if !*all {
+        states = report.Alerting()
+    }
+    rows := [][]string{}
+    for _, d := range states {
+        messages := []string{}
+        for _, alert := range d.Alerts {
+            messages = append(messages, alert.Message)
+        }
+        rows = append(rows, []string{d.Name, d.Status.String(), intStr(d.DaysLeft), strings.Join(messages, ", ")})
+    }
+    return a.print(states, []string{"NAME", "STATUS", "DAYS LEFT", "ALERTS"}, rows)
+}
+
+func priceStr(v *float32) string {
+    if v == nil {
+        return ""
+    }
+    return fmt.Sprintf("%.2f", *v)
+}
+
+func domainSearch(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain search", flag.ContinueOnError)
+    tlds := &stringsFlag{}
+    fs.Var(tlds, "tld", "tld to check the label with, can be repeated")
+    file := fs.String("file", "", "file with one candidate name per line, - for stdin")
+    workers := fs.Int("workers", 8, "number of concurrent checks")
+    sortBy := fs.String("sort", "name", "sort order: name, price or availability")
+    pos, err := parseFlags(fs, args, "label")
+    if err != nil {
+        // The label is optional when the candidates are read from a file.
+        *tlds = nil
+        if pos, err = parseFlags(fs, args); err != nil {
+            return usagef("usage: luma domain search [<label> --tld <tld>] [--file <path>]")
+        }
+    }
+    by := domain.DomainAvailabilitySort(*sortBy)
+    if by != domain.SortByName && by != domain.SortByPrice && by != domain.SortByAvailability {
+        return usagef("domain search: unknown sort order %q", *sortBy)
+    }
+
+    names := []string{}
+    if len(pos) > 0 {
+        if len(*tlds) == 0 {
+            return usagef("domain search: --tld is required with a label")
+        }
+        names = domain.DomainCandidates(pos[0], *tlds)
+    }
+    if len(*file) > 0 {
+        r := os.Stdin
+        if *file != "-" {
+            f, err := os.Open(*file)
+            if err != nil {
+                return err
+            }
+            defer f.Close()
+            r = f
+        }
+        candidates, err := domain.ReadDomainCandidates(r)
+        if err != nil {
+            return err
+        }
+        names = append(names, candidates...)
+    }
+    if len(names) == 0 {
+        return usagef("domain search: either <label> with --tld or --file is required")
+    }
+
+    results, err := a.domain.CheckDomainAvailability(a.ctx, names, domain.AvailabilityOptions{Workers: *workers, Sort: by})
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, r := range results {
+        available := strconv.FormatBool(r.Available)
+        if r.Err != nil {
+            available = "error: " + r.Err.Error()
+        }
+        row := []string{r.Name, available, "", "", ""}
+        if r.Pricing != nil {
+            row[2], row[3], row[4] = priceStr(r.Pricing.Create), priceStr(r.Pricing.Renew), priceStr(r.Pricing.Restore)
+        }
+        rows = append(rows, row)
+    }
+    return a.print(results, []string{"NAME", "AVAILABLE", "CREATE", "RENEW", "RESTORE"}, rows)
+}
+
+// runTransfer runs the transfer workflow and persists its state in stateFile after every
+// step, so that an interrupted transfer can be resumed with the same command.
+func (a *app) runTransfer(state domain.TransferState, stateFile string, wait bool) error {
+    opts := domain.TransferOptions{
+        SkipWait: !wait,
+        Timeout: time.Hour * 24,
+    }
+    if len(stateFile) > 0 {
+        opts.OnStep = func(s domain.TransferState) error {
+            return domain.SaveTransferState(stateFile, s)
+        }
+    }
+    err := a.domain.RunTransfer(a.ctx, &state, opts)
+    if len(stateFile) > 0 {
+        if saveErr := domain.SaveTransferState(stateFile, state); err == nil {
+            err = saveErr
+        }
+    }
+    if err != nil {
+        return err
+    }
+    return a.printTransfer(state)
+}
+
+func (a *app) printTransfer(state domain.TransferState) error {
+    validUntil := ""
+    if state.AuthinfoValidUntil != nil {
+        validUntil = state.AuthinfoValidUntil.Format(time.RFC3339)
+    }
+    row := []string{state.Domain, string(state.Direction), string(state.Step), state.Status.String(), state.Authinfo, validUntil}
+    return a.print(state, []string{"DOMAIN", "DIRECTION", "STEP", "STATUS", "AUTHINFO", "VALID UNTIL"}, [][]string{row})
+}
+
+// loadTransfer returns the state stored in stateFile if it exists.
+func loadTransfer(stateFile string, name string, direction domain.TransferDirection) (domain.TransferState, bool, error) {
+    if len(stateFile) == 0 {
+        return domain.TransferState{}, false, nil
+    }
+    state, err := domain.LoadTransferState(stateFile)
+    if os.IsNotExist(err) {
+        return state, false, nil
+    }
+    if err != nil {
+        return state, false, err
+    }
+    if state.Domain != name || state.Direction != direction {
+        return state, false, usagef("state file %s belongs to the transfer-%s of %s", stateFile, state.Direction, state.Domain)
+    }
+    return state, true, nil
+}
+
+func domainTransferIn(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain transfer-in", flag.ContinueOnError)
+    authinfo := fs.String("authinfo", "", "authinfo code from the losing registrar")
+    owner := fs.String("owner", "", "owner handle code")
+    admin := fs.String("admin", "", "admin handle code, defaults to the owner")
+    tech := fs.String("tech", "", "tech handle code, defaults to the owner")
+    zone := fs.String("zone", "", "zone handle code, defaults to the owner")
+    nameservers := &stringsFlag{}
+    fs.Var(nameservers, "ns", "nameserver hostname, can be repeated")
+    stateFile := fs.String("state", "", "file to keep the transfer state in, resumes the transfer if it exists")
+    wait := fs.Bool("wait", false, "wait until the transfer completed")
+    pos, err := parseFlags(fs, args, "name")
+    if err != nil {
+        return err
+    }
+
+    state, resumed, err := loadTransfer(*stateFile, pos[0], domain.TransferIn)
+    if err != nil {
+        return err
+    }
+    if !resumed {
+        if len(*authinfo) == 0 || len(*owner) == 0 {
+            return usagef("domain transfer-in: --authinfo and --owner are required")
+        }
+        in := domain.DomainCreateRequest{
+            Name: pos[0],
+            Authinfo: authinfo,
+            OwnerHandleCode: *owner,
+            AdminHandleCode: *owner,
+            TechHandleCode: *owner,
+            ZoneHandleCode: *owner,
+            Nameserver: []domain.DomainRequestNameserver{},
+        }
+        for code, value := range map[*string]string{&in.AdminHandleCode: *admin, &in.TechHandleCode: *tech, &in.ZoneHandleCode: *zone} {
+            if len(value) > 0 {
+                *code = value
+            }
+        }
+        for _, ns := range *nameservers {
+            in.Nameserver = append(in.Nameserver, domain.DomainRequestNameserver{Name: ns})
+        }
+        state = domain.NewTransferIn(in)
+    }
+    return a.runTransfer(state, *stateFile, *wait)
+}
+
+func domainTransferOut(a *app, args []string) error {
+    fs := flag.NewFlagSet("domain transfer-out", flag.ContinueOnError)
+    stateFile := fs.String("state", "", "file to keep the transfer state in, resumes the transfer if it exists")
+    wait := fs.Bool("wait", false, "wait until the domain was transferred and remove the authinfo")
+    cancel := fs.Bool("cancel", false, "cancel the transfer by removing the authinfo")
+    pos, err := parseFlags(fs, args, "name")
+    if err != nil {
+        return err
+    }
+
+    state, resumed, err := loadTransfer(*stateFile, pos[0], domain.TransferOut)
+    if err != nil {
+        return err
+    }
+    if !resumed {
+        state = domain.NewTransferOut(pos[0])
+    }
+    if *cancel {
+        if err := a.domain.CancelTransferOut(a.ctx, &state); err != nil {
+            return err
+        }
+        if len(*stateFile) > 0 {
+            if err := domain.SaveTransferState(*stateFile, state); err != nil {
+                return err
+            }
+        }
+        return a.printTransfer(state)
+    }
+    return a.runTransfer(state, *stateFile, *wait)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+    "encoding/json"
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "strings"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+)
+
+var firewallCommand = &command{
+    name: "firewall",
+    sub: []*command{
+        {name: "list", usage: "", run: firewallList},
+        {name: "export", usage: "<title>", run: firewallExport},
+        {name: "apply", usage: "--file <policy.json> [--dry-run] [--no-wait]", run: firewallApply},
+        {name: "snapshot", usage: "", run: firewallSnapshot},
+        {name: "check", usage: "<server> --protocol tcp|udp|icmp [--port <port>] [--address <cidr>] [--egress] [--snapshot <file>]", run: firewallCheck},
+        {name: "matrix", usage: "[--snapshot <file>]", run: firewallMatrix},
+        {name: "import", usage: "--format iptables|nft|sg --title <title> [--file <path>] [--strict]", run: firewallImport},
+    },
+}
+
+func firewallList(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    firewalls, err := a.compute.ListAllServerFirewalls(a.ctx, compute.GetServerFirewallsQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, f := range firewalls {
+        rows = append(rows, []string{f.Id, f.Title, f.ProjectId})
+    }
+    return a.print(firewalls, []string{"ID", "TITLE", "PROJECT"}, rows)
+}
+
+func firewallExport(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall export", flag.ContinueOnError)
+    pos, err := parseFlags(fs, args, "title")
+    if err != nil {
+        return err
+    }
+    policy, err := a.compute.ExportFirewallPolicy(a.ctx, pos[0], "")
+    if err != nil {
+        return err
+    }
+    enc := json.NewEncoder(a.out)
+    enc.SetIndent("", "  ")
+    return enc.Encode(policy)
+}
+
+func firewallApply(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall apply", flag.ContinueOnError)
+    file := fs.String("file", "", "policy document, - for stdin")
+    dryRun := fs.Bool("dry-run", false, "only print the changes")
+    noWait := fs.Bool("no-wait", false, "do not wait until the changes are applied to the servers")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    if len(*file) == 0 {
+        return usagef("firewall apply: --file is required")
+    }
+    r := os.Stdin
+    if *file != "-" {
+        f, err := os.Open(*file)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+        r = f
+    }
+    policy, err := compute.LoadFirewallPolicy(r)
+    if err != nil {
+        return usagef("%v", err)
+    }
+
+    plan, err := a.compute.PlanFirewallPolicy(a.ctx, policy)
+    if err != nil {
+        return err
+    }
+    if err := plan.WriteDiff(a.out); err != nil {
+        return err
+    }
+    if *dryRun || plan.Empty() {
+        return nil
+    }
+    if err := a.compute.ApplyFirewallPlan(a.ctx, &plan); err != nil {
+        return err
+    }
+    if *noWait {
+        return nil
+    }
+    return a.compute.WaitForFirewallApplied(a.ctx, plan.FirewallId, compute.WaitOptions{})
+}
+
+func firewallImport(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall import", flag.ContinueOnError)
+    format := fs.String("format", "", "iptables (iptables-save), nft (nft -j list ruleset) or sg (security group JSON/YAML)")
+    title := fs.String("title", "", "title of the resulting policy")
+    file := fs.String("file", "-", "rule set to import, - for stdin")
+    strict := fs.Bool("strict", false, "fail if any construct can not be converted")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    if len(*title) == 0 {
+        return usagef("firewall import: --title is required")
+    }
+    var importer func(r io.Reader) (compute.FirewallImport, error)
+    switch *format {
+        case "iptables":
+            importer = compute.ImportIptablesSave
+        case "nft":
+            importer = compute.ImportNftablesJSON
+        case "sg":
+            importer = compute.ImportSecurityGroup
+        default:
+            return usagef("firewall import: unknown format %q", *format)
+    }
+    r := os.Stdin
+    if *file != "-" {
+        f, err := os.Open(*file)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+        r = f
+    }
+    im, err := importer(r)
+    if err != nil {
+        return usagef("firewall import: %v", err)
+    }
+    for _, issue := range im.Issues {
+        fmt.Fprintln(a.errOut, "luma: firewall import: skipped", issue)
+    }
+    if *strict && len(im.Issues) > 0 {
+        return fmt.Errorf("firewall import: %d constructs could not be converted", len(im.Issues))
+    }
+    enc := json.NewEncoder(a.out)
+    enc.SetIndent("", "  ")
+    return enc.Encode(im.Policy(*title))
+}
+
+// loadSnapshot reads a snapshot written by firewall snapshot, or fetches the live state if
+// no file is given.
+func loadSnapshot(a *app, file string) (compute.FirewallSnapshot, error) {
+    if len(file) == 0 {
+        return a.compute.LoadFirewallSnapshot(a.ctx, "")
+    }
+    f, err := os.Open(file)
+    if err != nil {
+        return compute.FirewallSnapshot{}, err
+    }
+    defer f.Close()
+    return compute.ReadFirewallSnapshot(f)
+}
+
+func firewallSnapshot(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall snapshot", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    snapshot, err := a.compute.LoadFirewallSnapshot(a.ctx, "")
+    if err != nil {
+        return err
+    }
+    return snapshot.WriteJSON(a.out)
+}
+
+func firewallCheck(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall check", flag.ContinueOnError)
+    protocol := fs.String("protocol", "tcp", "tcp, udp or icmp")
+    port := fs.Int("port", 0, "destination port")
+    address := fs.String("address", "", "remote address or network, defaults to anywhere")
+    egress := fs.Bool("egress", false, "check outgoing instead of incoming traffic")
+    file := fs.String("snapshot", "", "evaluate a snapshot instead of the live state")
+    pos, err := parseFlags(fs, args, "server")
+    if err != nil {
+        return err
+    }
+    snapshot, err := loadSnapshot(a, *file)
+    if err != nil {
+        return err
+    }
+    q := compute.FirewallQuery{
+        ServerId: pos[0],
+        Direction: compute.ServerFirewallRuleTypeIngress,
+        Protocol: compute.ServerFirewallRuleProtocol(strings.ToUpper(*protocol)),
+        Port: *port,
+        Address: *address,
+    }
+    if *egress {
+        q.Direction = compute.ServerFirewallRuleTypeEgress
+    }
+    verdict, err := snapshot.Check(q)
+    if err != nil {
+        return usagef("firewall check: %v", err)
+    }
+    result := "denied"
+    if verdict.Allowed {
+        result = "allowed"
+    }
+    rows := [][]string{}
+    for _, m := range verdict.Matches {
+        rows = append(rows, []string{result, m.Firewall.Title, compute.FirewallRuleFromServer(m.Rule).String()})
+    }
+    if len(rows) == 0 {
+        reason := "no matching rule"
+        if len(verdict.Firewalls) == 0 {
+            reason = "no firewall"
+        }
+        rows = append(rows, []string{result, "", reason})
+    }
+    return a.print(verdict, []string{"RESULT", "FIREWALL", "RULE"}, rows)
+}
+
+func firewallMatrix(a *app, args []string) error {
+    fs := flag.NewFlagSet("firewall matrix", flag.ContinueOnError)
+    file := fs.String("snapshot", "", "evaluate a snapshot instead of the live state")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    snapshot, err := loadSnapshot(a, *file)
+    if err != nil {
+        return err
+    }
+    policies := snapshot.EffectivePolicies()
+    if a.format != "table" {
+        return a.print(policies, nil, nil)
+    }
+    return compute.WriteEffectivePolicies(a.out, policies)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "github.com/lumaserv/lumaserv-api-go/billing"
+)
+
+var invoiceCommand = &command{
+    name: "invoice",
+    sub: []*command{
+        {name: "list", usage: "", run: invoiceList},
+        {name: "download", usage: "<id> [--file <path>]", run: invoiceDownload},
+    },
+}
+
+func invoiceList(a *app, args []string) error {
+    fs := flag.NewFlagSet("invoice list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    invoices, err := a.billing.ListAllInvoices(a.ctx, billing.GetInvoicesQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, i := range invoices {
+        rows = append(rows, []string{i.Id, i.State.String(), i.CreatedAt, i.DueAt, fmt.Sprintf("%.2f", i.GrossPrice)})
+    }
+    return a.print(invoices, []string{"ID", "STATE", "CREATED", "DUE", "GROSS"}, rows)
+}
+
+func invoiceDownload(a *app, args []string) error {
+    fs := flag.NewFlagSet("invoice download", flag.ContinueOnError)
+    path := fs.String("file", "", "target file, defaults to invoice-<id>.pdf")
+    pos, err := parseFlags(fs, args, "id")
+    if err != nil {
+        return err
+    }
+    target := *path
+    if len(target) == 0 {
+        target = "invoice-" + pos[0] + ".pdf"
+    }
+    f, err := os.Create(target)
+    if err != nil {
+        return err
+    }
+    _, _, err = a.billing.GetInvoiceFileWithContext(a.ctx, pos[0], f)
+    if closeErr := f.Close(); err == nil {
+        err = closeErr
+    }
+    if err != nil {
+        os.Remove(target)
+    }
+    return err
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+    "flag"
+    "strings"
+    "github.com/lumaserv/lumaserv-api-go/labels"
+)
+
+var labelCommand = &command{
+    name: "label",
+    sub: []*command{
+        {name: "list", usage: "<selector> [--type <type>]...", run: labelList},
+        {name: "add", usage: "<key>[=<value>] (--selector <selector> | --id <id>...) [--type <type>]... [--dry-run]", run: labelAdd},
+        {name: "remove", usage: "<key> (--selector <selector> | --id <id>...) [--type <type>]... [--dry-run]", run: labelRemove},
+        {name: "rename", usage: "<key> <new-key> (--selector <selector> | --id <id>...) [--type <type>]... [--dry-run]", run: labelRename},
+    },
+}
+
+func (a *app) labelClients() labels.Clients {
+    return labels.Clients{Compute: a.compute, Domain: a.domain, Addon: a.addon}
+}
+
+// parseTypes converts --type values, no values select all types.
+func parseTypes(values []string) ([]labels.ObjectType, error) {
+    types := []labels.ObjectType{}
+    for _, v := range values {
+        t, err := labels.ParseObjectType(v)
+        if err != nil {
+            return nil, usagef("%v", err)
+        }
+        types = append(types, t)
+    }
+    return types, nil
+}
+
+func labelList(a *app, args []string) error {
+    fs := flag.NewFlagSet("label list", flag.ContinueOnError)
+    typeValues := &stringsFlag{}
+    fs.Var(typeValues, "type", "object type like server, server-volume or domain, can be repeated")
+    pos, err := parseFlags(fs, args, "selector")
+    if err != nil {
+        return err
+    }
+    sel, err := labels.Parse(pos[0])
+    if err != nil {
+        return usagef("%v", err)
+    }
+    types, err := parseTypes(*typeValues)
+    if err != nil {
+        return err
+    }
+    objects, err := a.labelClients().List(a.ctx, sel, types...)
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, o := range objects {
+        rows = append(rows, []string{string(o.Type), o.Id, o.Name, labelStr(o.Labels)})
+    }
+    return a.print(objects, []string{"TYPE", "ID", "NAME", "LABELS"}, rows)
+}
+
+func labelAdd(a *app, args []string) error {
+    return a.relabel("label add", args, []string{"key=value"}, func(pos []string) labels.Operation {
+        parts := strings.SplitN(pos[0], "=", 2)
+        if len(parts) == 1 {
+            return labels.AddLabel(parts[0], nil)
+        }
+        return labels.AddLabel(parts[0], &parts[1])
+    })
+}
+
+func labelRemove(a *app, args []string) error {
+    return a.relabel("label remove", args, []string{"key"}, func(pos []string) labels.Operation {
+        return labels.RemoveLabel(pos[0])
+    })
+}
+
+func labelRename(a *app, args []string) error {
+    return a.relabel("label rename", args, []string{"key", "new-key"}, func(pos []string) labels.Operation {
+        return labels.RenameLabel(pos[0], pos[1])
+    })
+}
+
+// relabel selects the objects by --selector or --id and applies the operation built from
+// the positional arguments to them.
+func (a *app) relabel(name string, args []string, positional []string, op func(pos []string) labels.Operation) error {
+    fs := flag.NewFlagSet(name, flag.ContinueOnError)
+    selector := fs.String("selector", "", "label selector of the objects")
+    ids := &stringsFlag{}
+    fs.Var(ids, "id", "object id, or name for DNS zones and domains, can be repeated")
+    typeValues := &stringsFlag{}
+    fs.Var(typeValues, "type", "object type like server, server-volume or domain, can be repeated")
+    workers := fs.Int("workers", 4, "number of concurrent updates")
+    rate := fs.Float64("rate", 0, "maximum update requests per second, 0 for no limit")
+    dryRun := fs.Bool("dry-run", false, "only print the resulting labels")
+    pos, err := parseFlags(fs, args, positional...)
+    if err != nil {
+        return err
+    }
+    types, err := parseTypes(*typeValues)
+    if err != nil {
+        return err
+    }
+    if (len(*selector) > 0) == (len(*ids) > 0) {
+        return usagef("%s: either --selector or --id is required", name)
+    }
+
+    clients := a.labelClients()
+    objects := []labels.Object{}
+    if len(*ids) > 0 {
+        if len(types) != 1 {
+            return usagef("%s: --id requires exactly one --type", name)
+        }
+        for _, id := range *ids {
+            o, err := clients.Get(a.ctx, types[0], id)
+            if err != nil {
+                return err
+            }
+            objects = append(objects, o)
+        }
+    } else {
+        sel, err := labels.Parse(*selector)
+        if err != nil {
+            return usagef("%v", err)
+        }
+        if objects, err = clients.List(a.ctx, sel, types...); err != nil {
+            return err
+        }
+    }
+
+    opts := labels.BulkOptions{Workers: *workers, RequestsPerSecond: *rate, DryRun: *dryRun}
+    report, err := clients.Relabel(a.ctx, objects, opts, op(pos))
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, r := range report.Results {
+        rows = append(rows, []string{string(r.Object.Type), r.Object.Id, r.Object.Name, string(r.Status), labelStr(r.Labels), r.Error})
+    }
+    if err := a.print(report, []string{"TYPE", "ID", "NAME", "STATUS", "LABELS", "ERROR"}, rows); err != nil {
+        return err
+    }
+    return report.Err()
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+    "flag"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+)
+
+var licenseCommand = &command{
+    name: "license",
+    sub: []*command{
+        {name: "plesk", sub: []*command{
+            {name: "list", usage: "", run: pleskLicenseList},
+        }},
+        {name: "ssl", sub: []*command{
+            {name: "list", usage: "", run: sslCertificateList},
+        }},
+    },
+}
+
+func pleskLicenseList(a *app, args []string) error {
+    fs := flag.NewFlagSet("license plesk list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    licenses, err := a.addon.ListAllPleskLicenses(a.ctx, addon.GetPleskLicensesQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, l := range licenses {
+        rows = append(rows, []string{l.Id, l.Key, l.License, labelStr(l.Labels)})
+    }
+    return a.print(licenses, []string{"ID", "KEY", "LICENSE", "LABELS"}, rows)
+}
+
+func sslCertificateList(a *app, args []string) error {
+    fs := flag.NewFlagSet("license ssl list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    certificates, err := a.addon.ListAllSSLCertificates(a.ctx, addon.GetSSLCertificatesQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    rows := [][]string{}
+    for _, c := range certificates {
+        rows = append(rows, []string{c.Id, c.TypeId, c.ApproverEmail, c.ValidUntil, labelStr(c.Labels)})
+    }
+    return a.print(certificates, []string{"ID", "TYPE", "APPROVER", "VALID UNTIL", "LABELS"}, rows)
+}
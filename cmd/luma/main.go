@@ -0,0 +1,211 @@
+// Command luma is a command-line client for the LUMASERV APIs.
+package main
+
+import (
+    "context"
+    "errors"
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "os/signal"
+    "strings"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+    "github.com/lumaserv/lumaserv-api-go/billing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+// Exit codes, derived from the type of the API error.
+const (
+    exitOk = 0
+    exitError = 1
+    exitUsage = 2
+    exitUnauthorized = 3
+    exitNotFound = 4
+    exitValidation = 5
+    exitConflict = 6
+    exitRateLimited = 7
+)
+
+type usageError struct {
+    msg string
+}
+
+func (e usageError) Error() string {
+    return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+    return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+type app struct {
+    ctx context.Context
+    out io.Writer
+    errOut io.Writer
+    format string
+    addon addon.AddonClient
+    auth auth.AuthClient
+    billing billing.BillingClient
+    compute compute.ComputeClient
+    domain domain.DomainClient
+}
+
+type command struct {
+    name string
+    usage string
+    run func(a *app, args []string) error
+    sub []*command
+}
+
+var commands = []*command{
+    serverCommand,
+    dnsCommand,
+    domainCommand,
+    tokenCommand,
+    invoiceCommand,
+    licenseCommand,
+    ddnsCommand,
+    firewallCommand,
+    labelCommand,
+}
+
+func main() {
+    os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+    fs := flag.NewFlagSet("luma", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    token := fs.String("token", os.Getenv("LUMASERV_TOKEN"), "API token, defaults to $LUMASERV_TOKEN")
+    project := fs.String("project", os.Getenv("LUMASERV_PROJECT"), "project id used for all requests, defaults to $LUMASERV_PROJECT")
+    format := fs.String("output", "table", "output format: table, json or yaml")
+    retries := fs.Int("retries", 3, "number of retries for transient failures")
+    fs.Usage = func() {
+        fmt.Fprintln(stderr, "Usage: luma [flags] <command> [args]")
+        fmt.Fprintln(stderr, "\nCommands:")
+        printCommands(stderr, commands, "  ")
+        fmt.Fprintln(stderr, "\nFlags:")
+        fs.PrintDefaults()
+    }
+    if err := fs.Parse(args); err != nil {
+        return exitUsage
+    }
+    if *format != "table" && *format != "json" && *format != "yaml" {
+        fmt.Fprintf(stderr, "luma: unknown output format %q\n", *format)
+        return exitUsage
+    }
+
+    ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+    defer cancel()
+
+    policy := core.DefaultRetryPolicy()
+    policy.MaxAttempts = *retries + 1
+    a := &app{
+        ctx: ctx,
+        out: stdout,
+        errOut: stderr,
+        format: *format,
+        addon: addon.NewClientWithUrl(*token, os.Getenv("LUMASERV_ADDON_URL")),
+        auth: auth.NewClientWithUrl(*token, os.Getenv("LUMASERV_AUTH_URL")),
+        billing: billing.NewClientWithUrl(*token, os.Getenv("LUMASERV_BILLING_URL")),
+        compute: compute.NewClientWithUrl(*token, os.Getenv("LUMASERV_COMPUTE_URL")),
+        domain: domain.NewClientWithUrl(*token, os.Getenv("LUMASERV_DOMAIN_URL")),
+    }
+    for _, c := range []*core.Client{a.addon.Client, a.auth.Client, a.billing.Client, a.compute.Client, a.domain.Client} {
+        c.SetRetryPolicy(policy)
+        if len(*project) > 0 {
+            c.SetCurrentProject(*project)
+        }
+    }
+
+    err := dispatch(a, commands, fs.Args(), "luma")
+    if err == nil {
+        return exitOk
+    }
+    fmt.Fprintln(stderr, "luma:", err)
+    return exitCode(err)
+}
+
+func dispatch(a *app, cmds []*command, args []string, path string) error {
+    if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+        return usagef("usage: %s <command>\n\nCommands:\n%s", path, commandList(cmds))
+    }
+    for _, c := range cmds {
+        if c.name != args[0] {
+            continue
+        }
+        if c.sub != nil {
+            return dispatch(a, c.sub, args[1:], path+" "+c.name)
+        }
+        return c.run(a, args[1:])
+    }
+    return usagef("unknown command %q for %s\n\nCommands:\n%s", args[0], path, commandList(cmds))
+}
+
+func commandList(cmds []*command) string {
+    b := &strings.Builder{}
+    printCommands(b, cmds, "  ")
+    return strings.TrimRight(b.String(), "\n")
+}
+
+func printCommands(w io.Writer, cmds []*command, indent string) {
+    for _, c := range cmds {
+        if c.sub != nil {
+            printCommands(w, c.sub, indent+c.name+" ")
+            continue
+        }
+        fmt.Fprintln(w, strings.TrimRight(indent+c.name+" "+c.usage, " "))
+    }
+}
+
+func exitCode(err error) int {
+    var usage usageError
+    var invalidRecord *domain.DNSValidationError
+    switch {
+        case errors.As(err, &usage):
+            return exitUsage
+        case errors.As(err, &invalidRecord):
+            return exitValidation
+        case core.IsUnauthorized(err), core.IsForbidden(err):
+            return exitUnauthorized
+        case core.IsNotFound(err):
+            return exitNotFound
+        case core.IsValidation(err):
+            return exitValidation
+        case core.IsConflict(err):
+            return exitConflict
+        case core.IsRateLimited(err):
+            return exitRateLimited
+    }
+    return exitError
+}
+
+// parseFlags parses the flags of a command, which may be mixed with its positional
+// arguments, and checks the number of positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string, positional ...string) ([]string, error) {
+    fs.SetOutput(io.Discard)
+    rest := []string{}
+    for {
+        if err := fs.Parse(args); err != nil {
+            return nil, usagef("%s: %v", fs.Name(), err)
+        }
+        args = fs.Args()
+        if len(args) == 0 {
+            break
+        }
+        rest = append(rest, args[0])
+        args = args[1:]
+    }
+    if len(rest) != len(positional) {
+        usage := "usage: luma " + fs.Name()
+        for _, p := range positional {
+            usage += " <" + p + ">"
+        }
+        return nil, usageError{msg: usage}
+    }
+    return rest, nil
+}
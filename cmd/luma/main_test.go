@@ -0,0 +1,235 @@
+package main
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+    "gopkg.in/yaml.v3"
+)
+
+// newTestServer starts a fake API and points the service URLs of run at it.
+func newTestServer(t *testing.T) *lumaservtest.Server {
+    s := lumaservtest.NewServer()
+    for _, service := range []string{"addon", "auth", "billing", "compute", "domain"} {
+        name := "LUMASERV_" + strings.ToUpper(service) + "_URL"
+        os.Setenv(name, s.ServiceUrl(service))
+    }
+    t.Cleanup(func() {
+        for _, service := range []string{"addon", "auth", "billing", "compute", "domain"} {
+            os.Unsetenv("LUMASERV_" + strings.ToUpper(service) + "_URL")
+        }
+        s.Close()
+    })
+    return s
+}
+
+func runLuma(args ...string) (int, string, string) {
+    stdout := &bytes.Buffer{}
+    stderr := &bytes.Buffer{}
+    code := run(append([]string{"--token", "token", "--retries", "0"}, args...), stdout, stderr)
+    return code, stdout.String(), stderr.String()
+}
+
+func TestDispatch(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/compute/servers", compute.Server{Id: "s1", Name: "web", State: compute.ServerStateStopped})
+
+    tests := []struct {
+        args []string
+        code int
+        stderr string
+    }{
+        {[]string{}, exitUsage, "Commands:"},
+        {[]string{"help"}, exitUsage, "server list"},
+        {[]string{"unknown"}, exitUsage, "unknown command \"unknown\" for luma"},
+        {[]string{"server"}, exitUsage, "usage: luma server <command>"},
+        {[]string{"server", "get"}, exitUsage, "usage: luma server get <id>"},
+        {[]string{"server", "get", "s1", "s2"}, exitUsage, "usage: luma server get <id>"},
+        {[]string{"server", "list", "--unknown"}, exitUsage, "flag provided but not defined"},
+        {[]string{"--output", "xml", "server", "list"}, exitUsage, "unknown output format"},
+        {[]string{"server", "start", "s1"}, exitOk, ""},
+        {[]string{"server", "get", "s1"}, exitOk, ""},
+        {[]string{"server", "get", "missing"}, exitNotFound, "luma:"},
+    }
+    for _, tt := range tests {
+        code, _, stderr := runLuma(tt.args...)
+        if code != tt.code || !strings.Contains(stderr, tt.stderr) {
+            t.Errorf("%v: got %d with %q, want %d with %q", tt.args, code, stderr, tt.code, tt.stderr)
+        }
+    }
+    if state := s.Items("/compute/servers")[0]["state"]; state != "RUNNING" {
+        t.Errorf("server start: got state %v", state)
+    }
+}
+
+func TestFlagsMixedWithArguments(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/compute/servers",
+        compute.Server{Id: "s1", Name: "web"},
+        compute.Server{Id: "s2", Name: "db"},
+    )
+
+    code, stdout, stderr := runLuma("server", "list", "--name", "db")
+    if code != exitOk || strings.Contains(stdout, "web") || !strings.Contains(stdout, "db") {
+        t.Errorf("got %d: %s%s", code, stdout, stderr)
+    }
+    code, stdout, stderr = runLuma("server", "get", "s1", "--output-ignored")
+    if code != exitUsage {
+        t.Errorf("unknown flag after argument: got %d: %s%s", code, stdout, stderr)
+    }
+}
+
+func TestExitCodes(t *testing.T) {
+    tests := []struct {
+        status int
+        code int
+    }{
+        {401, exitUnauthorized},
+        {403, exitUnauthorized},
+        {404, exitNotFound},
+        {400, exitValidation},
+        {422, exitValidation},
+        {409, exitConflict},
+        {429, exitRateLimited},
+        {500, exitError},
+    }
+    for _, tt := range tests {
+        s := newTestServer(t)
+        s.InjectFault(lumaservtest.Fault{Path: "/compute/servers", StatusCode: tt.status})
+        if code, _, _ := runLuma("server", "list"); code != tt.code {
+            t.Errorf("status %d: got exit code %d, want %d", tt.status, code, tt.code)
+        }
+    }
+
+    s := newTestServer(t)
+    s.Seed("/domain/dns/zones", map[string]interface{}{"name": "example.com"})
+    if code, _, stderr := runLuma("dns", "record", "add", "example.com", "--type", "A", "--data", "192.0.2"); code != exitValidation {
+        t.Errorf("invalid record: got exit code %d: %s", code, stderr)
+    }
+    if n := len(s.Items("/domain/dns/zones/example.com/records")); n != 0 {
+        t.Errorf("invalid record was sent to the API")
+    }
+}
+
+func TestOutputFormats(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/compute/servers", map[string]interface{}{"id": "s1", "name": "123", "state": "RUNNING", "labels": map[string]string{"env": "prod"}})
+
+    code, stdout, _ := runLuma("server", "list")
+    lines := strings.Split(strings.TrimSpace(stdout), "\n")
+    if code != exitOk || len(lines) != 2 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "env=prod") {
+        t.Errorf("table: got %d: %q", code, stdout)
+    }
+
+    code, stdout, _ = runLuma("--output", "json", "server", "list")
+    fromJson := []map[string]interface{}{}
+    if err := json.Unmarshal([]byte(stdout), &fromJson); code != exitOk || err != nil {
+        t.Fatalf("json: got %d, %v: %q", code, err, stdout)
+    }
+
+    code, stdout, _ = runLuma("--output", "yaml", "server", "list")
+    fromYaml := []map[string]interface{}{}
+    if err := yaml.Unmarshal([]byte(stdout), &fromYaml); code != exitOk || err != nil {
+        t.Fatalf("yaml: got %d, %v: %q", code, err, stdout)
+    }
+    if len(fromYaml) != 1 || fromYaml[0]["name"] != "123" || fromYaml[0]["id"] != fromJson[0]["id"] {
+        t.Errorf("yaml differs from json: %v, %v", fromYaml, fromJson)
+    }
+    if !strings.HasPrefix(stdout, "- ") || strings.Contains(stdout, "{") {
+        t.Errorf("yaml not in block style: %q", stdout)
+    }
+}
+
+func TestDNSZoneFiles(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/domain/dns/zones", map[string]interface{}{"name": "example.com", "ns1": "ns1.example.net", "hostmaster": "hostmaster@example.com"})
+    s.Seed("/domain/dns/zones/example.com/records", map[string]interface{}{"id": "r1", "name": "www", "type": "A", "data": "192.0.2.1", "ttl": 300})
+
+    code, stdout, stderr := runLuma("dns", "zone", "export", "example.com")
+    if code != exitOk || !strings.Contains(stdout, "www\t300\tIN\tA\t192.0.2.1") {
+        t.Errorf("export: got %d: %q%s", code, stdout, stderr)
+    }
+
+    path := filepath.Join(t.TempDir(), "example.com.zone")
+    zone := "$TTL 300\nmail IN A 192.0.2.2\nhost IN HINFO PC Linux\n"
+    if err := ioutil.WriteFile(path, []byte(zone), 0644); err != nil {
+        t.Fatal(err)
+    }
+    code, _, stderr = runLuma("dns", "zone", "import", "example.com", "--file", path)
+    if code != exitOk || !strings.Contains(stderr, "luma: zone file: skipped") || !strings.Contains(stderr, "HINFO") {
+        t.Errorf("import: got %d: %q", code, stderr)
+    }
+    if n := len(s.Items("/domain/dns/zones/example.com/records")); n != 2 {
+        t.Errorf("import: got %d records, want 2", n)
+    }
+}
+
+func TestDNSSyncDiff(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/domain/dns/zones", map[string]interface{}{"name": "example.com"})
+    s.Seed("/domain/dns/zones/example.com/records", map[string]interface{}{"id": "r1", "name": "old", "type": "A", "data": "192.0.2.1", "ttl": 300})
+
+    path := filepath.Join(t.TempDir(), "example.com.zone")
+    if err := ioutil.WriteFile(path, []byte("$TTL 300\nnew IN A 192.0.2.2\n"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    code, stdout, stderr := runLuma("dns", "sync", "example.com", "--file", path, "--dry-run")
+    if code != exitOk || !strings.HasPrefix(stdout, "zone example.com:") || !strings.Contains(stdout, "+ new") || !strings.Contains(stdout, "- old") {
+        t.Errorf("got %d: %q%s", code, stdout, stderr)
+    }
+    if items := s.Items("/domain/dns/zones/example.com/records"); len(items) != 1 || items[0]["name"] != "old" {
+        t.Errorf("dry run changed the records: %v", items)
+    }
+}
+
+func TestFirewallImport(t *testing.T) {
+    newTestServer(t)
+    path := filepath.Join(t.TempDir(), "rules.v4")
+    rules := "*filter\n:INPUT DROP [0:0]\n-A INPUT -p tcp --dport 443 -j ACCEPT\n-A INPUT -i lo -j ACCEPT\nCOMMIT\n"
+    if err := ioutil.WriteFile(path, []byte(rules), 0644); err != nil {
+        t.Fatal(err)
+    }
+
+    code, stdout, stderr := runLuma("firewall", "import", "--format", "iptables", "--title", "web", "--file", path)
+    policy := compute.FirewallPolicy{}
+    if err := json.Unmarshal([]byte(stdout), &policy); code != exitOk || err != nil || len(policy.Rules) != 1 {
+        t.Errorf("got %d, %v: %q", code, err, stdout)
+    }
+    if !strings.Contains(stderr, "luma: firewall import: skipped") || !strings.Contains(stderr, "interface matches") {
+        t.Errorf("issues not reported: %q", stderr)
+    }
+    if code, _, _ := runLuma("firewall", "import", "--format", "iptables", "--title", "web", "--file", path, "--strict"); code != exitError {
+        t.Errorf("strict: got exit code %d", code)
+    }
+}
+
+func TestDDNSErrors(t *testing.T) {
+    s := newTestServer(t)
+    s.Seed("/domain/dns/zones", map[string]interface{}{"name": "example.com"})
+    source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        http.Error(w, "unavailable", http.StatusServiceUnavailable)
+    }))
+    defer source.Close()
+
+    ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond * 200)
+    defer cancel()
+    stdout := &bytes.Buffer{}
+    stderr := &bytes.Buffer{}
+    a := &app{ctx: ctx, out: stdout, errOut: stderr, domain: s.DomainClient()}
+    if err := ddnsRun(a, []string{"example.com", "--host", "home", "--no-ipv6", "--ipv4-url", source.URL}); err != nil {
+        t.Fatal(err)
+    }
+    if !strings.HasPrefix(stderr.String(), "luma: ddns: detecting A address") || stdout.Len() > 0 {
+        t.Errorf("got stdout %q, stderr %q", stdout, stderr)
+    }
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "sort"
+    "strings"
+    "text/tabwriter"
+    "gopkg.in/yaml.v3"
+)
+
+// print writes v in the selected output format. The table format only shows the given
+// headers and rows.
+func (a *app) print(v interface{}, headers []string, rows [][]string) error {
+    switch a.format {
+        case "json":
+            enc := json.NewEncoder(a.out)
+            enc.SetIndent("", "  ")
+            return enc.Encode(v)
+        case "yaml":
+            return writeYaml(a.out, v)
+    }
+
+    tw := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+    fmt.Fprintln(tw, strings.Join(headers, "\t"))
+    for _, row := range rows {
+        fmt.Fprintln(tw, strings.Join(row, "\t"))
+    }
+    return tw.Flush()
+}
+
+// writeYaml encodes v as YAML by way of its JSON representation, so json tags and the
+// field order apply.
+func writeYaml(w io.Writer, v interface{}) error {
+    j, err := json.Marshal(v)
+    if err != nil {
+        return err
+    }
+    doc := yaml.Node{}
+    if err := yaml.Unmarshal(j, &doc); err != nil {
+        return err
+    }
+    plainStyle(&doc)
+    enc := yaml.NewEncoder(w)
+    enc.SetIndent(2)
+    if err := enc.Encode(&doc); err != nil {
+        return err
+    }
+    return enc.Close()
+}
+
+// plainStyle drops the flow and quoting style of the JSON input, the encoder still quotes
+// strings that would otherwise be read as another type.
+func plainStyle(n *yaml.Node) {
+    n.Style = 0
+    for _, c := range n.Content {
+        plainStyle(c)
+    }
+}
+
+func str(s *string) string {
+    if s == nil {
+        return "-"
+    }
+    return *s
+}
+
+func intStr(i *int) string {
+    if i == nil {
+        return "-"
+    }
+    return fmt.Sprint(*i)
+}
+
+func labelStr(labels map[string]*string) string {
+    parts := []string{}
+    for k, v := range labels {
+        if v == nil {
+            parts = append(parts, k)
+        } else {
+            parts = append(parts, k+"="+*v)
+        }
+    }
+    sort.Strings(parts)
+    return strings.Join(parts, ",")
+}
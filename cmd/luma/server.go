@@ -0,0 +1,163 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "strings"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+)
+
+var serverCommand = &command{
+    name: "server",
+    sub: []*command{
+        {name: "list", usage: "[--name <name>] [--label key=value]", run: serverList},
+        {name: "get", usage: "<id>", run: serverGet},
+        {name: "start", usage: "<id> [--wait]", run: serverStart},
+        {name: "stop", usage: "<id> [--wait]", run: serverStop},
+        {name: "restart", usage: "<id> [--wait]", run: serverRestart},
+        {name: "resize", usage: "<id> --variant <variant_id> [--resize-disk] [--wait]", run: serverResize},
+    },
+}
+
+type labelFlag map[string]*string
+
+func (l labelFlag) String() string {
+    return labelStr(l)
+}
+
+func (l labelFlag) Set(value string) error {
+    parts := strings.SplitN(value, "=", 2)
+    if len(parts) == 2 {
+        l[parts[0]] = &parts[1]
+    } else {
+        l[parts[0]] = nil
+    }
+    return nil
+}
+
+func (a *app) printServers(servers []compute.Server) error {
+    rows := [][]string{}
+    for _, s := range servers {
+        rows = append(rows, []string{s.Id, s.Name, s.State.String(), s.VariantId, s.ZoneId, labelStr(s.Labels)})
+    }
+    return a.print(servers, []string{"ID", "NAME", "STATE", "VARIANT", "ZONE", "LABELS"}, rows)
+}
+
+func serverList(a *app, args []string) error {
+    fs := flag.NewFlagSet("server list", flag.ContinueOnError)
+    name := fs.String("name", "", "filter by name")
+    labels := labelFlag{}
+    fs.Var(labels, "label", "filter by label, can be repeated")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+
+    qParams := compute.GetServersQueryParams{Filter: &compute.GetServersQueryParamsFilter{}}
+    if len(*name) > 0 {
+        qParams.Filter.Name = name
+    }
+    if len(labels) > 0 {
+        qParams.Filter.Labels = labels
+    }
+    servers, err := a.compute.ListAllServers(a.ctx, qParams).All()
+    if err != nil {
+        return err
+    }
+    return a.printServers(servers)
+}
+
+func serverGet(a *app, args []string) error {
+    fs := flag.NewFlagSet("server get", flag.ContinueOnError)
+    pos, err := parseFlags(fs, args, "id")
+    if err != nil {
+        return err
+    }
+    res, _, err := a.compute.GetServerWithContext(a.ctx, pos[0])
+    if err != nil {
+        return err
+    }
+    return a.printServers([]compute.Server{res.Data})
+}
+
+func (a *app) waitForServer(id string, state compute.ServerState) error {
+    server, err := a.compute.WaitForServerState(a.ctx, id, state, compute.WaitOptions{})
+    if err != nil {
+        return err
+    }
+    return a.printServers([]compute.Server{server})
+}
+
+func serverPowerAction(a *app, args []string, name string, state compute.ServerState, action func(id string) error) error {
+    fs := flag.NewFlagSet("server "+name, flag.ContinueOnError)
+    wait := fs.Bool("wait", false, "wait for the server to reach its target state")
+    pos, err := parseFlags(fs, args, "id")
+    if err != nil {
+        return err
+    }
+    if err := action(pos[0]); err != nil {
+        return err
+    }
+    if *wait {
+        return a.waitForServer(pos[0], state)
+    }
+    return nil
+}
+
+func serverStart(a *app, args []string) error {
+    return serverPowerAction(a, args, "start", compute.ServerStateRunning, func(id string) error {
+        _, _, err := a.compute.StartServerWithContext(a.ctx, id)
+        return err
+    })
+}
+
+func serverStop(a *app, args []string) error {
+    return serverPowerAction(a, args, "stop", compute.ServerStateStopped, func(id string) error {
+        _, _, err := a.compute.StopServerWithContext(a.ctx, id)
+        return err
+    })
+}
+
+func serverRestart(a *app, args []string) error {
+    fs := flag.NewFlagSet("server restart", flag.ContinueOnError)
+    wait := fs.Bool("wait", false, "wait for the restart to finish")
+    pos, err := parseFlags(fs, args, "id")
+    if err != nil {
+        return err
+    }
+    res, _, err := a.compute.RestartServerWithContext(a.ctx, pos[0])
+    if err != nil {
+        return err
+    }
+    action := res.Data
+    if *wait {
+        action, err = a.compute.WaitForServerAction(a.ctx, action.Id, compute.WaitOptions{})
+        if err != nil {
+            return err
+        }
+    }
+    return a.print(action, []string{"ID", "TYPE", "STATE", "PROGRESS"}, [][]string{
+        {action.Id, action.Type.String(), action.State.String(), fmt.Sprint(action.Progress)},
+    })
+}
+
+func serverResize(a *app, args []string) error {
+    fs := flag.NewFlagSet("server resize", flag.ContinueOnError)
+    variant := fs.String("variant", "", "id of the new server variant")
+    resizeDisk := fs.Bool("resize-disk", false, "also grow the disk to the size of the new variant")
+    wait := fs.Bool("wait", false, "wait for the server to be running again")
+    pos, err := parseFlags(fs, args, "id")
+    if err != nil {
+        return err
+    }
+    if len(*variant) == 0 {
+        return usagef("server resize: --variant is required")
+    }
+    _, _, err = a.compute.ResizeServerWithContext(a.ctx, compute.ServerResizeRequest{VariantId: *variant, ResizeDisk: resizeDisk}, pos[0])
+    if err != nil {
+        return err
+    }
+    if *wait {
+        return a.waitForServer(pos[0], compute.ServerStateRunning)
+    }
+    return nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+    "flag"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+)
+
+var tokenCommand = &command{
+    name: "token",
+    sub: []*command{
+        {name: "list", usage: "", run: tokenList},
+        {name: "create", usage: "--title <title> [--scope-project <project_id>]", run: tokenCreate},
+    },
+}
+
+func (a *app) printTokens(tokens []auth.Token) error {
+    rows := [][]string{}
+    for _, t := range tokens {
+        rows = append(rows, []string{t.Type, t.UserId, str(t.Scope.ProjectId), t.CreatedAt, str(t.ValidUntil), str(t.Token)})
+    }
+    return a.print(tokens, []string{"TYPE", "USER", "PROJECT", "CREATED", "VALID UNTIL", "TOKEN"}, rows)
+}
+
+func tokenList(a *app, args []string) error {
+    fs := flag.NewFlagSet("token list", flag.ContinueOnError)
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    tokens, err := a.auth.ListAllTokens(a.ctx, auth.GetTokensQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    return a.printTokens(tokens)
+}
+
+func tokenCreate(a *app, args []string) error {
+    fs := flag.NewFlagSet("token create", flag.ContinueOnError)
+    title := fs.String("title", "", "title of the token")
+    project := fs.String("scope-project", "", "restrict the token to a project")
+    if _, err := parseFlags(fs, args); err != nil {
+        return err
+    }
+    if len(*title) == 0 {
+        return usagef("token create: --title is required")
+    }
+    in := auth.TokenCreateRequest{Title: *title}
+    if len(*project) > 0 {
+        in.Scope = &auth.TokenScope{ProjectId: project}
+    }
+    res, _, err := a.auth.CreateTokenWithContext(a.ctx, in)
+    if err != nil {
+        return err
+    }
+    return a.printTokens([]auth.Token{res.Data})
+}
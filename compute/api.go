@@ -0,0 +1,296 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package compute
+
+//go:generate go run ../internal/cmd/apigen -client ComputeClient
+
+import (
+    "context"
+    "net/http"
+)
+
+// API lists all methods of ComputeClient, allowing it to be replaced by a mock.
+type API interface {
+    CreateSSHKey(in SSHKeyCreateRequest) (SSHKeySingleResponse, *http.Response, error)
+    CreateSSHKeyWithContext(ctx context.Context, in SSHKeyCreateRequest) (SSHKeySingleResponse, *http.Response, error)
+    GetSSHKeys(qParams GetSSHKeysQueryParams) (SSHKeyListResponse, *http.Response, error)
+    GetSSHKeysWithContext(ctx context.Context, qParams GetSSHKeysQueryParams) (SSHKeyListResponse, *http.Response, error)
+    ListAllSSHKeys(ctx context.Context, qParams GetSSHKeysQueryParams) *SSHKeyIterator
+    CreateServerPriceRange(in ServerPriceRangeCreateRequest) (ServerPriceRangeSingleResponse, *http.Response, error)
+    CreateServerPriceRangeWithContext(ctx context.Context, in ServerPriceRangeCreateRequest) (ServerPriceRangeSingleResponse, *http.Response, error)
+    GetServerPriceRanges(qParams GetServerPriceRangesQueryParams) (ServerPriceRangeListResponse, *http.Response, error)
+    GetServerPriceRangesWithContext(ctx context.Context, qParams GetServerPriceRangesQueryParams) (ServerPriceRangeListResponse, *http.Response, error)
+    ListAllServerPriceRanges(ctx context.Context, qParams GetServerPriceRangesQueryParams) *ServerPriceRangeIterator
+    StartServer(id string) (EmptyResponse, *http.Response, error)
+    StartServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreateAvailabilityZone(in AvailabilityZoneCreateRequest) (AvailabilityZoneSingleResponse, *http.Response, error)
+    CreateAvailabilityZoneWithContext(ctx context.Context, in AvailabilityZoneCreateRequest) (AvailabilityZoneSingleResponse, *http.Response, error)
+    GetAvailabilityZones(qParams GetAvailabilityZonesQueryParams) (AvailabilityZoneListResponse, *http.Response, error)
+    GetAvailabilityZonesWithContext(ctx context.Context, qParams GetAvailabilityZonesQueryParams) (AvailabilityZoneListResponse, *http.Response, error)
+    ListAllAvailabilityZones(ctx context.Context, qParams GetAvailabilityZonesQueryParams) *AvailabilityZoneIterator
+    GetServerTemplate(id string) (ServerTemplateSingleResponse, *http.Response, error)
+    GetServerTemplateWithContext(ctx context.Context, id string) (ServerTemplateSingleResponse, *http.Response, error)
+    ShutdownServer(id string, qParams ShutdownServerQueryParams) (EmptyResponse, *http.Response, error)
+    ShutdownServerWithContext(ctx context.Context, id string, qParams ShutdownServerQueryParams) (EmptyResponse, *http.Response, error)
+    GetServerFirewall(id string) (ServerFirewallSingleResponse, *http.Response, error)
+    GetServerFirewallWithContext(ctx context.Context, id string) (ServerFirewallSingleResponse, *http.Response, error)
+    DeleteServerFirewall(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerFirewallWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    GetServer(id string) (ServerSingleResponse, *http.Response, error)
+    GetServerWithContext(ctx context.Context, id string) (ServerSingleResponse, *http.Response, error)
+    DeleteServer(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateServer(in ServerUpdateRequest, id string) (ServerSingleResponse, *http.Response, error)
+    UpdateServerWithContext(ctx context.Context, in ServerUpdateRequest, id string) (ServerSingleResponse, *http.Response, error)
+    GetServerActions(qParams GetServerActionsQueryParams) (ServerActionListResponse, *http.Response, error)
+    GetServerActionsWithContext(ctx context.Context, qParams GetServerActionsQueryParams) (ServerActionListResponse, *http.Response, error)
+    ListAllServerActions(ctx context.Context, qParams GetServerActionsQueryParams) *ServerActionIterator
+    GetServerStorageClass(id string) (ServerStorageClassSingleResponse, *http.Response, error)
+    GetServerStorageClassWithContext(ctx context.Context, id string) (ServerStorageClassSingleResponse, *http.Response, error)
+    RestartServer(id string) (ServerActionSingleResponse, *http.Response, error)
+    RestartServerWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error)
+    MountServerMedia(in ServerMediaMountRequest, id string) (ServerSingleResponse, *http.Response, error)
+    MountServerMediaWithContext(ctx context.Context, in ServerMediaMountRequest, id string) (ServerSingleResponse, *http.Response, error)
+    UnmountServerMedia(id string) (ServerSingleResponse, *http.Response, error)
+    UnmountServerMediaWithContext(ctx context.Context, id string) (ServerSingleResponse, *http.Response, error)
+    RestoreServer(in ServerRestoreRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    RestoreServerWithContext(ctx context.Context, in ServerRestoreRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    GetServerGraph(id string, qParams GetServerGraphQueryParams) (ServerGraphResponse, *http.Response, error)
+    GetServerGraphWithContext(ctx context.Context, id string, qParams GetServerGraphQueryParams) (ServerGraphResponse, *http.Response, error)
+    RecreateServer(id string) (EmptyResponse, *http.Response, error)
+    RecreateServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreateServerFirewall(in ServerFirewallCreateRequest) (ServerFirewallSingleResponse, *http.Response, error)
+    CreateServerFirewallWithContext(ctx context.Context, in ServerFirewallCreateRequest) (ServerFirewallSingleResponse, *http.Response, error)
+    GetServerFirewalls(qParams GetServerFirewallsQueryParams) (ServerFirewallListResponse, *http.Response, error)
+    GetServerFirewallsWithContext(ctx context.Context, qParams GetServerFirewallsQueryParams) (ServerFirewallListResponse, *http.Response, error)
+    ListAllServerFirewalls(ctx context.Context, qParams GetServerFirewallsQueryParams) *ServerFirewallIterator
+    GetServerFirewallRule(id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    GetServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    DeleteServerFirewallRule(id string, rule_id string) (EmptyResponse, *http.Response, error)
+    DeleteServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (EmptyResponse, *http.Response, error)
+    UpdateServerFirewallRule(in ServerFirewallRuleUpdateRequest, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    UpdateServerFirewallRuleWithContext(ctx context.Context, in ServerFirewallRuleUpdateRequest, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    CreateServerHost(in ServerHostCreateRequest) (ServerHostSingleResponse, *http.Response, error)
+    CreateServerHostWithContext(ctx context.Context, in ServerHostCreateRequest) (ServerHostSingleResponse, *http.Response, error)
+    GetServerHosts(qParams GetServerHostsQueryParams) (ServerHostListResponse, *http.Response, error)
+    GetServerHostsWithContext(ctx context.Context, qParams GetServerHostsQueryParams) (ServerHostListResponse, *http.Response, error)
+    ListAllServerHosts(ctx context.Context, qParams GetServerHostsQueryParams) *ServerHostIterator
+    CreateServer(in ServerCreateRequest) (ServerSingleResponse, *http.Response, error)
+    CreateServerWithContext(ctx context.Context, in ServerCreateRequest) (ServerSingleResponse, *http.Response, error)
+    GetServers(qParams GetServersQueryParams) (ServerListResponse, *http.Response, error)
+    GetServersWithContext(ctx context.Context, qParams GetServersQueryParams) (ServerListResponse, *http.Response, error)
+    ListAllServers(ctx context.Context, qParams GetServersQueryParams) *ServerIterator
+    DeleteServerNetwork(id string, network_id string) (EmptyResponse, *http.Response, error)
+    DeleteServerNetworkWithContext(ctx context.Context, id string, network_id string) (EmptyResponse, *http.Response, error)
+    GetAvailabilityZone(id string) (AvailabilityZoneSingleResponse, *http.Response, error)
+    GetAvailabilityZoneWithContext(ctx context.Context, id string) (AvailabilityZoneSingleResponse, *http.Response, error)
+    UpdateAvailabilityZone(in AvailabilityZoneUpdateRequest, id string) (AvailabilityZoneSingleResponse, *http.Response, error)
+    UpdateAvailabilityZoneWithContext(ctx context.Context, in AvailabilityZoneUpdateRequest, id string) (AvailabilityZoneSingleResponse, *http.Response, error)
+    CreateServerBackup(in ServerBackupCreateRequest) (ServerBackupSingleResponse, *http.Response, error)
+    CreateServerBackupWithContext(ctx context.Context, in ServerBackupCreateRequest) (ServerBackupSingleResponse, *http.Response, error)
+    GetServerBackups(qParams GetServerBackupsQueryParams) (ServerBackupListResponse, *http.Response, error)
+    GetServerBackupsWithContext(ctx context.Context, qParams GetServerBackupsQueryParams) (ServerBackupListResponse, *http.Response, error)
+    ListAllServerBackups(ctx context.Context, qParams GetServerBackupsQueryParams) *ServerBackupIterator
+    CreateSubnet(in SubnetCreateRequest) (SubnetSingleResponse, *http.Response, error)
+    CreateSubnetWithContext(ctx context.Context, in SubnetCreateRequest) (SubnetSingleResponse, *http.Response, error)
+    GetSubnets(qParams GetSubnetsQueryParams) (SubnetListResponse, *http.Response, error)
+    GetSubnetsWithContext(ctx context.Context, qParams GetSubnetsQueryParams) (SubnetListResponse, *http.Response, error)
+    ListAllSubnets(ctx context.Context, qParams GetSubnetsQueryParams) *SubnetIterator
+    CreateServerVolume(in ServerVolumeCreateRequest) (ServerVolumeSingleResponse, *http.Response, error)
+    CreateServerVolumeWithContext(ctx context.Context, in ServerVolumeCreateRequest) (ServerVolumeSingleResponse, *http.Response, error)
+    GetServerVolumes(qParams GetServerVolumesQueryParams) (ServerVolumeListResponse, *http.Response, error)
+    GetServerVolumesWithContext(ctx context.Context, qParams GetServerVolumesQueryParams) (ServerVolumeListResponse, *http.Response, error)
+    ListAllServerVolumes(ctx context.Context, qParams GetServerVolumesQueryParams) *ServerVolumeIterator
+    CreateServerStorageClass(in ServerStorageClassCreateRequest) (ServerStorageClassSingleResponse, *http.Response, error)
+    CreateServerStorageClassWithContext(ctx context.Context, in ServerStorageClassCreateRequest) (ServerStorageClassSingleResponse, *http.Response, error)
+    GetServerStorageClasses(qParams GetServerStorageClassesQueryParams) (ServerStorageClassListResponse, *http.Response, error)
+    GetServerStorageClassesWithContext(ctx context.Context, qParams GetServerStorageClassesQueryParams) (ServerStorageClassListResponse, *http.Response, error)
+    ListAllServerStorageClasses(ctx context.Context, qParams GetServerStorageClassesQueryParams) *ServerStorageClassIterator
+    GetServerFirewallMember(id string, member_id string) (ServerFirewallMemberSingleResponse, *http.Response, error)
+    GetServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (ServerFirewallMemberSingleResponse, *http.Response, error)
+    DeleteServerFirewallMember(id string, member_id string) (EmptyResponse, *http.Response, error)
+    DeleteServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (EmptyResponse, *http.Response, error)
+    Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    GetScheduledServerAction(id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    GetScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    DeleteScheduledServerAction(id string, action_id string) (EmptyResponse, *http.Response, error)
+    DeleteScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (EmptyResponse, *http.Response, error)
+    UpdateScheduledServerAction(in ScheduledServerActionUpdateRequest, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    UpdateScheduledServerActionWithContext(ctx context.Context, in ScheduledServerActionUpdateRequest, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    CreateS3Bucket(in S3BucketCreateRequest) (S3BucketSingleResponse, *http.Response, error)
+    CreateS3BucketWithContext(ctx context.Context, in S3BucketCreateRequest) (S3BucketSingleResponse, *http.Response, error)
+    GetS3Buckets(qParams GetS3BucketsQueryParams) (S3BucketListResponse, *http.Response, error)
+    GetS3BucketsWithContext(ctx context.Context, qParams GetS3BucketsQueryParams) (S3BucketListResponse, *http.Response, error)
+    ListAllS3Buckets(ctx context.Context, qParams GetS3BucketsQueryParams) *S3BucketIterator
+    GetServerStatus(id string) (ServerStatusResponse, *http.Response, error)
+    GetServerStatusWithContext(ctx context.Context, id string) (ServerStatusResponse, *http.Response, error)
+    CreateServerFirewallMember(in ServerFirewallMemberCreateRequest, id string) (ServerFirewallMemberSingleResponse, *http.Response, error)
+    CreateServerFirewallMemberWithContext(ctx context.Context, in ServerFirewallMemberCreateRequest, id string) (ServerFirewallMemberSingleResponse, *http.Response, error)
+    GetServerFirewallMembers(id string, qParams GetServerFirewallMembersQueryParams) (ServerFirewallMemberListResponse, *http.Response, error)
+    GetServerFirewallMembersWithContext(ctx context.Context, id string, qParams GetServerFirewallMembersQueryParams) (ServerFirewallMemberListResponse, *http.Response, error)
+    ListAllServerFirewallMembers(ctx context.Context, id string, qParams GetServerFirewallMembersQueryParams) *ServerFirewallMemberIterator
+    GetServerPriceRange(id string) (ServerPriceRangeSingleResponse, *http.Response, error)
+    GetServerPriceRangeWithContext(ctx context.Context, id string) (ServerPriceRangeSingleResponse, *http.Response, error)
+    GetServerAction(id string) (ServerActionSingleResponse, *http.Response, error)
+    GetServerActionWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error)
+    GetServerVariantPrice(id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    GetServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    DeleteServerVariantPrice(id string, variant_id string) (EmptyResponse, *http.Response, error)
+    DeleteServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (EmptyResponse, *http.Response, error)
+    UpdateServerVariantPrice(in ServerVariantPriceUpdateRequest, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    UpdateServerVariantPriceWithContext(ctx context.Context, in ServerVariantPriceUpdateRequest, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    GetServerVolumePricing(qParams GetServerVolumePricingQueryParams) (ServerVolumePriceListResponse, *http.Response, error)
+    GetServerVolumePricingWithContext(ctx context.Context, qParams GetServerVolumePricingQueryParams) (ServerVolumePriceListResponse, *http.Response, error)
+    CreateServerTemplate(in ServerTemplateCreateRequest) (ServerTemplateSingleResponse, *http.Response, error)
+    CreateServerTemplateWithContext(ctx context.Context, in ServerTemplateCreateRequest) (ServerTemplateSingleResponse, *http.Response, error)
+    GetServerTemplates(qParams GetServerTemplatesQueryParams) (ServerTemplateListResponse, *http.Response, error)
+    GetServerTemplatesWithContext(ctx context.Context, qParams GetServerTemplatesQueryParams) (ServerTemplateListResponse, *http.Response, error)
+    ListAllServerTemplates(ctx context.Context, qParams GetServerTemplatesQueryParams) *ServerTemplateIterator
+    GetServerHost(id string) (ServerHostSingleResponse, *http.Response, error)
+    GetServerHostWithContext(ctx context.Context, id string) (ServerHostSingleResponse, *http.Response, error)
+    UpdateServerHost(in ServerHostUpdateRequest, id string) (ServerHostSingleResponse, *http.Response, error)
+    UpdateServerHostWithContext(ctx context.Context, in ServerHostUpdateRequest, id string) (ServerHostSingleResponse, *http.Response, error)
+    CreateServerFirewallRule(in ServerFirewallRuleCreateRequest, id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    CreateServerFirewallRuleWithContext(ctx context.Context, in ServerFirewallRuleCreateRequest, id string) (ServerFirewallRuleSingleResponse, *http.Response, error)
+    GetServerFirewallRules(id string, qParams GetServerFirewallRulesQueryParams) (ServerFirewallRuleListResponse, *http.Response, error)
+    GetServerFirewallRulesWithContext(ctx context.Context, id string, qParams GetServerFirewallRulesQueryParams) (ServerFirewallRuleListResponse, *http.Response, error)
+    ListAllServerFirewallRules(ctx context.Context, id string, qParams GetServerFirewallRulesQueryParams) *ServerFirewallRuleIterator
+    CreateServerPriceRangeVolumePrice(in ServerVolumePriceCreateRequest, id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    CreateServerPriceRangeVolumePriceWithContext(ctx context.Context, in ServerVolumePriceCreateRequest, id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    GetServerPriceRangeVolumePrices(id string, qParams GetServerPriceRangeVolumePricesQueryParams) (ServerVolumePriceListResponse, *http.Response, error)
+    GetServerPriceRangeVolumePricesWithContext(ctx context.Context, id string, qParams GetServerPriceRangeVolumePricesQueryParams) (ServerVolumePriceListResponse, *http.Response, error)
+    CreateScheduledServerAction(in ScheduledServerActionCreateRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    CreateScheduledServerActionWithContext(ctx context.Context, in ScheduledServerActionCreateRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error)
+    GetScheduledServerActions(id string, qParams GetScheduledServerActionsQueryParams) (ScheduledServerActionListResponse, *http.Response, error)
+    GetScheduledServerActionsWithContext(ctx context.Context, id string, qParams GetScheduledServerActionsQueryParams) (ScheduledServerActionListResponse, *http.Response, error)
+    ListAllScheduledServerActions(ctx context.Context, id string, qParams GetScheduledServerActionsQueryParams) *ScheduledServerActionIterator
+    GetServerPricing(qParams GetServerPricingQueryParams) (ServerVariantPriceListResponse, *http.Response, error)
+    GetServerPricingWithContext(ctx context.Context, qParams GetServerPricingQueryParams) (ServerVariantPriceListResponse, *http.Response, error)
+    StopServer(id string) (EmptyResponse, *http.Response, error)
+    StopServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    GetServerVolume(id string) (ServerVolumeSingleResponse, *http.Response, error)
+    GetServerVolumeWithContext(ctx context.Context, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    DeleteServerVolume(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerVolumeWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateServerVolume(in ServerVolumeUpdateRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    UpdateServerVolumeWithContext(ctx context.Context, in ServerVolumeUpdateRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    CreateServerNetwork(in ServerNetworkCreateRequest, id string) (ServerNetworkSingleResponse, *http.Response, error)
+    CreateServerNetworkWithContext(ctx context.Context, in ServerNetworkCreateRequest, id string) (ServerNetworkSingleResponse, *http.Response, error)
+    GetServerNetworks(id string, qParams GetServerNetworksQueryParams) (ServerNetworkListResponse, *http.Response, error)
+    GetServerNetworksWithContext(ctx context.Context, id string, qParams GetServerNetworksQueryParams) (ServerNetworkListResponse, *http.Response, error)
+    ListAllServerNetworks(ctx context.Context, id string, qParams GetServerNetworksQueryParams) *ServerNetworkIterator
+    CreateServerVariant(in ServerVariantCreateRequest) (ServerVariantSingleResponse, *http.Response, error)
+    CreateServerVariantWithContext(ctx context.Context, in ServerVariantCreateRequest) (ServerVariantSingleResponse, *http.Response, error)
+    GetServerVariants(qParams GetServerVariantsQueryParams) (ServerVariantListResponse, *http.Response, error)
+    GetServerVariantsWithContext(ctx context.Context, qParams GetServerVariantsQueryParams) (ServerVariantListResponse, *http.Response, error)
+    ListAllServerVariants(ctx context.Context, qParams GetServerVariantsQueryParams) *ServerVariantIterator
+    GetServerStorage(id string) (ServerStorageSingleResponse, *http.Response, error)
+    GetServerStorageWithContext(ctx context.Context, id string) (ServerStorageSingleResponse, *http.Response, error)
+    GetSSHKey(id string) (SSHKeySingleResponse, *http.Response, error)
+    GetSSHKeyWithContext(ctx context.Context, id string) (SSHKeySingleResponse, *http.Response, error)
+    DeleteSSHKey(id string) (EmptyResponse, *http.Response, error)
+    DeleteSSHKeyWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateSSHKey(in SSHKeyUpdateRequest, id string) (SSHKeySingleResponse, *http.Response, error)
+    UpdateSSHKeyWithContext(ctx context.Context, in SSHKeyUpdateRequest, id string) (SSHKeySingleResponse, *http.Response, error)
+    CreateServerPriceRangeAssignment(in ServerPriceRangeAssignmentCreateRequest) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    CreateServerPriceRangeAssignmentWithContext(ctx context.Context, in ServerPriceRangeAssignmentCreateRequest) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    GetServerPriceRangeAssignments(qParams GetServerPriceRangeAssignmentsQueryParams) (ServerPriceRangeAssignmentListResponse, *http.Response, error)
+    GetServerPriceRangeAssignmentsWithContext(ctx context.Context, qParams GetServerPriceRangeAssignmentsQueryParams) (ServerPriceRangeAssignmentListResponse, *http.Response, error)
+    ListAllServerPriceRangeAssignments(ctx context.Context, qParams GetServerPriceRangeAssignmentsQueryParams) *ServerPriceRangeAssignmentIterator
+    GetAddresses(qParams GetAddressesQueryParams) (AddressListResponse, *http.Response, error)
+    GetAddressesWithContext(ctx context.Context, qParams GetAddressesQueryParams) (AddressListResponse, *http.Response, error)
+    ListAllAddresses(ctx context.Context, qParams GetAddressesQueryParams) *AddressIterator
+    GetServerVariant(id string) (ServerVariantSingleResponse, *http.Response, error)
+    GetServerVariantWithContext(ctx context.Context, id string) (ServerVariantSingleResponse, *http.Response, error)
+    DeleteServerVariant(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerVariantWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    DeleteS3AccessKeyGrant(access_key_id string, id string) (EmptyResponse, *http.Response, error)
+    DeleteS3AccessKeyGrantWithContext(ctx context.Context, access_key_id string, id string) (EmptyResponse, *http.Response, error)
+    CreateServerMedia(in ServerMediaCreateRequest) (ServerMediaSingleResponse, *http.Response, error)
+    CreateServerMediaWithContext(ctx context.Context, in ServerMediaCreateRequest) (ServerMediaSingleResponse, *http.Response, error)
+    GetServerMedias(qParams GetServerMediasQueryParams) (ServerMediaListResponse, *http.Response, error)
+    GetServerMediasWithContext(ctx context.Context, qParams GetServerMediasQueryParams) (ServerMediaListResponse, *http.Response, error)
+    ListAllServerMedias(ctx context.Context, qParams GetServerMediasQueryParams) *ServerMediaIterator
+    GetSubnet(id string) (SubnetSingleResponse, *http.Response, error)
+    GetSubnetWithContext(ctx context.Context, id string) (SubnetSingleResponse, *http.Response, error)
+    DeleteSubnet(id string) (EmptyResponse, *http.Response, error)
+    DeleteSubnetWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    AttachServerVolume(in ServerVolumeAttachRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    AttachServerVolumeWithContext(ctx context.Context, in ServerVolumeAttachRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    GetServerPriceRangeVolumePrice(id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    GetServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    DeleteServerPriceRangeVolumePrice(id string, class_id string) (EmptyResponse, *http.Response, error)
+    DeleteServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (EmptyResponse, *http.Response, error)
+    UpdateServerPriceRangeVolumePrice(in ServerVolumePriceUpdateRequest, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    UpdateServerPriceRangeVolumePriceWithContext(ctx context.Context, in ServerVolumePriceUpdateRequest, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error)
+    GetS3AccessKey(id string) (S3AccessKeySingleResponse, *http.Response, error)
+    GetS3AccessKeyWithContext(ctx context.Context, id string) (S3AccessKeySingleResponse, *http.Response, error)
+    DeleteS3AccessKey(id string) (EmptyResponse, *http.Response, error)
+    DeleteS3AccessKeyWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreateS3AccessKey(in S3AccessKeyCreateRequest) (S3AccessKeySingleResponse, *http.Response, error)
+    CreateS3AccessKeyWithContext(ctx context.Context, in S3AccessKeyCreateRequest) (S3AccessKeySingleResponse, *http.Response, error)
+    GetS3AccessKeys(qParams GetS3AccessKeysQueryParams) (S3AccessKeyListResponse, *http.Response, error)
+    GetS3AccessKeysWithContext(ctx context.Context, qParams GetS3AccessKeysQueryParams) (S3AccessKeyListResponse, *http.Response, error)
+    ListAllS3AccessKeys(ctx context.Context, qParams GetS3AccessKeysQueryParams) *S3AccessKeyIterator
+    GetAddress(id string) (AddressSingleResponse, *http.Response, error)
+    GetAddressWithContext(ctx context.Context, id string) (AddressSingleResponse, *http.Response, error)
+    GetServerBackup(id string) (ServerBackupSingleResponse, *http.Response, error)
+    GetServerBackupWithContext(ctx context.Context, id string) (ServerBackupSingleResponse, *http.Response, error)
+    DeleteServerBackup(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerBackupWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateServerBackup(in ServerBackupUpdateRequest, id string) (ServerBackupSingleResponse, *http.Response, error)
+    UpdateServerBackupWithContext(ctx context.Context, in ServerBackupUpdateRequest, id string) (ServerBackupSingleResponse, *http.Response, error)
+    CreateNetwork(in NetworkCreateRequest) (NetworkSingleResponse, *http.Response, error)
+    CreateNetworkWithContext(ctx context.Context, in NetworkCreateRequest) (NetworkSingleResponse, *http.Response, error)
+    GetNetworks(qParams GetNetworksQueryParams) (NetworkListResponse, *http.Response, error)
+    GetNetworksWithContext(ctx context.Context, qParams GetNetworksQueryParams) (NetworkListResponse, *http.Response, error)
+    ListAllNetworks(ctx context.Context, qParams GetNetworksQueryParams) *NetworkIterator
+    CreateServerStorage(in ServerStorageCreateRequest) (ServerStorageSingleResponse, *http.Response, error)
+    CreateServerStorageWithContext(ctx context.Context, in ServerStorageCreateRequest) (ServerStorageSingleResponse, *http.Response, error)
+    GetServerStorages(qParams GetServerStoragesQueryParams) (ServerStorageListResponse, *http.Response, error)
+    GetServerStoragesWithContext(ctx context.Context, qParams GetServerStoragesQueryParams) (ServerStorageListResponse, *http.Response, error)
+    ResizeServer(in ServerResizeRequest, id string) (EmptyResponse, *http.Response, error)
+    ResizeServerWithContext(ctx context.Context, in ServerResizeRequest, id string) (EmptyResponse, *http.Response, error)
+    GetServerMedia(id string) (ServerMediaSingleResponse, *http.Response, error)
+    GetServerMediaWithContext(ctx context.Context, id string) (ServerMediaSingleResponse, *http.Response, error)
+    DeleteServerMedia(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerMediaWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    CreateS3AccessKeyGrant(in S3AccessGrantCreateRequest, access_key_id string) (S3AccessGrantSingleResponse, *http.Response, error)
+    CreateS3AccessKeyGrantWithContext(ctx context.Context, in S3AccessGrantCreateRequest, access_key_id string) (S3AccessGrantSingleResponse, *http.Response, error)
+    GetS3AccessKeyGrants(access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) (S3AccessGrantListResponse, *http.Response, error)
+    GetS3AccessKeyGrantsWithContext(ctx context.Context, access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) (S3AccessGrantListResponse, *http.Response, error)
+    ListAllS3AccessKeyGrants(ctx context.Context, access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) *S3AccessGrantIterator
+    GetServerPriceRangeAssignment(id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    GetServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    DeleteServerPriceRangeAssignment(id string) (EmptyResponse, *http.Response, error)
+    DeleteServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    UpdateServerPriceRangeAssignment(in ServerPriceRangeAssignmentUpdateRequest, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    UpdateServerPriceRangeAssignmentWithContext(ctx context.Context, in ServerPriceRangeAssignmentUpdateRequest, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    GetServerVNC(id string) (ServerVNCResponse, *http.Response, error)
+    GetServerVNCWithContext(ctx context.Context, id string) (ServerVNCResponse, *http.Response, error)
+    CancelServerAction(id string) (ServerActionSingleResponse, *http.Response, error)
+    CancelServerActionWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error)
+    GetNetwork(id string) (NetworkSingleResponse, *http.Response, error)
+    GetNetworkWithContext(ctx context.Context, id string) (NetworkSingleResponse, *http.Response, error)
+    UpdateNetwork(in NetworkUpdateRequest, id string) (NetworkSingleResponse, *http.Response, error)
+    UpdateNetworkWithContext(ctx context.Context, in NetworkUpdateRequest, id string) (NetworkSingleResponse, *http.Response, error)
+    GetLabels(qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error)
+    GetLabelsWithContext(ctx context.Context, qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error)
+    ListAllLabels(ctx context.Context, qParams GetLabelsQueryParams) *LabelIterator
+    ResizeServerVolume(in ServerVolumeResizeRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    ResizeServerVolumeWithContext(ctx context.Context, in ServerVolumeResizeRequest, id string) (ServerVolumeSingleResponse, *http.Response, error)
+    GetS3Bucket(id string) (S3BucketSingleResponse, *http.Response, error)
+    GetS3BucketWithContext(ctx context.Context, id string) (S3BucketSingleResponse, *http.Response, error)
+    DeleteS3Bucket(id string) (EmptyResponse, *http.Response, error)
+    DeleteS3BucketWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error)
+    DetachServerVolume(id string, qParams DetachServerVolumeQueryParams) (ServerVolumeSingleResponse, *http.Response, error)
+    DetachServerVolumeWithContext(ctx context.Context, id string, qParams DetachServerVolumeQueryParams) (ServerVolumeSingleResponse, *http.Response, error)
+    CreateServerVariantPrice(in ServerVariantPriceCreateRequest, id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    CreateServerVariantPriceWithContext(ctx context.Context, in ServerVariantPriceCreateRequest, id string) (ServerVariantPriceSingleResponse, *http.Response, error)
+    GetServerVariantPrices(id string, qParams GetServerVariantPricesQueryParams) (ServerVariantPriceListResponse, *http.Response, error)
+    GetServerVariantPricesWithContext(ctx context.Context, id string, qParams GetServerVariantPricesQueryParams) (ServerVariantPriceListResponse, *http.Response, error)
+    ListAllServerVariantPrices(ctx context.Context, id string, qParams GetServerVariantPricesQueryParams) *ServerVariantPriceIterator
+}
+
+var _ API = ComputeClient{}
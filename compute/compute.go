@@ -1,23 +1,14 @@
 package compute
 
 import (
-    "bytes"
-    "io/ioutil"
+    "context"
     "net/http"
-    "time"
-    "encoding/json"
-    "io"
-    "errors"
-    "strconv"
-    "github.com/google/go-querystring/query"
     "reflect"
+    "github.com/lumaserv/lumaserv-api-go/core"
 )
 
 type ComputeClient struct {
-    baseUrl string
-    apiKey  string
-    client  *http.Client
-    currentProject string
+    *core.Client
 }
 
 func NewClient (apiKey string) ComputeClient {
@@ -30,92 +21,18 @@ func NewClientWithUrl (apiKey string, baseUrl string) ComputeClient {
     }
 
     return ComputeClient {
-        apiKey: apiKey,
-        baseUrl: baseUrl,
+        Client: core.NewClient(apiKey, baseUrl),
     }
 }
 
-func (c *ComputeClient) SetCurrentProject (project string) {
-    c.currentProject = project
-}
-
-func (c *ComputeClient) GetCurrentProject () string {
-    return c.currentProject
-}
-
-func (c *ComputeClient) SetHttpClient(client *http.Client) {
-    c.client = client
-}
-
-func (c *ComputeClient) SetAccessToken(token string) {
-    c.apiKey = token
-}
-
-func (c *ComputeClient) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
-    if c.client == nil {
-        c.client = &http.Client{
-            Timeout: time.Second * 5,
-        }
-    }
-
-    req, err := http.NewRequest(method, c.baseUrl+path, postBody)
-    if err != nil {
-        return nil, nil, err
-    }
-
-    req.Header.Add("Authorization", "Bearer "+c.apiKey)
-    req.Header.Add("User-Agent", "LUMASERV-go-client")
-    req.Header.Add("Accept", "application/json")
-    res, err := c.client.Do(req)
-    if err != nil {
-        return res, nil, err
-    }
-
-    if res.Body != nil {
-        defer res.Body.Close()
-    }
+type ResponseMessages = core.ResponseMessages
 
-    body, err := ioutil.ReadAll(res.Body)
+type ResponseMessage = core.ResponseMessage
 
-    return res, body, err
-}
+type ResponsePagination = core.ResponsePagination
 
-func (c ComputeClient) toStr(in interface{}) string {
-    switch in.(type) {
-        case string:
-            return in.(string)
-        case int:
-            return strconv.Itoa(in.(int))
-    }
+type ResponseMetadata = core.ResponseMetadata
 
-    panic("Unhandled type in toStr")
-}
-
-func (c ComputeClient) applyCurrentProject (v reflect.Value) {
-    if len(c.currentProject) > 0 {
-        if v.Kind() == reflect.Ptr {
-            x := v.Elem()
-            f := x.FieldByName("ProjectId")
-            if f.IsValid() && f.CanSet() {
-                if f.Kind() == reflect.String && len(f.String()) == 0 {
-                    f.SetString(c.currentProject)
-                } else if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String {
-                    f.Set(reflect.ValueOf(&c.currentProject))
-                }
-            }
-
-            for i := 0; i < x.NumField(); i++ {
-                field := x.Field(i)
-                if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct || field.Kind() == reflect.Struct {
-                    if field.IsNil() && field.CanSet() {
-                        field.Set(reflect.New(field.Type().Elem()))
-                    }
-                    c.applyCurrentProject(field)
-                }
-            }
-        }
-    }
-}
 type SSHKey struct {
     PublicKey string `json:"public_key"`
     ProjectId string `json:"project_id"`
@@ -191,6 +108,39 @@ type ServerBackup struct {
 
 type ObjectType string
 
+const (
+    ObjectTypeServer ObjectType = "SERVER"
+    ObjectTypeServerVolume ObjectType = "SERVER_VOLUME"
+    ObjectTypeServerMedia ObjectType = "SERVER_MEDIA"
+    ObjectTypeServerBackup ObjectType = "SERVER_BACKUP"
+    ObjectTypeServerFirewall ObjectType = "SERVER_FIREWALL"
+    ObjectTypeSSHKey ObjectType = "SSH_KEY"
+    ObjectTypeNetwork ObjectType = "NETWORK"
+    ObjectTypeSubnet ObjectType = "SUBNET"
+    ObjectTypeAddress ObjectType = "ADDRESS"
+    ObjectTypeS3Bucket ObjectType = "S3_BUCKET"
+    ObjectTypeS3AccessKey ObjectType = "S3_ACCESS_KEY"
+    ObjectTypeDNSZone ObjectType = "DNS_ZONE"
+    ObjectTypeDomain ObjectType = "DOMAIN"
+    ObjectTypeDomainHandle ObjectType = "DOMAIN_HANDLE"
+    ObjectTypeSSLCertificate ObjectType = "SSL_CERTIFICATE"
+    ObjectTypeSSLContact ObjectType = "SSL_CONTACT"
+    ObjectTypeSSLOrganisation ObjectType = "SSL_ORGANISATION"
+    ObjectTypePleskLicense ObjectType = "PLESK_LICENSE"
+)
+
+func (e ObjectType) String() string {
+    return string(e)
+}
+
+func (e ObjectType) IsValid() bool {
+    switch e {
+        case ObjectTypeServer, ObjectTypeServerVolume, ObjectTypeServerMedia, ObjectTypeServerBackup, ObjectTypeServerFirewall, ObjectTypeSSHKey, ObjectTypeNetwork, ObjectTypeSubnet, ObjectTypeAddress, ObjectTypeS3Bucket, ObjectTypeS3AccessKey, ObjectTypeDNSZone, ObjectTypeDomain, ObjectTypeDomainHandle, ObjectTypeSSLCertificate, ObjectTypeSSLContact, ObjectTypeSSLOrganisation, ObjectTypePleskLicense:
+            return true
+    }
+    return false
+}
+
 type S3AccessKey struct {
     ProjectId string `json:"project_id"`
     Id string `json:"id"`
@@ -221,6 +171,23 @@ type ServerTemplate struct {
 
 type NetworkType string
 
+const (
+    NetworkTypePublic NetworkType = "PUBLIC"
+    NetworkTypePrivate NetworkType = "PRIVATE"
+)
+
+func (e NetworkType) String() string {
+    return string(e)
+}
+
+func (e NetworkType) IsValid() bool {
+    switch e {
+        case NetworkTypePublic, NetworkTypePrivate:
+            return true
+    }
+    return false
+}
+
 type Network struct {
     ZoneId string `json:"zone_id"`
     ProjectId string `json:"project_id"`
@@ -242,14 +209,48 @@ type ServerStatus struct {
 
 type ServerBackupState string
 
-type ResponseMessages struct {
-    Warnings []ResponseMessage `json:"warnings"`
-    Errors []ResponseMessage `json:"errors"`
-    Infos []ResponseMessage `json:"infos"`
+const (
+    ServerBackupStateCreating ServerBackupState = "CREATING"
+    ServerBackupStateAvailable ServerBackupState = "AVAILABLE"
+    ServerBackupStateRestoring ServerBackupState = "RESTORING"
+    ServerBackupStateDeleting ServerBackupState = "DELETING"
+    ServerBackupStateFailed ServerBackupState = "FAILED"
+)
+
+func (e ServerBackupState) String() string {
+    return string(e)
+}
+
+func (e ServerBackupState) IsValid() bool {
+    switch e {
+        case ServerBackupStateCreating, ServerBackupStateAvailable, ServerBackupStateRestoring, ServerBackupStateDeleting, ServerBackupStateFailed:
+            return true
+    }
+    return false
 }
 
 type ServerActionState string
 
+const (
+    ServerActionStatePending ServerActionState = "PENDING"
+    ServerActionStateRunning ServerActionState = "RUNNING"
+    ServerActionStateFinished ServerActionState = "FINISHED"
+    ServerActionStateFailed ServerActionState = "FAILED"
+    ServerActionStateCancelled ServerActionState = "CANCELLED"
+)
+
+func (e ServerActionState) String() string {
+    return string(e)
+}
+
+func (e ServerActionState) IsValid() bool {
+    switch e {
+        case ServerActionStatePending, ServerActionStateRunning, ServerActionStateFinished, ServerActionStateFailed, ServerActionStateCancelled:
+            return true
+    }
+    return false
+}
+
 type ServerHost struct {
     ZoneId string `json:"zone_id"`
     CreatedAt string `json:"created_at"`
@@ -299,6 +300,33 @@ type ServerVNC struct {
 
 type ServerState string
 
+const (
+    ServerStateCreating ServerState = "CREATING"
+    ServerStateInstalling ServerState = "INSTALLING"
+    ServerStateStarting ServerState = "STARTING"
+    ServerStateRunning ServerState = "RUNNING"
+    ServerStateStopping ServerState = "STOPPING"
+    ServerStateStopped ServerState = "STOPPED"
+    ServerStateRestarting ServerState = "RESTARTING"
+    ServerStateResizing ServerState = "RESIZING"
+    ServerStateRestoring ServerState = "RESTORING"
+    ServerStateRecreating ServerState = "RECREATING"
+    ServerStateDeleting ServerState = "DELETING"
+    ServerStateError ServerState = "ERROR"
+)
+
+func (e ServerState) String() string {
+    return string(e)
+}
+
+func (e ServerState) IsValid() bool {
+    switch e {
+        case ServerStateCreating, ServerStateInstalling, ServerStateStarting, ServerStateRunning, ServerStateStopping, ServerStateStopped, ServerStateRestarting, ServerStateResizing, ServerStateRestoring, ServerStateRecreating, ServerStateDeleting, ServerStateError:
+            return true
+    }
+    return false
+}
+
 type AvailabilityZone struct {
     CountryCode string `json:"country_code"`
     City string `json:"city"`
@@ -323,12 +351,24 @@ type ServerStorage struct {
     Id string `json:"id"`
 }
 
-type ResponseMessage struct {
-    Message string `json:"message"`
-    Key string `json:"key"`
+type ServerFirewallMemberType string
+
+const (
+    ServerFirewallMemberTypeServer ServerFirewallMemberType = "SERVER"
+    ServerFirewallMemberTypeLabel ServerFirewallMemberType = "LABEL"
+)
+
+func (e ServerFirewallMemberType) String() string {
+    return string(e)
 }
 
-type ServerFirewallMemberType string
+func (e ServerFirewallMemberType) IsValid() bool {
+    switch e {
+        case ServerFirewallMemberTypeServer, ServerFirewallMemberTypeLabel:
+            return true
+    }
+    return false
+}
 
 type ServerVariantPrice struct {
     VariantId string `json:"variant_id"`
@@ -338,6 +378,26 @@ type ServerVariantPrice struct {
 
 type ScheduledServerActionInterval string
 
+const (
+    ScheduledServerActionIntervalOnce ScheduledServerActionInterval = "ONCE"
+    ScheduledServerActionIntervalHourly ScheduledServerActionInterval = "HOURLY"
+    ScheduledServerActionIntervalDaily ScheduledServerActionInterval = "DAILY"
+    ScheduledServerActionIntervalWeekly ScheduledServerActionInterval = "WEEKLY"
+    ScheduledServerActionIntervalMonthly ScheduledServerActionInterval = "MONTHLY"
+)
+
+func (e ScheduledServerActionInterval) String() string {
+    return string(e)
+}
+
+func (e ScheduledServerActionInterval) IsValid() bool {
+    switch e {
+        case ScheduledServerActionIntervalOnce, ScheduledServerActionIntervalHourly, ScheduledServerActionIntervalDaily, ScheduledServerActionIntervalWeekly, ScheduledServerActionIntervalMonthly:
+            return true
+    }
+    return false
+}
+
 type ServerMedia struct {
     ZoneId *string `json:"zone_id"`
     ProjectId string `json:"project_id"`
@@ -350,16 +410,74 @@ type ServerMedia struct {
 
 type ServerFirewallRuleProtocol string
 
+const (
+    ServerFirewallRuleProtocolTCP ServerFirewallRuleProtocol = "TCP"
+    ServerFirewallRuleProtocolUDP ServerFirewallRuleProtocol = "UDP"
+    ServerFirewallRuleProtocolICMP ServerFirewallRuleProtocol = "ICMP"
+)
+
+func (e ServerFirewallRuleProtocol) String() string {
+    return string(e)
+}
+
+func (e ServerFirewallRuleProtocol) IsValid() bool {
+    switch e {
+        case ServerFirewallRuleProtocolTCP, ServerFirewallRuleProtocolUDP, ServerFirewallRuleProtocolICMP:
+            return true
+    }
+    return false
+}
+
 type ServerFirewallRuleType string
 
-type ResponsePagination struct {
-    Total int `json:"total"`
-    Page int `json:"page"`
-    PageSize int `json:"page_size"`
+const (
+    ServerFirewallRuleTypeIngress ServerFirewallRuleType = "INGRESS"
+    ServerFirewallRuleTypeEgress ServerFirewallRuleType = "EGRESS"
+)
+
+func (e ServerFirewallRuleType) String() string {
+    return string(e)
+}
+
+func (e ServerFirewallRuleType) IsValid() bool {
+    switch e {
+        case ServerFirewallRuleTypeIngress, ServerFirewallRuleTypeEgress:
+            return true
+    }
+    return false
 }
 
 type ServerActionType string
 
+const (
+    ServerActionTypeCreate ServerActionType = "CREATE"
+    ServerActionTypeStart ServerActionType = "START"
+    ServerActionTypeStop ServerActionType = "STOP"
+    ServerActionTypeShutdown ServerActionType = "SHUTDOWN"
+    ServerActionTypeRestart ServerActionType = "RESTART"
+    ServerActionTypeResize ServerActionType = "RESIZE"
+    ServerActionTypeRecreate ServerActionType = "RECREATE"
+    ServerActionTypeRestore ServerActionType = "RESTORE"
+    ServerActionTypeBackup ServerActionType = "BACKUP"
+    ServerActionTypeDelete ServerActionType = "DELETE"
+    ServerActionTypeMountMedia ServerActionType = "MOUNT_MEDIA"
+    ServerActionTypeUnmountMedia ServerActionType = "UNMOUNT_MEDIA"
+    ServerActionTypeAttachVolume ServerActionType = "ATTACH_VOLUME"
+    ServerActionTypeDetachVolume ServerActionType = "DETACH_VOLUME"
+)
+
+func (e ServerActionType) String() string {
+    return string(e)
+}
+
+func (e ServerActionType) IsValid() bool {
+    switch e {
+        case ServerActionTypeCreate, ServerActionTypeStart, ServerActionTypeStop, ServerActionTypeShutdown, ServerActionTypeRestart, ServerActionTypeResize, ServerActionTypeRecreate, ServerActionTypeRestore, ServerActionTypeBackup, ServerActionTypeDelete, ServerActionTypeMountMedia, ServerActionTypeUnmountMedia, ServerActionTypeAttachVolume, ServerActionTypeDetachVolume:
+            return true
+    }
+    return false
+}
+
 type ServerCreateRequestNetwork struct {
     NetworkId string `json:"network_id"`
 }
@@ -435,12 +553,6 @@ type ServerAction struct {
     EndedAt *string `json:"ended_at"`
 }
 
-type ResponseMetadata struct {
-    TransactionId string `json:"transaction_id"`
-    BuildCommit string `json:"build_commit"`
-    BuildTimestamp string `json:"build_timestamp"`
-}
-
 type AddressAssignments struct {
     AssignedType ObjectType `json:"assigned_type"`
     AssignedId string `json:"assigned_id"`
@@ -1161,21 +1273,13 @@ type ServerResizeRequest struct {
 }
 
 func (c ComputeClient) CreateSSHKey(in SSHKeyCreateRequest) (SSHKeySingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateSSHKeyWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateSSHKeyWithContext(ctx context.Context, in SSHKeyCreateRequest) (SSHKeySingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SSHKeySingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/ssh-keys", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/ssh-keys", nil, in, &body)
     return body, res, err
 }
 
@@ -1196,43 +1300,24 @@ type GetSSHKeysQueryParams struct {
 }
 
 func (c ComputeClient) GetSSHKeys(qParams GetSSHKeysQueryParams) (SSHKeyListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSSHKeysWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetSSHKeysWithContext(ctx context.Context, qParams GetSSHKeysQueryParams) (SSHKeyListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SSHKeyListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/ssh-keys"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssh-keys", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerPriceRange(in ServerPriceRangeCreateRequest) (ServerPriceRangeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerPriceRangeWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerPriceRangeWithContext(ctx context.Context, in ServerPriceRangeCreateRequest) (ServerPriceRangeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerPriceRangeSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-price-ranges", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-price-ranges", nil, in, &body)
     return body, res, err
 }
 
@@ -1251,60 +1336,34 @@ type GetServerPriceRangesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerPriceRanges(qParams GetServerPriceRangesQueryParams) (ServerPriceRangeListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerPriceRangesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerPriceRangesWithContext(ctx context.Context, qParams GetServerPriceRangesQueryParams) (ServerPriceRangeListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerPriceRangeListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-price-ranges"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) StartServer(id string) (EmptyResponse, *http.Response, error) {
+    return c.StartServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) StartServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/start", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/start", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateAvailabilityZone(in AvailabilityZoneCreateRequest) (AvailabilityZoneSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateAvailabilityZoneWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateAvailabilityZoneWithContext(ctx context.Context, in AvailabilityZoneCreateRequest) (AvailabilityZoneSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := AvailabilityZoneSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/availability-zones", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/availability-zones", nil, in, &body)
     return body, res, err
 }
 
@@ -1325,41 +1384,23 @@ type GetAvailabilityZonesQueryParams struct {
 }
 
 func (c ComputeClient) GetAvailabilityZones(qParams GetAvailabilityZonesQueryParams) (AvailabilityZoneListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetAvailabilityZonesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetAvailabilityZonesWithContext(ctx context.Context, qParams GetAvailabilityZonesQueryParams) (AvailabilityZoneListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := AvailabilityZoneListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/availability-zones"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/availability-zones", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerTemplate(id string) (ServerTemplateSingleResponse, *http.Response, error) {
+    return c.GetServerTemplateWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerTemplateWithContext(ctx context.Context, id string) (ServerTemplateSingleResponse, *http.Response, error) {
     body := ServerTemplateSingleResponse{}
-    res, j, err := c.Request("GET", "/server-templates/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-templates/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
@@ -1368,111 +1409,64 @@ type ShutdownServerQueryParams struct {
 }
 
 func (c ComputeClient) ShutdownServer(id string, qParams ShutdownServerQueryParams) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.ShutdownServerWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) ShutdownServerWithContext(ctx context.Context, id string, qParams ShutdownServerQueryParams) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := EmptyResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/shutdown"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/shutdown", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerFirewall(id string) (ServerFirewallSingleResponse, *http.Response, error) {
+    return c.GetServerFirewallWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerFirewallWithContext(ctx context.Context, id string) (ServerFirewallSingleResponse, *http.Response, error) {
     body := ServerFirewallSingleResponse{}
-    res, j, err := c.Request("GET", "/server-firewalls/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerFirewall(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerFirewallWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerFirewallWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-firewalls/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-firewalls/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServer(id string) (ServerSingleResponse, *http.Response, error) {
+    return c.GetServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerWithContext(ctx context.Context, id string) (ServerSingleResponse, *http.Response, error) {
     body := ServerSingleResponse{}
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServer(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/servers/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/servers/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServer(in ServerUpdateRequest, id string) (ServerSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateServerWithContext(ctx context.Context, in ServerUpdateRequest, id string) (ServerSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/servers/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/servers/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
@@ -1493,113 +1487,65 @@ type GetServerActionsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerActions(qParams GetServerActionsQueryParams) (ServerActionListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerActionsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerActionsWithContext(ctx context.Context, qParams GetServerActionsQueryParams) (ServerActionListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerActionListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-actions"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-actions", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerStorageClass(id string) (ServerStorageClassSingleResponse, *http.Response, error) {
+    return c.GetServerStorageClassWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerStorageClassWithContext(ctx context.Context, id string) (ServerStorageClassSingleResponse, *http.Response, error) {
     body := ServerStorageClassSingleResponse{}
-    res, j, err := c.Request("GET", "/server-storage-classes/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-storage-classes/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) RestartServer(id string) (ServerActionSingleResponse, *http.Response, error) {
+    return c.RestartServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) RestartServerWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error) {
     body := ServerActionSingleResponse{}
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/restart", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/restart", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) MountServerMedia(in ServerMediaMountRequest, id string) (ServerSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.MountServerMediaWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) MountServerMediaWithContext(ctx context.Context, in ServerMediaMountRequest, id string) (ServerSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/mount", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/mount", nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UnmountServerMedia(id string) (ServerSingleResponse, *http.Response, error) {
+    return c.UnmountServerMediaWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) UnmountServerMediaWithContext(ctx context.Context, id string) (ServerSingleResponse, *http.Response, error) {
     body := ServerSingleResponse{}
-    res, j, err := c.Request("DELETE", "/servers/"+c.toStr(id)+"/mount", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/servers/"+core.ToStr(id)+"/mount", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) RestoreServer(in ServerRestoreRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.RestoreServerWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) RestoreServerWithContext(ctx context.Context, in ServerRestoreRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ScheduledServerActionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/restore", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/restore", nil, in, &body)
     return body, res, err
 }
 
@@ -1608,60 +1554,34 @@ type GetServerGraphQueryParams struct {
 }
 
 func (c ComputeClient) GetServerGraph(id string, qParams GetServerGraphQueryParams) (ServerGraphResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerGraphWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerGraphWithContext(ctx context.Context, id string, qParams GetServerGraphQueryParams) (ServerGraphResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerGraphResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/graph"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/graph", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) RecreateServer(id string) (EmptyResponse, *http.Response, error) {
+    return c.RecreateServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) RecreateServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/recreate", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/recreate", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerFirewall(in ServerFirewallCreateRequest) (ServerFirewallSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerFirewallWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerFirewallWithContext(ctx context.Context, in ServerFirewallCreateRequest) (ServerFirewallSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerFirewallSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-firewalls", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-firewalls", nil, in, &body)
     return body, res, err
 }
 
@@ -1681,96 +1601,55 @@ type GetServerFirewallsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerFirewalls(qParams GetServerFirewallsQueryParams) (ServerFirewallListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerFirewallsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerFirewallsWithContext(ctx context.Context, qParams GetServerFirewallsQueryParams) (ServerFirewallListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerFirewallListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-firewalls"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerFirewallRule(id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
+    return c.GetServerFirewallRuleWithContext(context.Background(), id, rule_id)
+}
+
+func (c ComputeClient) GetServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
     body := ServerFirewallRuleSingleResponse{}
-    res, j, err := c.Request("GET", "/server-firewalls/"+c.toStr(id)+"/rules/"+c.toStr(rule_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls/"+core.ToStr(id)+"/rules/"+core.ToStr(rule_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerFirewallRule(id string, rule_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerFirewallRuleWithContext(context.Background(), id, rule_id)
+}
+
+func (c ComputeClient) DeleteServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-firewalls/"+c.toStr(id)+"/rules/"+c.toStr(rule_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-firewalls/"+core.ToStr(id)+"/rules/"+core.ToStr(rule_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerFirewallRule(in ServerFirewallRuleUpdateRequest, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerFirewallRuleWithContext(context.Background(), in, id, rule_id)
+}
+
+func (c ComputeClient) UpdateServerFirewallRuleWithContext(ctx context.Context, in ServerFirewallRuleUpdateRequest, id string, rule_id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerFirewallRuleSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-firewalls/"+c.toStr(id)+"/rules/"+c.toStr(rule_id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-firewalls/"+core.ToStr(id)+"/rules/"+core.ToStr(rule_id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerHost(in ServerHostCreateRequest) (ServerHostSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerHostWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerHostWithContext(ctx context.Context, in ServerHostCreateRequest) (ServerHostSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerHostSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-hosts", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-hosts", nil, in, &body)
     return body, res, err
 }
 
@@ -1789,43 +1668,24 @@ type GetServerHostsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerHosts(qParams GetServerHostsQueryParams) (ServerHostListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerHostsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerHostsWithContext(ctx context.Context, qParams GetServerHostsQueryParams) (ServerHostListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerHostListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-hosts"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-hosts", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServer(in ServerCreateRequest) (ServerSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerWithContext(ctx context.Context, in ServerCreateRequest) (ServerSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers", nil, in, &body)
     return body, res, err
 }
 
@@ -1852,96 +1712,55 @@ type GetServersQueryParams struct {
 }
 
 func (c ComputeClient) GetServers(qParams GetServersQueryParams) (ServerListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServersWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServersWithContext(ctx context.Context, qParams GetServersQueryParams) (ServerListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/servers"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerNetwork(id string, network_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerNetworkWithContext(context.Background(), id, network_id)
+}
+
+func (c ComputeClient) DeleteServerNetworkWithContext(ctx context.Context, id string, network_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/servers/"+c.toStr(id)+"/networks/"+c.toStr(network_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/servers/"+core.ToStr(id)+"/networks/"+core.ToStr(network_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetAvailabilityZone(id string) (AvailabilityZoneSingleResponse, *http.Response, error) {
+    return c.GetAvailabilityZoneWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetAvailabilityZoneWithContext(ctx context.Context, id string) (AvailabilityZoneSingleResponse, *http.Response, error) {
     body := AvailabilityZoneSingleResponse{}
-    res, j, err := c.Request("GET", "/availability-zones/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/availability-zones/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateAvailabilityZone(in AvailabilityZoneUpdateRequest, id string) (AvailabilityZoneSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateAvailabilityZoneWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateAvailabilityZoneWithContext(ctx context.Context, in AvailabilityZoneUpdateRequest, id string) (AvailabilityZoneSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := AvailabilityZoneSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/availability-zones/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/availability-zones/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerBackup(in ServerBackupCreateRequest) (ServerBackupSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerBackupWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerBackupWithContext(ctx context.Context, in ServerBackupCreateRequest) (ServerBackupSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerBackupSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-backups", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-backups", nil, in, &body)
     return body, res, err
 }
 
@@ -1961,43 +1780,24 @@ type GetServerBackupsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerBackups(qParams GetServerBackupsQueryParams) (ServerBackupListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerBackupsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerBackupsWithContext(ctx context.Context, qParams GetServerBackupsQueryParams) (ServerBackupListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerBackupListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-backups"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-backups", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateSubnet(in SubnetCreateRequest) (SubnetSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateSubnetWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateSubnetWithContext(ctx context.Context, in SubnetCreateRequest) (SubnetSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SubnetSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/subnets", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/subnets", nil, in, &body)
     return body, res, err
 }
 
@@ -2019,43 +1819,24 @@ type GetSubnetsQueryParams struct {
 }
 
 func (c ComputeClient) GetSubnets(qParams GetSubnetsQueryParams) (SubnetListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetSubnetsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetSubnetsWithContext(ctx context.Context, qParams GetSubnetsQueryParams) (SubnetListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SubnetListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/subnets"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/subnets", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerVolume(in ServerVolumeCreateRequest) (ServerVolumeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerVolumeWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerVolumeWithContext(ctx context.Context, in ServerVolumeCreateRequest) (ServerVolumeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumeSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-volumes", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-volumes", nil, in, &body)
     return body, res, err
 }
 
@@ -2079,43 +1860,24 @@ type GetServerVolumesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerVolumes(qParams GetServerVolumesQueryParams) (ServerVolumeListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerVolumesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerVolumesWithContext(ctx context.Context, qParams GetServerVolumesQueryParams) (ServerVolumeListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVolumeListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-volumes"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-volumes", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerStorageClass(in ServerStorageClassCreateRequest) (ServerStorageClassSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerStorageClassWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerStorageClassWithContext(ctx context.Context, in ServerStorageClassCreateRequest) (ServerStorageClassSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerStorageClassSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-storage-classes", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-storage-classes", nil, in, &body)
     return body, res, err
 }
 
@@ -2136,58 +1898,33 @@ type GetServerStorageClassesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerStorageClasses(qParams GetServerStorageClassesQueryParams) (ServerStorageClassListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerStorageClassesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerStorageClassesWithContext(ctx context.Context, qParams GetServerStorageClassesQueryParams) (ServerStorageClassListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerStorageClassListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-storage-classes"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-storage-classes", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerFirewallMember(id string, member_id string) (ServerFirewallMemberSingleResponse, *http.Response, error) {
+    return c.GetServerFirewallMemberWithContext(context.Background(), id, member_id)
+}
+
+func (c ComputeClient) GetServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (ServerFirewallMemberSingleResponse, *http.Response, error) {
     body := ServerFirewallMemberSingleResponse{}
-    res, j, err := c.Request("GET", "/server-firewalls/"+c.toStr(id)+"/members/"+c.toStr(member_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls/"+core.ToStr(id)+"/members/"+core.ToStr(member_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerFirewallMember(id string, member_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerFirewallMemberWithContext(context.Background(), id, member_id)
+}
+
+func (c ComputeClient) DeleteServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-firewalls/"+c.toStr(id)+"/members/"+c.toStr(member_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-firewalls/"+core.ToStr(id)+"/members/"+core.ToStr(member_id), nil, nil, &body)
     return body, res, err
 }
 
@@ -2205,96 +1942,55 @@ type SearchQueryParams struct {
 }
 
 func (c ComputeClient) Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.SearchWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SearchResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/search"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/search", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetScheduledServerAction(id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
+    return c.GetScheduledServerActionWithContext(context.Background(), id, action_id)
+}
+
+func (c ComputeClient) GetScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
     body := ScheduledServerActionSingleResponse{}
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/scheduled-actions/"+c.toStr(action_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/scheduled-actions/"+core.ToStr(action_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteScheduledServerAction(id string, action_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteScheduledServerActionWithContext(context.Background(), id, action_id)
+}
+
+func (c ComputeClient) DeleteScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/servers/"+c.toStr(id)+"/scheduled-actions/"+c.toStr(action_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/servers/"+core.ToStr(id)+"/scheduled-actions/"+core.ToStr(action_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateScheduledServerAction(in ScheduledServerActionUpdateRequest, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateScheduledServerActionWithContext(context.Background(), in, id, action_id)
+}
+
+func (c ComputeClient) UpdateScheduledServerActionWithContext(ctx context.Context, in ScheduledServerActionUpdateRequest, id string, action_id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ScheduledServerActionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/servers/"+c.toStr(id)+"/scheduled-actions/"+c.toStr(action_id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/servers/"+core.ToStr(id)+"/scheduled-actions/"+core.ToStr(action_id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateS3Bucket(in S3BucketCreateRequest) (S3BucketSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateS3BucketWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateS3BucketWithContext(ctx context.Context, in S3BucketCreateRequest) (S3BucketSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := S3BucketSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/storage/s3/buckets", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/storage/s3/buckets", nil, in, &body)
     return body, res, err
 }
 
@@ -2314,60 +2010,34 @@ type GetS3BucketsQueryParams struct {
 }
 
 func (c ComputeClient) GetS3Buckets(qParams GetS3BucketsQueryParams) (S3BucketListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetS3BucketsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetS3BucketsWithContext(ctx context.Context, qParams GetS3BucketsQueryParams) (S3BucketListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := S3BucketListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/storage/s3/buckets"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/storage/s3/buckets", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerStatus(id string) (ServerStatusResponse, *http.Response, error) {
+    return c.GetServerStatusWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerStatusWithContext(ctx context.Context, id string) (ServerStatusResponse, *http.Response, error) {
     body := ServerStatusResponse{}
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/status", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/status", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerFirewallMember(in ServerFirewallMemberCreateRequest, id string) (ServerFirewallMemberSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerFirewallMemberWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateServerFirewallMemberWithContext(ctx context.Context, in ServerFirewallMemberCreateRequest, id string) (ServerFirewallMemberSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerFirewallMemberSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-firewalls/"+c.toStr(id)+"/members", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-firewalls/"+core.ToStr(id)+"/members", nil, in, &body)
     return body, res, err
 }
 
@@ -2390,111 +2060,64 @@ type GetServerFirewallMembersQueryParams struct {
 }
 
 func (c ComputeClient) GetServerFirewallMembers(id string, qParams GetServerFirewallMembersQueryParams) (ServerFirewallMemberListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerFirewallMembersWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerFirewallMembersWithContext(ctx context.Context, id string, qParams GetServerFirewallMembersQueryParams) (ServerFirewallMemberListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerFirewallMemberListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-firewalls/"+c.toStr(id)+"/members"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls/"+core.ToStr(id)+"/members", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerPriceRange(id string) (ServerPriceRangeSingleResponse, *http.Response, error) {
+    return c.GetServerPriceRangeWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerPriceRangeWithContext(ctx context.Context, id string) (ServerPriceRangeSingleResponse, *http.Response, error) {
     body := ServerPriceRangeSingleResponse{}
-    res, j, err := c.Request("GET", "/server-price-ranges/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerAction(id string) (ServerActionSingleResponse, *http.Response, error) {
+    return c.GetServerActionWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerActionWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error) {
     body := ServerActionSingleResponse{}
-    res, j, err := c.Request("GET", "/server-actions/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-actions/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerVariantPrice(id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
+    return c.GetServerVariantPriceWithContext(context.Background(), id, variant_id)
+}
+
+func (c ComputeClient) GetServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
     body := ServerVariantPriceSingleResponse{}
-    res, j, err := c.Request("GET", "/server-price-ranges/"+c.toStr(id)+"/variant-prices/"+c.toStr(variant_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges/"+core.ToStr(id)+"/variant-prices/"+core.ToStr(variant_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerVariantPrice(id string, variant_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerVariantPriceWithContext(context.Background(), id, variant_id)
+}
+
+func (c ComputeClient) DeleteServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-price-ranges/"+c.toStr(id)+"/variant-prices/"+c.toStr(variant_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-price-ranges/"+core.ToStr(id)+"/variant-prices/"+core.ToStr(variant_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerVariantPrice(in ServerVariantPriceUpdateRequest, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerVariantPriceWithContext(context.Background(), in, id, variant_id)
+}
+
+func (c ComputeClient) UpdateServerVariantPriceWithContext(ctx context.Context, in ServerVariantPriceUpdateRequest, id string, variant_id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVariantPriceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-price-ranges/"+c.toStr(id)+"/variant-prices/"+c.toStr(variant_id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-price-ranges/"+core.ToStr(id)+"/variant-prices/"+core.ToStr(variant_id), nil, in, &body)
     return body, res, err
 }
 
@@ -2508,43 +2131,24 @@ type GetServerVolumePricingQueryParams struct {
 }
 
 func (c ComputeClient) GetServerVolumePricing(qParams GetServerVolumePricingQueryParams) (ServerVolumePriceListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerVolumePricingWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerVolumePricingWithContext(ctx context.Context, qParams GetServerVolumePricingQueryParams) (ServerVolumePriceListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVolumePriceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/pricing/server-volumes"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/pricing/server-volumes", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerTemplate(in ServerTemplateCreateRequest) (ServerTemplateSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerTemplateWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerTemplateWithContext(ctx context.Context, in ServerTemplateCreateRequest) (ServerTemplateSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerTemplateSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-templates", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-templates", nil, in, &body)
     return body, res, err
 }
 
@@ -2564,79 +2168,45 @@ type GetServerTemplatesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerTemplates(qParams GetServerTemplatesQueryParams) (ServerTemplateListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerTemplatesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerTemplatesWithContext(ctx context.Context, qParams GetServerTemplatesQueryParams) (ServerTemplateListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerTemplateListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-templates"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-templates", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerHost(id string) (ServerHostSingleResponse, *http.Response, error) {
+    return c.GetServerHostWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerHostWithContext(ctx context.Context, id string) (ServerHostSingleResponse, *http.Response, error) {
     body := ServerHostSingleResponse{}
-    res, j, err := c.Request("GET", "/server-hosts/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-hosts/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerHost(in ServerHostUpdateRequest, id string) (ServerHostSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerHostWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateServerHostWithContext(ctx context.Context, in ServerHostUpdateRequest, id string) (ServerHostSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerHostSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-hosts/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-hosts/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerFirewallRule(in ServerFirewallRuleCreateRequest, id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerFirewallRuleWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateServerFirewallRuleWithContext(ctx context.Context, in ServerFirewallRuleCreateRequest, id string) (ServerFirewallRuleSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerFirewallRuleSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-firewalls/"+c.toStr(id)+"/rules", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-firewalls/"+core.ToStr(id)+"/rules", nil, in, &body)
     return body, res, err
 }
 
@@ -2658,43 +2228,24 @@ type GetServerFirewallRulesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerFirewallRules(id string, qParams GetServerFirewallRulesQueryParams) (ServerFirewallRuleListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerFirewallRulesWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerFirewallRulesWithContext(ctx context.Context, id string, qParams GetServerFirewallRulesQueryParams) (ServerFirewallRuleListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerFirewallRuleListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-firewalls/"+c.toStr(id)+"/rules"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-firewalls/"+core.ToStr(id)+"/rules", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerPriceRangeVolumePrice(in ServerVolumePriceCreateRequest, id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerPriceRangeVolumePriceWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateServerPriceRangeVolumePriceWithContext(ctx context.Context, in ServerVolumePriceCreateRequest, id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumePriceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-price-ranges/"+c.toStr(id)+"/volume-prices", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-price-ranges/"+core.ToStr(id)+"/volume-prices", nil, in, &body)
     return body, res, err
 }
 
@@ -2707,43 +2258,24 @@ type GetServerPriceRangeVolumePricesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerPriceRangeVolumePrices(id string, qParams GetServerPriceRangeVolumePricesQueryParams) (ServerVolumePriceListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerPriceRangeVolumePricesWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerPriceRangeVolumePricesWithContext(ctx context.Context, id string, qParams GetServerPriceRangeVolumePricesQueryParams) (ServerVolumePriceListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVolumePriceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-price-ranges/"+c.toStr(id)+"/volume-prices"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges/"+core.ToStr(id)+"/volume-prices", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateScheduledServerAction(in ScheduledServerActionCreateRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateScheduledServerActionWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateScheduledServerActionWithContext(ctx context.Context, in ScheduledServerActionCreateRequest, id string) (ScheduledServerActionSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ScheduledServerActionSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/scheduled-actions", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/scheduled-actions", nil, in, &body)
     return body, res, err
 }
 
@@ -2756,24 +2288,13 @@ type GetScheduledServerActionsQueryParams struct {
 }
 
 func (c ComputeClient) GetScheduledServerActions(id string, qParams GetScheduledServerActionsQueryParams) (ScheduledServerActionListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetScheduledServerActionsWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetScheduledServerActionsWithContext(ctx context.Context, id string, qParams GetScheduledServerActionsQueryParams) (ScheduledServerActionListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ScheduledServerActionListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/scheduled-actions"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/scheduled-actions", qParams, nil, &body)
     return body, res, err
 }
 
@@ -2787,113 +2308,65 @@ type GetServerPricingQueryParams struct {
 }
 
 func (c ComputeClient) GetServerPricing(qParams GetServerPricingQueryParams) (ServerVariantPriceListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerPricingWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerPricingWithContext(ctx context.Context, qParams GetServerPricingQueryParams) (ServerVariantPriceListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVariantPriceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/pricing/servers"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/pricing/servers", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) StopServer(id string) (EmptyResponse, *http.Response, error) {
+    return c.StopServerWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) StopServerWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/stop", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/stop", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerVolume(id string) (ServerVolumeSingleResponse, *http.Response, error) {
+    return c.GetServerVolumeWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerVolumeWithContext(ctx context.Context, id string) (ServerVolumeSingleResponse, *http.Response, error) {
     body := ServerVolumeSingleResponse{}
-    res, j, err := c.Request("GET", "/server-volumes/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-volumes/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerVolume(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerVolumeWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerVolumeWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-volumes/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-volumes/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerVolume(in ServerVolumeUpdateRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateServerVolumeWithContext(ctx context.Context, in ServerVolumeUpdateRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumeSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-volumes/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-volumes/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerNetwork(in ServerNetworkCreateRequest, id string) (ServerNetworkSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerNetworkWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateServerNetworkWithContext(ctx context.Context, in ServerNetworkCreateRequest, id string) (ServerNetworkSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerNetworkSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/networks", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/networks", nil, in, &body)
     return body, res, err
 }
 
@@ -2917,43 +2390,24 @@ type GetServerNetworksQueryParams struct {
 }
 
 func (c ComputeClient) GetServerNetworks(id string, qParams GetServerNetworksQueryParams) (ServerNetworkListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerNetworksWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerNetworksWithContext(ctx context.Context, id string, qParams GetServerNetworksQueryParams) (ServerNetworkListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerNetworkListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/networks"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/networks", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerVariant(in ServerVariantCreateRequest) (ServerVariantSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerVariantWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerVariantWithContext(ctx context.Context, in ServerVariantCreateRequest) (ServerVariantSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVariantSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-variants", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-variants", nil, in, &body)
     return body, res, err
 }
 
@@ -2978,113 +2432,65 @@ type GetServerVariantsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerVariants(qParams GetServerVariantsQueryParams) (ServerVariantListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerVariantsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerVariantsWithContext(ctx context.Context, qParams GetServerVariantsQueryParams) (ServerVariantListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVariantListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-variants"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-variants", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerStorage(id string) (ServerStorageSingleResponse, *http.Response, error) {
+    return c.GetServerStorageWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerStorageWithContext(ctx context.Context, id string) (ServerStorageSingleResponse, *http.Response, error) {
     body := ServerStorageSingleResponse{}
-    res, j, err := c.Request("GET", "/server-storages/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-storages/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetSSHKey(id string) (SSHKeySingleResponse, *http.Response, error) {
+    return c.GetSSHKeyWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetSSHKeyWithContext(ctx context.Context, id string) (SSHKeySingleResponse, *http.Response, error) {
     body := SSHKeySingleResponse{}
-    res, j, err := c.Request("GET", "/ssh-keys/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/ssh-keys/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteSSHKey(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteSSHKeyWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteSSHKeyWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/ssh-keys/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/ssh-keys/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateSSHKey(in SSHKeyUpdateRequest, id string) (SSHKeySingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateSSHKeyWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateSSHKeyWithContext(ctx context.Context, in SSHKeyUpdateRequest, id string) (SSHKeySingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := SSHKeySingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/ssh-keys/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/ssh-keys/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerPriceRangeAssignment(in ServerPriceRangeAssignmentCreateRequest) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerPriceRangeAssignmentWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerPriceRangeAssignmentWithContext(ctx context.Context, in ServerPriceRangeAssignmentCreateRequest) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerPriceRangeAssignmentSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-price-range-assignments", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-price-range-assignments", nil, in, &body)
     return body, res, err
 }
 
@@ -3105,24 +2511,13 @@ type GetServerPriceRangeAssignmentsQueryParams struct {
 }
 
 func (c ComputeClient) GetServerPriceRangeAssignments(qParams GetServerPriceRangeAssignmentsQueryParams) (ServerPriceRangeAssignmentListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerPriceRangeAssignmentsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerPriceRangeAssignmentsWithContext(ctx context.Context, qParams GetServerPriceRangeAssignmentsQueryParams) (ServerPriceRangeAssignmentListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerPriceRangeAssignmentListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-price-range-assignments"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-range-assignments", qParams, nil, &body)
     return body, res, err
 }
 
@@ -3141,94 +2536,54 @@ type GetAddressesQueryParams struct {
 }
 
 func (c ComputeClient) GetAddresses(qParams GetAddressesQueryParams) (AddressListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetAddressesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetAddressesWithContext(ctx context.Context, qParams GetAddressesQueryParams) (AddressListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := AddressListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/addresses"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/addresses", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerVariant(id string) (ServerVariantSingleResponse, *http.Response, error) {
+    return c.GetServerVariantWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerVariantWithContext(ctx context.Context, id string) (ServerVariantSingleResponse, *http.Response, error) {
     body := ServerVariantSingleResponse{}
-    res, j, err := c.Request("GET", "/server-variants/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-variants/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerVariant(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerVariantWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerVariantWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-variants/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-variants/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteS3AccessKeyGrant(access_key_id string, id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteS3AccessKeyGrantWithContext(context.Background(), access_key_id, id)
+}
+
+func (c ComputeClient) DeleteS3AccessKeyGrantWithContext(ctx context.Context, access_key_id string, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/storage/s3/access-keys/"+c.toStr(access_key_id)+"/grants/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/storage/s3/access-keys/"+core.ToStr(access_key_id)+"/grants/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerMedia(in ServerMediaCreateRequest) (ServerMediaSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerMediaWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerMediaWithContext(ctx context.Context, in ServerMediaCreateRequest) (ServerMediaSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerMediaSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-medias", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-medias", nil, in, &body)
     return body, res, err
 }
 
@@ -3251,183 +2606,106 @@ type GetServerMediasQueryParams struct {
 }
 
 func (c ComputeClient) GetServerMedias(qParams GetServerMediasQueryParams) (ServerMediaListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerMediasWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerMediasWithContext(ctx context.Context, qParams GetServerMediasQueryParams) (ServerMediaListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerMediaListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-medias"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-medias", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetSubnet(id string) (SubnetSingleResponse, *http.Response, error) {
+    return c.GetSubnetWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetSubnetWithContext(ctx context.Context, id string) (SubnetSingleResponse, *http.Response, error) {
     body := SubnetSingleResponse{}
-    res, j, err := c.Request("GET", "/subnets/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/subnets/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteSubnet(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteSubnetWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteSubnetWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/subnets/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/subnets/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) AttachServerVolume(in ServerVolumeAttachRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.AttachServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) AttachServerVolumeWithContext(ctx context.Context, in ServerVolumeAttachRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumeSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-volumes/"+c.toStr(id)+"/attach", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-volumes/"+core.ToStr(id)+"/attach", nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerPriceRangeVolumePrice(id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
+    return c.GetServerPriceRangeVolumePriceWithContext(context.Background(), id, class_id)
+}
+
+func (c ComputeClient) GetServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
     body := ServerVolumePriceSingleResponse{}
-    res, j, err := c.Request("GET", "/server-price-ranges/"+c.toStr(id)+"/volume-prices/"+c.toStr(class_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges/"+core.ToStr(id)+"/volume-prices/"+core.ToStr(class_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerPriceRangeVolumePrice(id string, class_id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerPriceRangeVolumePriceWithContext(context.Background(), id, class_id)
+}
+
+func (c ComputeClient) DeleteServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-price-ranges/"+c.toStr(id)+"/volume-prices/"+c.toStr(class_id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-price-ranges/"+core.ToStr(id)+"/volume-prices/"+core.ToStr(class_id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerPriceRangeVolumePrice(in ServerVolumePriceUpdateRequest, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerPriceRangeVolumePriceWithContext(context.Background(), in, id, class_id)
+}
+
+func (c ComputeClient) UpdateServerPriceRangeVolumePriceWithContext(ctx context.Context, in ServerVolumePriceUpdateRequest, id string, class_id string) (ServerVolumePriceSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumePriceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-price-ranges/"+c.toStr(id)+"/volume-prices/"+c.toStr(class_id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-price-ranges/"+core.ToStr(id)+"/volume-prices/"+core.ToStr(class_id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetS3AccessKey(id string) (S3AccessKeySingleResponse, *http.Response, error) {
+    return c.GetS3AccessKeyWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetS3AccessKeyWithContext(ctx context.Context, id string) (S3AccessKeySingleResponse, *http.Response, error) {
     body := S3AccessKeySingleResponse{}
-    res, j, err := c.Request("GET", "/storage/s3/access-keys/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/storage/s3/access-keys/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteS3AccessKey(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteS3AccessKeyWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteS3AccessKeyWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/storage/s3/access-keys/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/storage/s3/access-keys/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateS3AccessKey(in S3AccessKeyCreateRequest) (S3AccessKeySingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateS3AccessKeyWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateS3AccessKeyWithContext(ctx context.Context, in S3AccessKeyCreateRequest) (S3AccessKeySingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := S3AccessKeySingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/storage/s3/access-keys", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/storage/s3/access-keys", nil, in, &body)
     return body, res, err
 }
 
@@ -3447,113 +2725,65 @@ type GetS3AccessKeysQueryParams struct {
 }
 
 func (c ComputeClient) GetS3AccessKeys(qParams GetS3AccessKeysQueryParams) (S3AccessKeyListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetS3AccessKeysWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetS3AccessKeysWithContext(ctx context.Context, qParams GetS3AccessKeysQueryParams) (S3AccessKeyListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := S3AccessKeyListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/storage/s3/access-keys"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/storage/s3/access-keys", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetAddress(id string) (AddressSingleResponse, *http.Response, error) {
+    return c.GetAddressWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetAddressWithContext(ctx context.Context, id string) (AddressSingleResponse, *http.Response, error) {
     body := AddressSingleResponse{}
-    res, j, err := c.Request("GET", "/addresses/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/addresses/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerBackup(id string) (ServerBackupSingleResponse, *http.Response, error) {
+    return c.GetServerBackupWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerBackupWithContext(ctx context.Context, id string) (ServerBackupSingleResponse, *http.Response, error) {
     body := ServerBackupSingleResponse{}
-    res, j, err := c.Request("GET", "/server-backups/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-backups/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerBackup(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerBackupWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerBackupWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-backups/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-backups/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerBackup(in ServerBackupUpdateRequest, id string) (ServerBackupSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerBackupWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateServerBackupWithContext(ctx context.Context, in ServerBackupUpdateRequest, id string) (ServerBackupSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerBackupSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-backups/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-backups/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateNetwork(in NetworkCreateRequest) (NetworkSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateNetworkWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateNetworkWithContext(ctx context.Context, in NetworkCreateRequest) (NetworkSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := NetworkSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/networks", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/networks", nil, in, &body)
     return body, res, err
 }
 
@@ -3577,43 +2807,24 @@ type GetNetworksQueryParams struct {
 }
 
 func (c ComputeClient) GetNetworks(qParams GetNetworksQueryParams) (NetworkListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetNetworksWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetNetworksWithContext(ctx context.Context, qParams GetNetworksQueryParams) (NetworkListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := NetworkListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/networks"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/networks", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerStorage(in ServerStorageCreateRequest) (ServerStorageSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerStorageWithContext(context.Background(), in)
+}
+
+func (c ComputeClient) CreateServerStorageWithContext(ctx context.Context, in ServerStorageCreateRequest) (ServerStorageSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerStorageSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-storages", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-storages", nil, in, &body)
     return body, res, err
 }
 
@@ -3630,96 +2841,55 @@ type GetServerStoragesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerStorages(qParams GetServerStoragesQueryParams) (ServerStorageListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerStoragesWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetServerStoragesWithContext(ctx context.Context, qParams GetServerStoragesQueryParams) (ServerStorageListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerStorageListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-storages"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-storages", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) ResizeServer(in ServerResizeRequest, id string) (EmptyResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ResizeServerWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) ResizeServerWithContext(ctx context.Context, in ServerResizeRequest, id string) (EmptyResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := EmptyResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/servers/"+c.toStr(id)+"/resize", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/servers/"+core.ToStr(id)+"/resize", nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerMedia(id string) (ServerMediaSingleResponse, *http.Response, error) {
+    return c.GetServerMediaWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerMediaWithContext(ctx context.Context, id string) (ServerMediaSingleResponse, *http.Response, error) {
     body := ServerMediaSingleResponse{}
-    res, j, err := c.Request("GET", "/server-medias/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-medias/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerMedia(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerMediaWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerMediaWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-medias/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-medias/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateS3AccessKeyGrant(in S3AccessGrantCreateRequest, access_key_id string) (S3AccessGrantSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateS3AccessKeyGrantWithContext(context.Background(), in, access_key_id)
+}
+
+func (c ComputeClient) CreateS3AccessKeyGrantWithContext(ctx context.Context, in S3AccessGrantCreateRequest, access_key_id string) (S3AccessGrantSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := S3AccessGrantSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/storage/s3/access-keys/"+c.toStr(access_key_id)+"/grants", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/storage/s3/access-keys/"+core.ToStr(access_key_id)+"/grants", nil, in, &body)
     return body, res, err
 }
 
@@ -3738,147 +2908,85 @@ type GetS3AccessKeyGrantsQueryParams struct {
 }
 
 func (c ComputeClient) GetS3AccessKeyGrants(access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) (S3AccessGrantListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetS3AccessKeyGrantsWithContext(context.Background(), access_key_id, qParams)
+}
+
+func (c ComputeClient) GetS3AccessKeyGrantsWithContext(ctx context.Context, access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) (S3AccessGrantListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := S3AccessGrantListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/storage/s3/access-keys/"+c.toStr(access_key_id)+"/grants"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/storage/s3/access-keys/"+core.ToStr(access_key_id)+"/grants", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerPriceRangeAssignment(id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    return c.GetServerPriceRangeAssignmentWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
     body := ServerPriceRangeAssignmentSingleResponse{}
-    res, j, err := c.Request("GET", "/server-price-range-assignments/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-range-assignments/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteServerPriceRangeAssignment(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteServerPriceRangeAssignmentWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/server-price-range-assignments/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/server-price-range-assignments/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateServerPriceRangeAssignment(in ServerPriceRangeAssignmentUpdateRequest, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateServerPriceRangeAssignmentWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateServerPriceRangeAssignmentWithContext(ctx context.Context, in ServerPriceRangeAssignmentUpdateRequest, id string) (ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerPriceRangeAssignmentSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/server-price-range-assignments/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/server-price-range-assignments/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetServerVNC(id string) (ServerVNCResponse, *http.Response, error) {
+    return c.GetServerVNCWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetServerVNCWithContext(ctx context.Context, id string) (ServerVNCResponse, *http.Response, error) {
     body := ServerVNCResponse{}
-    res, j, err := c.Request("GET", "/servers/"+c.toStr(id)+"/vnc", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/servers/"+core.ToStr(id)+"/vnc", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CancelServerAction(id string) (ServerActionSingleResponse, *http.Response, error) {
+    return c.CancelServerActionWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) CancelServerActionWithContext(ctx context.Context, id string) (ServerActionSingleResponse, *http.Response, error) {
     body := ServerActionSingleResponse{}
-    res, j, err := c.Request("POST", "/server-actions/"+c.toStr(id)+"/cancel", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-actions/"+core.ToStr(id)+"/cancel", nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetNetwork(id string) (NetworkSingleResponse, *http.Response, error) {
+    return c.GetNetworkWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetNetworkWithContext(ctx context.Context, id string) (NetworkSingleResponse, *http.Response, error) {
     body := NetworkSingleResponse{}
-    res, j, err := c.Request("GET", "/networks/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/networks/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) UpdateNetwork(in NetworkUpdateRequest, id string) (NetworkSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateNetworkWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) UpdateNetworkWithContext(ctx context.Context, in NetworkUpdateRequest, id string) (NetworkSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := NetworkSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/networks/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/networks/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
@@ -3897,77 +3005,44 @@ type GetLabelsQueryParams struct {
 }
 
 func (c ComputeClient) GetLabels(qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetLabelsWithContext(context.Background(), qParams)
+}
+
+func (c ComputeClient) GetLabelsWithContext(ctx context.Context, qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := LabelListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/labels"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/labels", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) ResizeServerVolume(in ServerVolumeResizeRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ResizeServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) ResizeServerVolumeWithContext(ctx context.Context, in ServerVolumeResizeRequest, id string) (ServerVolumeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVolumeSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-volumes/"+c.toStr(id)+"/resize", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-volumes/"+core.ToStr(id)+"/resize", nil, in, &body)
     return body, res, err
 }
 
 func (c ComputeClient) GetS3Bucket(id string) (S3BucketSingleResponse, *http.Response, error) {
+    return c.GetS3BucketWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) GetS3BucketWithContext(ctx context.Context, id string) (S3BucketSingleResponse, *http.Response, error) {
     body := S3BucketSingleResponse{}
-    res, j, err := c.Request("GET", "/storage/s3/buckets/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/storage/s3/buckets/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) DeleteS3Bucket(id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteS3BucketWithContext(context.Background(), id)
+}
+
+func (c ComputeClient) DeleteS3BucketWithContext(ctx context.Context, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/storage/s3/buckets/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/storage/s3/buckets/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
@@ -3976,43 +3051,24 @@ type DetachServerVolumeQueryParams struct {
 }
 
 func (c ComputeClient) DetachServerVolume(id string, qParams DetachServerVolumeQueryParams) (ServerVolumeSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.DetachServerVolumeWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) DetachServerVolumeWithContext(ctx context.Context, id string, qParams DetachServerVolumeQueryParams) (ServerVolumeSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVolumeSingleResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("POST", "/server-volumes/"+c.toStr(id)+"/detach"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-volumes/"+core.ToStr(id)+"/detach", qParams, nil, &body)
     return body, res, err
 }
 
 func (c ComputeClient) CreateServerVariantPrice(in ServerVariantPriceCreateRequest, id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateServerVariantPriceWithContext(context.Background(), in, id)
+}
+
+func (c ComputeClient) CreateServerVariantPriceWithContext(ctx context.Context, in ServerVariantPriceCreateRequest, id string) (ServerVariantPriceSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := ServerVariantPriceSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/server-price-ranges/"+c.toStr(id)+"/variant-prices", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/server-price-ranges/"+core.ToStr(id)+"/variant-prices", nil, in, &body)
     return body, res, err
 }
 
@@ -4025,24 +3081,13 @@ type GetServerVariantPricesQueryParams struct {
 }
 
 func (c ComputeClient) GetServerVariantPrices(id string, qParams GetServerVariantPricesQueryParams) (ServerVariantPriceListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetServerVariantPricesWithContext(context.Background(), id, qParams)
+}
+
+func (c ComputeClient) GetServerVariantPricesWithContext(ctx context.Context, id string, qParams GetServerVariantPricesQueryParams) (ServerVariantPriceListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := ServerVariantPriceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/server-price-ranges/"+c.toStr(id)+"/variant-prices"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/server-price-ranges/"+core.ToStr(id)+"/variant-prices", qParams, nil, &body)
     return body, res, err
 }
 
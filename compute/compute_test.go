@@ -0,0 +1,46 @@
+package compute_test
+
+import (
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestServers(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    c := compute.NewClientWithUrl("token", s.ServiceUrl("compute"))
+
+    created, _, err := c.CreateServer(compute.ServerCreateRequest{Name: "web", ProjectId: "p1", ZoneId: "z1", VariantId: "v1"})
+    if err != nil {
+        t.Fatal(err)
+    }
+    id := created.Data.Id
+    if created.Data.State != compute.ServerStateRunning {
+        t.Errorf("got state %s after create", created.Data.State)
+    }
+
+    name := "app"
+    if _, _, err := c.UpdateServer(compute.ServerUpdateRequest{Name: &name}, id); err != nil {
+        t.Fatal(err)
+    }
+    if _, _, err := c.StopServer(id); err != nil {
+        t.Fatal(err)
+    }
+    list, _, err := c.GetServers(compute.GetServersQueryParams{Filter: &compute.GetServersQueryParamsFilter{Name: &name}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(list.Data) != 1 || list.Data[0].State != compute.ServerStateStopped {
+        t.Fatalf("got %+v", list.Data)
+    }
+
+    action, _, err := c.RestartServer(id)
+    if err != nil {
+        t.Fatal(err)
+    }
+    got, _, err := c.GetServerAction(action.Data.Id)
+    if err != nil || got.Data.State != compute.ServerActionStateFinished {
+        t.Errorf("got %+v, %v", got.Data, err)
+    }
+}
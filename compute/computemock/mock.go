@@ -0,0 +1,1936 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package computemock
+
+import (
+    "context"
+    "net/http"
+    "sync"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+)
+
+// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements compute.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+    CreateSSHKeyFunc func(ctx context.Context, in compute.SSHKeyCreateRequest) (compute.SSHKeySingleResponse, *http.Response, error)
+    GetSSHKeysFunc func(ctx context.Context, qParams compute.GetSSHKeysQueryParams) (compute.SSHKeyListResponse, *http.Response, error)
+    CreateServerPriceRangeFunc func(ctx context.Context, in compute.ServerPriceRangeCreateRequest) (compute.ServerPriceRangeSingleResponse, *http.Response, error)
+    GetServerPriceRangesFunc func(ctx context.Context, qParams compute.GetServerPriceRangesQueryParams) (compute.ServerPriceRangeListResponse, *http.Response, error)
+    StartServerFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    CreateAvailabilityZoneFunc func(ctx context.Context, in compute.AvailabilityZoneCreateRequest) (compute.AvailabilityZoneSingleResponse, *http.Response, error)
+    GetAvailabilityZonesFunc func(ctx context.Context, qParams compute.GetAvailabilityZonesQueryParams) (compute.AvailabilityZoneListResponse, *http.Response, error)
+    GetServerTemplateFunc func(ctx context.Context, id string) (compute.ServerTemplateSingleResponse, *http.Response, error)
+    ShutdownServerFunc func(ctx context.Context, id string, qParams compute.ShutdownServerQueryParams) (compute.EmptyResponse, *http.Response, error)
+    GetServerFirewallFunc func(ctx context.Context, id string) (compute.ServerFirewallSingleResponse, *http.Response, error)
+    DeleteServerFirewallFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    GetServerFunc func(ctx context.Context, id string) (compute.ServerSingleResponse, *http.Response, error)
+    DeleteServerFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerFunc func(ctx context.Context, in compute.ServerUpdateRequest, id string) (compute.ServerSingleResponse, *http.Response, error)
+    GetServerActionsFunc func(ctx context.Context, qParams compute.GetServerActionsQueryParams) (compute.ServerActionListResponse, *http.Response, error)
+    GetServerStorageClassFunc func(ctx context.Context, id string) (compute.ServerStorageClassSingleResponse, *http.Response, error)
+    RestartServerFunc func(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error)
+    MountServerMediaFunc func(ctx context.Context, in compute.ServerMediaMountRequest, id string) (compute.ServerSingleResponse, *http.Response, error)
+    UnmountServerMediaFunc func(ctx context.Context, id string) (compute.ServerSingleResponse, *http.Response, error)
+    RestoreServerFunc func(ctx context.Context, in compute.ServerRestoreRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error)
+    GetServerGraphFunc func(ctx context.Context, id string, qParams compute.GetServerGraphQueryParams) (compute.ServerGraphResponse, *http.Response, error)
+    RecreateServerFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    CreateServerFirewallFunc func(ctx context.Context, in compute.ServerFirewallCreateRequest) (compute.ServerFirewallSingleResponse, *http.Response, error)
+    GetServerFirewallsFunc func(ctx context.Context, qParams compute.GetServerFirewallsQueryParams) (compute.ServerFirewallListResponse, *http.Response, error)
+    GetServerFirewallRuleFunc func(ctx context.Context, id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error)
+    DeleteServerFirewallRuleFunc func(ctx context.Context, id string, rule_id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerFirewallRuleFunc func(ctx context.Context, in compute.ServerFirewallRuleUpdateRequest, id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error)
+    CreateServerHostFunc func(ctx context.Context, in compute.ServerHostCreateRequest) (compute.ServerHostSingleResponse, *http.Response, error)
+    GetServerHostsFunc func(ctx context.Context, qParams compute.GetServerHostsQueryParams) (compute.ServerHostListResponse, *http.Response, error)
+    CreateServerFunc func(ctx context.Context, in compute.ServerCreateRequest) (compute.ServerSingleResponse, *http.Response, error)
+    GetServersFunc func(ctx context.Context, qParams compute.GetServersQueryParams) (compute.ServerListResponse, *http.Response, error)
+    DeleteServerNetworkFunc func(ctx context.Context, id string, network_id string) (compute.EmptyResponse, *http.Response, error)
+    GetAvailabilityZoneFunc func(ctx context.Context, id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error)
+    UpdateAvailabilityZoneFunc func(ctx context.Context, in compute.AvailabilityZoneUpdateRequest, id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error)
+    CreateServerBackupFunc func(ctx context.Context, in compute.ServerBackupCreateRequest) (compute.ServerBackupSingleResponse, *http.Response, error)
+    GetServerBackupsFunc func(ctx context.Context, qParams compute.GetServerBackupsQueryParams) (compute.ServerBackupListResponse, *http.Response, error)
+    CreateSubnetFunc func(ctx context.Context, in compute.SubnetCreateRequest) (compute.SubnetSingleResponse, *http.Response, error)
+    GetSubnetsFunc func(ctx context.Context, qParams compute.GetSubnetsQueryParams) (compute.SubnetListResponse, *http.Response, error)
+    CreateServerVolumeFunc func(ctx context.Context, in compute.ServerVolumeCreateRequest) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    GetServerVolumesFunc func(ctx context.Context, qParams compute.GetServerVolumesQueryParams) (compute.ServerVolumeListResponse, *http.Response, error)
+    CreateServerStorageClassFunc func(ctx context.Context, in compute.ServerStorageClassCreateRequest) (compute.ServerStorageClassSingleResponse, *http.Response, error)
+    GetServerStorageClassesFunc func(ctx context.Context, qParams compute.GetServerStorageClassesQueryParams) (compute.ServerStorageClassListResponse, *http.Response, error)
+    GetServerFirewallMemberFunc func(ctx context.Context, id string, member_id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error)
+    DeleteServerFirewallMemberFunc func(ctx context.Context, id string, member_id string) (compute.EmptyResponse, *http.Response, error)
+    SearchFunc func(ctx context.Context, qParams compute.SearchQueryParams) (compute.SearchResponse, *http.Response, error)
+    GetScheduledServerActionFunc func(ctx context.Context, id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error)
+    DeleteScheduledServerActionFunc func(ctx context.Context, id string, action_id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateScheduledServerActionFunc func(ctx context.Context, in compute.ScheduledServerActionUpdateRequest, id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error)
+    CreateS3BucketFunc func(ctx context.Context, in compute.S3BucketCreateRequest) (compute.S3BucketSingleResponse, *http.Response, error)
+    GetS3BucketsFunc func(ctx context.Context, qParams compute.GetS3BucketsQueryParams) (compute.S3BucketListResponse, *http.Response, error)
+    GetServerStatusFunc func(ctx context.Context, id string) (compute.ServerStatusResponse, *http.Response, error)
+    CreateServerFirewallMemberFunc func(ctx context.Context, in compute.ServerFirewallMemberCreateRequest, id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error)
+    GetServerFirewallMembersFunc func(ctx context.Context, id string, qParams compute.GetServerFirewallMembersQueryParams) (compute.ServerFirewallMemberListResponse, *http.Response, error)
+    GetServerPriceRangeFunc func(ctx context.Context, id string) (compute.ServerPriceRangeSingleResponse, *http.Response, error)
+    GetServerActionFunc func(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error)
+    GetServerVariantPriceFunc func(ctx context.Context, id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error)
+    DeleteServerVariantPriceFunc func(ctx context.Context, id string, variant_id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerVariantPriceFunc func(ctx context.Context, in compute.ServerVariantPriceUpdateRequest, id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error)
+    GetServerVolumePricingFunc func(ctx context.Context, qParams compute.GetServerVolumePricingQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error)
+    CreateServerTemplateFunc func(ctx context.Context, in compute.ServerTemplateCreateRequest) (compute.ServerTemplateSingleResponse, *http.Response, error)
+    GetServerTemplatesFunc func(ctx context.Context, qParams compute.GetServerTemplatesQueryParams) (compute.ServerTemplateListResponse, *http.Response, error)
+    GetServerHostFunc func(ctx context.Context, id string) (compute.ServerHostSingleResponse, *http.Response, error)
+    UpdateServerHostFunc func(ctx context.Context, in compute.ServerHostUpdateRequest, id string) (compute.ServerHostSingleResponse, *http.Response, error)
+    CreateServerFirewallRuleFunc func(ctx context.Context, in compute.ServerFirewallRuleCreateRequest, id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error)
+    GetServerFirewallRulesFunc func(ctx context.Context, id string, qParams compute.GetServerFirewallRulesQueryParams) (compute.ServerFirewallRuleListResponse, *http.Response, error)
+    CreateServerPriceRangeVolumePriceFunc func(ctx context.Context, in compute.ServerVolumePriceCreateRequest, id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error)
+    GetServerPriceRangeVolumePricesFunc func(ctx context.Context, id string, qParams compute.GetServerPriceRangeVolumePricesQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error)
+    CreateScheduledServerActionFunc func(ctx context.Context, in compute.ScheduledServerActionCreateRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error)
+    GetScheduledServerActionsFunc func(ctx context.Context, id string, qParams compute.GetScheduledServerActionsQueryParams) (compute.ScheduledServerActionListResponse, *http.Response, error)
+    GetServerPricingFunc func(ctx context.Context, qParams compute.GetServerPricingQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error)
+    StopServerFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    GetServerVolumeFunc func(ctx context.Context, id string) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    DeleteServerVolumeFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerVolumeFunc func(ctx context.Context, in compute.ServerVolumeUpdateRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    CreateServerNetworkFunc func(ctx context.Context, in compute.ServerNetworkCreateRequest, id string) (compute.ServerNetworkSingleResponse, *http.Response, error)
+    GetServerNetworksFunc func(ctx context.Context, id string, qParams compute.GetServerNetworksQueryParams) (compute.ServerNetworkListResponse, *http.Response, error)
+    CreateServerVariantFunc func(ctx context.Context, in compute.ServerVariantCreateRequest) (compute.ServerVariantSingleResponse, *http.Response, error)
+    GetServerVariantsFunc func(ctx context.Context, qParams compute.GetServerVariantsQueryParams) (compute.ServerVariantListResponse, *http.Response, error)
+    GetServerStorageFunc func(ctx context.Context, id string) (compute.ServerStorageSingleResponse, *http.Response, error)
+    GetSSHKeyFunc func(ctx context.Context, id string) (compute.SSHKeySingleResponse, *http.Response, error)
+    DeleteSSHKeyFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateSSHKeyFunc func(ctx context.Context, in compute.SSHKeyUpdateRequest, id string) (compute.SSHKeySingleResponse, *http.Response, error)
+    CreateServerPriceRangeAssignmentFunc func(ctx context.Context, in compute.ServerPriceRangeAssignmentCreateRequest) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    GetServerPriceRangeAssignmentsFunc func(ctx context.Context, qParams compute.GetServerPriceRangeAssignmentsQueryParams) (compute.ServerPriceRangeAssignmentListResponse, *http.Response, error)
+    GetAddressesFunc func(ctx context.Context, qParams compute.GetAddressesQueryParams) (compute.AddressListResponse, *http.Response, error)
+    GetServerVariantFunc func(ctx context.Context, id string) (compute.ServerVariantSingleResponse, *http.Response, error)
+    DeleteServerVariantFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    DeleteS3AccessKeyGrantFunc func(ctx context.Context, access_key_id string, id string) (compute.EmptyResponse, *http.Response, error)
+    CreateServerMediaFunc func(ctx context.Context, in compute.ServerMediaCreateRequest) (compute.ServerMediaSingleResponse, *http.Response, error)
+    GetServerMediasFunc func(ctx context.Context, qParams compute.GetServerMediasQueryParams) (compute.ServerMediaListResponse, *http.Response, error)
+    GetSubnetFunc func(ctx context.Context, id string) (compute.SubnetSingleResponse, *http.Response, error)
+    DeleteSubnetFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    AttachServerVolumeFunc func(ctx context.Context, in compute.ServerVolumeAttachRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    GetServerPriceRangeVolumePriceFunc func(ctx context.Context, id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error)
+    DeleteServerPriceRangeVolumePriceFunc func(ctx context.Context, id string, class_id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerPriceRangeVolumePriceFunc func(ctx context.Context, in compute.ServerVolumePriceUpdateRequest, id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error)
+    GetS3AccessKeyFunc func(ctx context.Context, id string) (compute.S3AccessKeySingleResponse, *http.Response, error)
+    DeleteS3AccessKeyFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    CreateS3AccessKeyFunc func(ctx context.Context, in compute.S3AccessKeyCreateRequest) (compute.S3AccessKeySingleResponse, *http.Response, error)
+    GetS3AccessKeysFunc func(ctx context.Context, qParams compute.GetS3AccessKeysQueryParams) (compute.S3AccessKeyListResponse, *http.Response, error)
+    GetAddressFunc func(ctx context.Context, id string) (compute.AddressSingleResponse, *http.Response, error)
+    GetServerBackupFunc func(ctx context.Context, id string) (compute.ServerBackupSingleResponse, *http.Response, error)
+    DeleteServerBackupFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerBackupFunc func(ctx context.Context, in compute.ServerBackupUpdateRequest, id string) (compute.ServerBackupSingleResponse, *http.Response, error)
+    CreateNetworkFunc func(ctx context.Context, in compute.NetworkCreateRequest) (compute.NetworkSingleResponse, *http.Response, error)
+    GetNetworksFunc func(ctx context.Context, qParams compute.GetNetworksQueryParams) (compute.NetworkListResponse, *http.Response, error)
+    CreateServerStorageFunc func(ctx context.Context, in compute.ServerStorageCreateRequest) (compute.ServerStorageSingleResponse, *http.Response, error)
+    GetServerStoragesFunc func(ctx context.Context, qParams compute.GetServerStoragesQueryParams) (compute.ServerStorageListResponse, *http.Response, error)
+    ResizeServerFunc func(ctx context.Context, in compute.ServerResizeRequest, id string) (compute.EmptyResponse, *http.Response, error)
+    GetServerMediaFunc func(ctx context.Context, id string) (compute.ServerMediaSingleResponse, *http.Response, error)
+    DeleteServerMediaFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    CreateS3AccessKeyGrantFunc func(ctx context.Context, in compute.S3AccessGrantCreateRequest, access_key_id string) (compute.S3AccessGrantSingleResponse, *http.Response, error)
+    GetS3AccessKeyGrantsFunc func(ctx context.Context, access_key_id string, qParams compute.GetS3AccessKeyGrantsQueryParams) (compute.S3AccessGrantListResponse, *http.Response, error)
+    GetServerPriceRangeAssignmentFunc func(ctx context.Context, id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    DeleteServerPriceRangeAssignmentFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    UpdateServerPriceRangeAssignmentFunc func(ctx context.Context, in compute.ServerPriceRangeAssignmentUpdateRequest, id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error)
+    GetServerVNCFunc func(ctx context.Context, id string) (compute.ServerVNCResponse, *http.Response, error)
+    CancelServerActionFunc func(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error)
+    GetNetworkFunc func(ctx context.Context, id string) (compute.NetworkSingleResponse, *http.Response, error)
+    UpdateNetworkFunc func(ctx context.Context, in compute.NetworkUpdateRequest, id string) (compute.NetworkSingleResponse, *http.Response, error)
+    GetLabelsFunc func(ctx context.Context, qParams compute.GetLabelsQueryParams) (compute.LabelListResponse, *http.Response, error)
+    ResizeServerVolumeFunc func(ctx context.Context, in compute.ServerVolumeResizeRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    GetS3BucketFunc func(ctx context.Context, id string) (compute.S3BucketSingleResponse, *http.Response, error)
+    DeleteS3BucketFunc func(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error)
+    DetachServerVolumeFunc func(ctx context.Context, id string, qParams compute.DetachServerVolumeQueryParams) (compute.ServerVolumeSingleResponse, *http.Response, error)
+    CreateServerVariantPriceFunc func(ctx context.Context, in compute.ServerVariantPriceCreateRequest, id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error)
+    GetServerVariantPricesFunc func(ctx context.Context, id string, qParams compute.GetServerVariantPricesQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error)
+}
+
+var _ compute.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+
+func (m *Mock) CreateSSHKey(in compute.SSHKeyCreateRequest) (compute.SSHKeySingleResponse, *http.Response, error) {
+    return m.CreateSSHKeyWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateSSHKeyWithContext(ctx context.Context, in compute.SSHKeyCreateRequest) (compute.SSHKeySingleResponse, *http.Response, error) {
+    m.record("CreateSSHKey", in)
+    if m.CreateSSHKeyFunc != nil {
+        return m.CreateSSHKeyFunc(ctx, in)
+    }
+    return compute.SSHKeySingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSHKeys(qParams compute.GetSSHKeysQueryParams) (compute.SSHKeyListResponse, *http.Response, error) {
+    return m.GetSSHKeysWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSSHKeysWithContext(ctx context.Context, qParams compute.GetSSHKeysQueryParams) (compute.SSHKeyListResponse, *http.Response, error) {
+    m.record("GetSSHKeys", qParams)
+    if m.GetSSHKeysFunc != nil {
+        return m.GetSSHKeysFunc(ctx, qParams)
+    }
+    return compute.SSHKeyListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSSHKeys(ctx context.Context, qParams compute.GetSSHKeysQueryParams) *compute.SSHKeyIterator {
+    return compute.NewSSHKeyIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.SSHKey, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSSHKeysWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerPriceRange(in compute.ServerPriceRangeCreateRequest) (compute.ServerPriceRangeSingleResponse, *http.Response, error) {
+    return m.CreateServerPriceRangeWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerPriceRangeWithContext(ctx context.Context, in compute.ServerPriceRangeCreateRequest) (compute.ServerPriceRangeSingleResponse, *http.Response, error) {
+    m.record("CreateServerPriceRange", in)
+    if m.CreateServerPriceRangeFunc != nil {
+        return m.CreateServerPriceRangeFunc(ctx, in)
+    }
+    return compute.ServerPriceRangeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerPriceRanges(qParams compute.GetServerPriceRangesQueryParams) (compute.ServerPriceRangeListResponse, *http.Response, error) {
+    return m.GetServerPriceRangesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerPriceRangesWithContext(ctx context.Context, qParams compute.GetServerPriceRangesQueryParams) (compute.ServerPriceRangeListResponse, *http.Response, error) {
+    m.record("GetServerPriceRanges", qParams)
+    if m.GetServerPriceRangesFunc != nil {
+        return m.GetServerPriceRangesFunc(ctx, qParams)
+    }
+    return compute.ServerPriceRangeListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerPriceRanges(ctx context.Context, qParams compute.GetServerPriceRangesQueryParams) *compute.ServerPriceRangeIterator {
+    return compute.NewServerPriceRangeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerPriceRange, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerPriceRangesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) StartServer(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.StartServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) StartServerWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("StartServer", id)
+    if m.StartServerFunc != nil {
+        return m.StartServerFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateAvailabilityZone(in compute.AvailabilityZoneCreateRequest) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    return m.CreateAvailabilityZoneWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateAvailabilityZoneWithContext(ctx context.Context, in compute.AvailabilityZoneCreateRequest) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    m.record("CreateAvailabilityZone", in)
+    if m.CreateAvailabilityZoneFunc != nil {
+        return m.CreateAvailabilityZoneFunc(ctx, in)
+    }
+    return compute.AvailabilityZoneSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetAvailabilityZones(qParams compute.GetAvailabilityZonesQueryParams) (compute.AvailabilityZoneListResponse, *http.Response, error) {
+    return m.GetAvailabilityZonesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetAvailabilityZonesWithContext(ctx context.Context, qParams compute.GetAvailabilityZonesQueryParams) (compute.AvailabilityZoneListResponse, *http.Response, error) {
+    m.record("GetAvailabilityZones", qParams)
+    if m.GetAvailabilityZonesFunc != nil {
+        return m.GetAvailabilityZonesFunc(ctx, qParams)
+    }
+    return compute.AvailabilityZoneListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllAvailabilityZones(ctx context.Context, qParams compute.GetAvailabilityZonesQueryParams) *compute.AvailabilityZoneIterator {
+    return compute.NewAvailabilityZoneIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.AvailabilityZone, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetAvailabilityZonesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerTemplate(id string) (compute.ServerTemplateSingleResponse, *http.Response, error) {
+    return m.GetServerTemplateWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerTemplateWithContext(ctx context.Context, id string) (compute.ServerTemplateSingleResponse, *http.Response, error) {
+    m.record("GetServerTemplate", id)
+    if m.GetServerTemplateFunc != nil {
+        return m.GetServerTemplateFunc(ctx, id)
+    }
+    return compute.ServerTemplateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) ShutdownServer(id string, qParams compute.ShutdownServerQueryParams) (compute.EmptyResponse, *http.Response, error) {
+    return m.ShutdownServerWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) ShutdownServerWithContext(ctx context.Context, id string, qParams compute.ShutdownServerQueryParams) (compute.EmptyResponse, *http.Response, error) {
+    m.record("ShutdownServer", id, qParams)
+    if m.ShutdownServerFunc != nil {
+        return m.ShutdownServerFunc(ctx, id, qParams)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerFirewall(id string) (compute.ServerFirewallSingleResponse, *http.Response, error) {
+    return m.GetServerFirewallWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerFirewallWithContext(ctx context.Context, id string) (compute.ServerFirewallSingleResponse, *http.Response, error) {
+    m.record("GetServerFirewall", id)
+    if m.GetServerFirewallFunc != nil {
+        return m.GetServerFirewallFunc(ctx, id)
+    }
+    return compute.ServerFirewallSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerFirewall(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerFirewallWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerFirewallWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerFirewall", id)
+    if m.DeleteServerFirewallFunc != nil {
+        return m.DeleteServerFirewallFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetServer(id string) (compute.ServerSingleResponse, *http.Response, error) {
+    return m.GetServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerWithContext(ctx context.Context, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    m.record("GetServer", id)
+    if m.GetServerFunc != nil {
+        return m.GetServerFunc(ctx, id)
+    }
+    return compute.ServerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServer(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServer", id)
+    if m.DeleteServerFunc != nil {
+        return m.DeleteServerFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServer(in compute.ServerUpdateRequest, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    return m.UpdateServerWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServerWithContext(ctx context.Context, in compute.ServerUpdateRequest, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    m.record("UpdateServer", in, id)
+    if m.UpdateServerFunc != nil {
+        return m.UpdateServerFunc(ctx, in, id)
+    }
+    return compute.ServerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerActions(qParams compute.GetServerActionsQueryParams) (compute.ServerActionListResponse, *http.Response, error) {
+    return m.GetServerActionsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerActionsWithContext(ctx context.Context, qParams compute.GetServerActionsQueryParams) (compute.ServerActionListResponse, *http.Response, error) {
+    m.record("GetServerActions", qParams)
+    if m.GetServerActionsFunc != nil {
+        return m.GetServerActionsFunc(ctx, qParams)
+    }
+    return compute.ServerActionListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerActions(ctx context.Context, qParams compute.GetServerActionsQueryParams) *compute.ServerActionIterator {
+    return compute.NewServerActionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerAction, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerActionsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerStorageClass(id string) (compute.ServerStorageClassSingleResponse, *http.Response, error) {
+    return m.GetServerStorageClassWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerStorageClassWithContext(ctx context.Context, id string) (compute.ServerStorageClassSingleResponse, *http.Response, error) {
+    m.record("GetServerStorageClass", id)
+    if m.GetServerStorageClassFunc != nil {
+        return m.GetServerStorageClassFunc(ctx, id)
+    }
+    return compute.ServerStorageClassSingleResponse{}, nil, nil
+}
+
+func (m *Mock) RestartServer(id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    return m.RestartServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) RestartServerWithContext(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    m.record("RestartServer", id)
+    if m.RestartServerFunc != nil {
+        return m.RestartServerFunc(ctx, id)
+    }
+    return compute.ServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) MountServerMedia(in compute.ServerMediaMountRequest, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    return m.MountServerMediaWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) MountServerMediaWithContext(ctx context.Context, in compute.ServerMediaMountRequest, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    m.record("MountServerMedia", in, id)
+    if m.MountServerMediaFunc != nil {
+        return m.MountServerMediaFunc(ctx, in, id)
+    }
+    return compute.ServerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UnmountServerMedia(id string) (compute.ServerSingleResponse, *http.Response, error) {
+    return m.UnmountServerMediaWithContext(context.Background(), id)
+}
+
+func (m *Mock) UnmountServerMediaWithContext(ctx context.Context, id string) (compute.ServerSingleResponse, *http.Response, error) {
+    m.record("UnmountServerMedia", id)
+    if m.UnmountServerMediaFunc != nil {
+        return m.UnmountServerMediaFunc(ctx, id)
+    }
+    return compute.ServerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) RestoreServer(in compute.ServerRestoreRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    return m.RestoreServerWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) RestoreServerWithContext(ctx context.Context, in compute.ServerRestoreRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    m.record("RestoreServer", in, id)
+    if m.RestoreServerFunc != nil {
+        return m.RestoreServerFunc(ctx, in, id)
+    }
+    return compute.ScheduledServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerGraph(id string, qParams compute.GetServerGraphQueryParams) (compute.ServerGraphResponse, *http.Response, error) {
+    return m.GetServerGraphWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerGraphWithContext(ctx context.Context, id string, qParams compute.GetServerGraphQueryParams) (compute.ServerGraphResponse, *http.Response, error) {
+    m.record("GetServerGraph", id, qParams)
+    if m.GetServerGraphFunc != nil {
+        return m.GetServerGraphFunc(ctx, id, qParams)
+    }
+    return compute.ServerGraphResponse{}, nil, nil
+}
+
+func (m *Mock) RecreateServer(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.RecreateServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) RecreateServerWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("RecreateServer", id)
+    if m.RecreateServerFunc != nil {
+        return m.RecreateServerFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerFirewall(in compute.ServerFirewallCreateRequest) (compute.ServerFirewallSingleResponse, *http.Response, error) {
+    return m.CreateServerFirewallWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerFirewallWithContext(ctx context.Context, in compute.ServerFirewallCreateRequest) (compute.ServerFirewallSingleResponse, *http.Response, error) {
+    m.record("CreateServerFirewall", in)
+    if m.CreateServerFirewallFunc != nil {
+        return m.CreateServerFirewallFunc(ctx, in)
+    }
+    return compute.ServerFirewallSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerFirewalls(qParams compute.GetServerFirewallsQueryParams) (compute.ServerFirewallListResponse, *http.Response, error) {
+    return m.GetServerFirewallsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerFirewallsWithContext(ctx context.Context, qParams compute.GetServerFirewallsQueryParams) (compute.ServerFirewallListResponse, *http.Response, error) {
+    m.record("GetServerFirewalls", qParams)
+    if m.GetServerFirewallsFunc != nil {
+        return m.GetServerFirewallsFunc(ctx, qParams)
+    }
+    return compute.ServerFirewallListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerFirewalls(ctx context.Context, qParams compute.GetServerFirewallsQueryParams) *compute.ServerFirewallIterator {
+    return compute.NewServerFirewallIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerFirewall, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerFirewallsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerFirewallRule(id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    return m.GetServerFirewallRuleWithContext(context.Background(), id, rule_id)
+}
+
+func (m *Mock) GetServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    m.record("GetServerFirewallRule", id, rule_id)
+    if m.GetServerFirewallRuleFunc != nil {
+        return m.GetServerFirewallRuleFunc(ctx, id, rule_id)
+    }
+    return compute.ServerFirewallRuleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerFirewallRule(id string, rule_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerFirewallRuleWithContext(context.Background(), id, rule_id)
+}
+
+func (m *Mock) DeleteServerFirewallRuleWithContext(ctx context.Context, id string, rule_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerFirewallRule", id, rule_id)
+    if m.DeleteServerFirewallRuleFunc != nil {
+        return m.DeleteServerFirewallRuleFunc(ctx, id, rule_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerFirewallRule(in compute.ServerFirewallRuleUpdateRequest, id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    return m.UpdateServerFirewallRuleWithContext(context.Background(), in, id, rule_id)
+}
+
+func (m *Mock) UpdateServerFirewallRuleWithContext(ctx context.Context, in compute.ServerFirewallRuleUpdateRequest, id string, rule_id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    m.record("UpdateServerFirewallRule", in, id, rule_id)
+    if m.UpdateServerFirewallRuleFunc != nil {
+        return m.UpdateServerFirewallRuleFunc(ctx, in, id, rule_id)
+    }
+    return compute.ServerFirewallRuleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerHost(in compute.ServerHostCreateRequest) (compute.ServerHostSingleResponse, *http.Response, error) {
+    return m.CreateServerHostWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerHostWithContext(ctx context.Context, in compute.ServerHostCreateRequest) (compute.ServerHostSingleResponse, *http.Response, error) {
+    m.record("CreateServerHost", in)
+    if m.CreateServerHostFunc != nil {
+        return m.CreateServerHostFunc(ctx, in)
+    }
+    return compute.ServerHostSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerHosts(qParams compute.GetServerHostsQueryParams) (compute.ServerHostListResponse, *http.Response, error) {
+    return m.GetServerHostsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerHostsWithContext(ctx context.Context, qParams compute.GetServerHostsQueryParams) (compute.ServerHostListResponse, *http.Response, error) {
+    m.record("GetServerHosts", qParams)
+    if m.GetServerHostsFunc != nil {
+        return m.GetServerHostsFunc(ctx, qParams)
+    }
+    return compute.ServerHostListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerHosts(ctx context.Context, qParams compute.GetServerHostsQueryParams) *compute.ServerHostIterator {
+    return compute.NewServerHostIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerHost, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerHostsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServer(in compute.ServerCreateRequest) (compute.ServerSingleResponse, *http.Response, error) {
+    return m.CreateServerWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerWithContext(ctx context.Context, in compute.ServerCreateRequest) (compute.ServerSingleResponse, *http.Response, error) {
+    m.record("CreateServer", in)
+    if m.CreateServerFunc != nil {
+        return m.CreateServerFunc(ctx, in)
+    }
+    return compute.ServerSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServers(qParams compute.GetServersQueryParams) (compute.ServerListResponse, *http.Response, error) {
+    return m.GetServersWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServersWithContext(ctx context.Context, qParams compute.GetServersQueryParams) (compute.ServerListResponse, *http.Response, error) {
+    m.record("GetServers", qParams)
+    if m.GetServersFunc != nil {
+        return m.GetServersFunc(ctx, qParams)
+    }
+    return compute.ServerListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServers(ctx context.Context, qParams compute.GetServersQueryParams) *compute.ServerIterator {
+    return compute.NewServerIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.Server, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) DeleteServerNetwork(id string, network_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerNetworkWithContext(context.Background(), id, network_id)
+}
+
+func (m *Mock) DeleteServerNetworkWithContext(ctx context.Context, id string, network_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerNetwork", id, network_id)
+    if m.DeleteServerNetworkFunc != nil {
+        return m.DeleteServerNetworkFunc(ctx, id, network_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetAvailabilityZone(id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    return m.GetAvailabilityZoneWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetAvailabilityZoneWithContext(ctx context.Context, id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    m.record("GetAvailabilityZone", id)
+    if m.GetAvailabilityZoneFunc != nil {
+        return m.GetAvailabilityZoneFunc(ctx, id)
+    }
+    return compute.AvailabilityZoneSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateAvailabilityZone(in compute.AvailabilityZoneUpdateRequest, id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    return m.UpdateAvailabilityZoneWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateAvailabilityZoneWithContext(ctx context.Context, in compute.AvailabilityZoneUpdateRequest, id string) (compute.AvailabilityZoneSingleResponse, *http.Response, error) {
+    m.record("UpdateAvailabilityZone", in, id)
+    if m.UpdateAvailabilityZoneFunc != nil {
+        return m.UpdateAvailabilityZoneFunc(ctx, in, id)
+    }
+    return compute.AvailabilityZoneSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerBackup(in compute.ServerBackupCreateRequest) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    return m.CreateServerBackupWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerBackupWithContext(ctx context.Context, in compute.ServerBackupCreateRequest) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    m.record("CreateServerBackup", in)
+    if m.CreateServerBackupFunc != nil {
+        return m.CreateServerBackupFunc(ctx, in)
+    }
+    return compute.ServerBackupSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerBackups(qParams compute.GetServerBackupsQueryParams) (compute.ServerBackupListResponse, *http.Response, error) {
+    return m.GetServerBackupsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerBackupsWithContext(ctx context.Context, qParams compute.GetServerBackupsQueryParams) (compute.ServerBackupListResponse, *http.Response, error) {
+    m.record("GetServerBackups", qParams)
+    if m.GetServerBackupsFunc != nil {
+        return m.GetServerBackupsFunc(ctx, qParams)
+    }
+    return compute.ServerBackupListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerBackups(ctx context.Context, qParams compute.GetServerBackupsQueryParams) *compute.ServerBackupIterator {
+    return compute.NewServerBackupIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerBackup, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerBackupsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateSubnet(in compute.SubnetCreateRequest) (compute.SubnetSingleResponse, *http.Response, error) {
+    return m.CreateSubnetWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateSubnetWithContext(ctx context.Context, in compute.SubnetCreateRequest) (compute.SubnetSingleResponse, *http.Response, error) {
+    m.record("CreateSubnet", in)
+    if m.CreateSubnetFunc != nil {
+        return m.CreateSubnetFunc(ctx, in)
+    }
+    return compute.SubnetSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSubnets(qParams compute.GetSubnetsQueryParams) (compute.SubnetListResponse, *http.Response, error) {
+    return m.GetSubnetsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetSubnetsWithContext(ctx context.Context, qParams compute.GetSubnetsQueryParams) (compute.SubnetListResponse, *http.Response, error) {
+    m.record("GetSubnets", qParams)
+    if m.GetSubnetsFunc != nil {
+        return m.GetSubnetsFunc(ctx, qParams)
+    }
+    return compute.SubnetListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllSubnets(ctx context.Context, qParams compute.GetSubnetsQueryParams) *compute.SubnetIterator {
+    return compute.NewSubnetIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.Subnet, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetSubnetsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerVolume(in compute.ServerVolumeCreateRequest) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.CreateServerVolumeWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerVolumeWithContext(ctx context.Context, in compute.ServerVolumeCreateRequest) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("CreateServerVolume", in)
+    if m.CreateServerVolumeFunc != nil {
+        return m.CreateServerVolumeFunc(ctx, in)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVolumes(qParams compute.GetServerVolumesQueryParams) (compute.ServerVolumeListResponse, *http.Response, error) {
+    return m.GetServerVolumesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerVolumesWithContext(ctx context.Context, qParams compute.GetServerVolumesQueryParams) (compute.ServerVolumeListResponse, *http.Response, error) {
+    m.record("GetServerVolumes", qParams)
+    if m.GetServerVolumesFunc != nil {
+        return m.GetServerVolumesFunc(ctx, qParams)
+    }
+    return compute.ServerVolumeListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerVolumes(ctx context.Context, qParams compute.GetServerVolumesQueryParams) *compute.ServerVolumeIterator {
+    return compute.NewServerVolumeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerVolume, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerVolumesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerStorageClass(in compute.ServerStorageClassCreateRequest) (compute.ServerStorageClassSingleResponse, *http.Response, error) {
+    return m.CreateServerStorageClassWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerStorageClassWithContext(ctx context.Context, in compute.ServerStorageClassCreateRequest) (compute.ServerStorageClassSingleResponse, *http.Response, error) {
+    m.record("CreateServerStorageClass", in)
+    if m.CreateServerStorageClassFunc != nil {
+        return m.CreateServerStorageClassFunc(ctx, in)
+    }
+    return compute.ServerStorageClassSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerStorageClasses(qParams compute.GetServerStorageClassesQueryParams) (compute.ServerStorageClassListResponse, *http.Response, error) {
+    return m.GetServerStorageClassesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerStorageClassesWithContext(ctx context.Context, qParams compute.GetServerStorageClassesQueryParams) (compute.ServerStorageClassListResponse, *http.Response, error) {
+    m.record("GetServerStorageClasses", qParams)
+    if m.GetServerStorageClassesFunc != nil {
+        return m.GetServerStorageClassesFunc(ctx, qParams)
+    }
+    return compute.ServerStorageClassListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerStorageClasses(ctx context.Context, qParams compute.GetServerStorageClassesQueryParams) *compute.ServerStorageClassIterator {
+    return compute.NewServerStorageClassIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerStorageClass, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerStorageClassesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerFirewallMember(id string, member_id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error) {
+    return m.GetServerFirewallMemberWithContext(context.Background(), id, member_id)
+}
+
+func (m *Mock) GetServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error) {
+    m.record("GetServerFirewallMember", id, member_id)
+    if m.GetServerFirewallMemberFunc != nil {
+        return m.GetServerFirewallMemberFunc(ctx, id, member_id)
+    }
+    return compute.ServerFirewallMemberSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerFirewallMember(id string, member_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerFirewallMemberWithContext(context.Background(), id, member_id)
+}
+
+func (m *Mock) DeleteServerFirewallMemberWithContext(ctx context.Context, id string, member_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerFirewallMember", id, member_id)
+    if m.DeleteServerFirewallMemberFunc != nil {
+        return m.DeleteServerFirewallMemberFunc(ctx, id, member_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) Search(qParams compute.SearchQueryParams) (compute.SearchResponse, *http.Response, error) {
+    return m.SearchWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) SearchWithContext(ctx context.Context, qParams compute.SearchQueryParams) (compute.SearchResponse, *http.Response, error) {
+    m.record("Search", qParams)
+    if m.SearchFunc != nil {
+        return m.SearchFunc(ctx, qParams)
+    }
+    return compute.SearchResponse{}, nil, nil
+}
+
+func (m *Mock) GetScheduledServerAction(id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    return m.GetScheduledServerActionWithContext(context.Background(), id, action_id)
+}
+
+func (m *Mock) GetScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    m.record("GetScheduledServerAction", id, action_id)
+    if m.GetScheduledServerActionFunc != nil {
+        return m.GetScheduledServerActionFunc(ctx, id, action_id)
+    }
+    return compute.ScheduledServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteScheduledServerAction(id string, action_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteScheduledServerActionWithContext(context.Background(), id, action_id)
+}
+
+func (m *Mock) DeleteScheduledServerActionWithContext(ctx context.Context, id string, action_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteScheduledServerAction", id, action_id)
+    if m.DeleteScheduledServerActionFunc != nil {
+        return m.DeleteScheduledServerActionFunc(ctx, id, action_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateScheduledServerAction(in compute.ScheduledServerActionUpdateRequest, id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    return m.UpdateScheduledServerActionWithContext(context.Background(), in, id, action_id)
+}
+
+func (m *Mock) UpdateScheduledServerActionWithContext(ctx context.Context, in compute.ScheduledServerActionUpdateRequest, id string, action_id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    m.record("UpdateScheduledServerAction", in, id, action_id)
+    if m.UpdateScheduledServerActionFunc != nil {
+        return m.UpdateScheduledServerActionFunc(ctx, in, id, action_id)
+    }
+    return compute.ScheduledServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateS3Bucket(in compute.S3BucketCreateRequest) (compute.S3BucketSingleResponse, *http.Response, error) {
+    return m.CreateS3BucketWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateS3BucketWithContext(ctx context.Context, in compute.S3BucketCreateRequest) (compute.S3BucketSingleResponse, *http.Response, error) {
+    m.record("CreateS3Bucket", in)
+    if m.CreateS3BucketFunc != nil {
+        return m.CreateS3BucketFunc(ctx, in)
+    }
+    return compute.S3BucketSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetS3Buckets(qParams compute.GetS3BucketsQueryParams) (compute.S3BucketListResponse, *http.Response, error) {
+    return m.GetS3BucketsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetS3BucketsWithContext(ctx context.Context, qParams compute.GetS3BucketsQueryParams) (compute.S3BucketListResponse, *http.Response, error) {
+    m.record("GetS3Buckets", qParams)
+    if m.GetS3BucketsFunc != nil {
+        return m.GetS3BucketsFunc(ctx, qParams)
+    }
+    return compute.S3BucketListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllS3Buckets(ctx context.Context, qParams compute.GetS3BucketsQueryParams) *compute.S3BucketIterator {
+    return compute.NewS3BucketIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.S3Bucket, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetS3BucketsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerStatus(id string) (compute.ServerStatusResponse, *http.Response, error) {
+    return m.GetServerStatusWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerStatusWithContext(ctx context.Context, id string) (compute.ServerStatusResponse, *http.Response, error) {
+    m.record("GetServerStatus", id)
+    if m.GetServerStatusFunc != nil {
+        return m.GetServerStatusFunc(ctx, id)
+    }
+    return compute.ServerStatusResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerFirewallMember(in compute.ServerFirewallMemberCreateRequest, id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error) {
+    return m.CreateServerFirewallMemberWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateServerFirewallMemberWithContext(ctx context.Context, in compute.ServerFirewallMemberCreateRequest, id string) (compute.ServerFirewallMemberSingleResponse, *http.Response, error) {
+    m.record("CreateServerFirewallMember", in, id)
+    if m.CreateServerFirewallMemberFunc != nil {
+        return m.CreateServerFirewallMemberFunc(ctx, in, id)
+    }
+    return compute.ServerFirewallMemberSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerFirewallMembers(id string, qParams compute.GetServerFirewallMembersQueryParams) (compute.ServerFirewallMemberListResponse, *http.Response, error) {
+    return m.GetServerFirewallMembersWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerFirewallMembersWithContext(ctx context.Context, id string, qParams compute.GetServerFirewallMembersQueryParams) (compute.ServerFirewallMemberListResponse, *http.Response, error) {
+    m.record("GetServerFirewallMembers", id, qParams)
+    if m.GetServerFirewallMembersFunc != nil {
+        return m.GetServerFirewallMembersFunc(ctx, id, qParams)
+    }
+    return compute.ServerFirewallMemberListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerFirewallMembers(ctx context.Context, id string, qParams compute.GetServerFirewallMembersQueryParams) *compute.ServerFirewallMemberIterator {
+    return compute.NewServerFirewallMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerFirewallMember, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerFirewallMembersWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerPriceRange(id string) (compute.ServerPriceRangeSingleResponse, *http.Response, error) {
+    return m.GetServerPriceRangeWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerPriceRangeWithContext(ctx context.Context, id string) (compute.ServerPriceRangeSingleResponse, *http.Response, error) {
+    m.record("GetServerPriceRange", id)
+    if m.GetServerPriceRangeFunc != nil {
+        return m.GetServerPriceRangeFunc(ctx, id)
+    }
+    return compute.ServerPriceRangeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerAction(id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    return m.GetServerActionWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerActionWithContext(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    m.record("GetServerAction", id)
+    if m.GetServerActionFunc != nil {
+        return m.GetServerActionFunc(ctx, id)
+    }
+    return compute.ServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVariantPrice(id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    return m.GetServerVariantPriceWithContext(context.Background(), id, variant_id)
+}
+
+func (m *Mock) GetServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    m.record("GetServerVariantPrice", id, variant_id)
+    if m.GetServerVariantPriceFunc != nil {
+        return m.GetServerVariantPriceFunc(ctx, id, variant_id)
+    }
+    return compute.ServerVariantPriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerVariantPrice(id string, variant_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerVariantPriceWithContext(context.Background(), id, variant_id)
+}
+
+func (m *Mock) DeleteServerVariantPriceWithContext(ctx context.Context, id string, variant_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerVariantPrice", id, variant_id)
+    if m.DeleteServerVariantPriceFunc != nil {
+        return m.DeleteServerVariantPriceFunc(ctx, id, variant_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerVariantPrice(in compute.ServerVariantPriceUpdateRequest, id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    return m.UpdateServerVariantPriceWithContext(context.Background(), in, id, variant_id)
+}
+
+func (m *Mock) UpdateServerVariantPriceWithContext(ctx context.Context, in compute.ServerVariantPriceUpdateRequest, id string, variant_id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    m.record("UpdateServerVariantPrice", in, id, variant_id)
+    if m.UpdateServerVariantPriceFunc != nil {
+        return m.UpdateServerVariantPriceFunc(ctx, in, id, variant_id)
+    }
+    return compute.ServerVariantPriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVolumePricing(qParams compute.GetServerVolumePricingQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error) {
+    return m.GetServerVolumePricingWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerVolumePricingWithContext(ctx context.Context, qParams compute.GetServerVolumePricingQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error) {
+    m.record("GetServerVolumePricing", qParams)
+    if m.GetServerVolumePricingFunc != nil {
+        return m.GetServerVolumePricingFunc(ctx, qParams)
+    }
+    return compute.ServerVolumePriceListResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerTemplate(in compute.ServerTemplateCreateRequest) (compute.ServerTemplateSingleResponse, *http.Response, error) {
+    return m.CreateServerTemplateWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerTemplateWithContext(ctx context.Context, in compute.ServerTemplateCreateRequest) (compute.ServerTemplateSingleResponse, *http.Response, error) {
+    m.record("CreateServerTemplate", in)
+    if m.CreateServerTemplateFunc != nil {
+        return m.CreateServerTemplateFunc(ctx, in)
+    }
+    return compute.ServerTemplateSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerTemplates(qParams compute.GetServerTemplatesQueryParams) (compute.ServerTemplateListResponse, *http.Response, error) {
+    return m.GetServerTemplatesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerTemplatesWithContext(ctx context.Context, qParams compute.GetServerTemplatesQueryParams) (compute.ServerTemplateListResponse, *http.Response, error) {
+    m.record("GetServerTemplates", qParams)
+    if m.GetServerTemplatesFunc != nil {
+        return m.GetServerTemplatesFunc(ctx, qParams)
+    }
+    return compute.ServerTemplateListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerTemplates(ctx context.Context, qParams compute.GetServerTemplatesQueryParams) *compute.ServerTemplateIterator {
+    return compute.NewServerTemplateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerTemplate, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerTemplatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerHost(id string) (compute.ServerHostSingleResponse, *http.Response, error) {
+    return m.GetServerHostWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerHostWithContext(ctx context.Context, id string) (compute.ServerHostSingleResponse, *http.Response, error) {
+    m.record("GetServerHost", id)
+    if m.GetServerHostFunc != nil {
+        return m.GetServerHostFunc(ctx, id)
+    }
+    return compute.ServerHostSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerHost(in compute.ServerHostUpdateRequest, id string) (compute.ServerHostSingleResponse, *http.Response, error) {
+    return m.UpdateServerHostWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServerHostWithContext(ctx context.Context, in compute.ServerHostUpdateRequest, id string) (compute.ServerHostSingleResponse, *http.Response, error) {
+    m.record("UpdateServerHost", in, id)
+    if m.UpdateServerHostFunc != nil {
+        return m.UpdateServerHostFunc(ctx, in, id)
+    }
+    return compute.ServerHostSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerFirewallRule(in compute.ServerFirewallRuleCreateRequest, id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    return m.CreateServerFirewallRuleWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateServerFirewallRuleWithContext(ctx context.Context, in compute.ServerFirewallRuleCreateRequest, id string) (compute.ServerFirewallRuleSingleResponse, *http.Response, error) {
+    m.record("CreateServerFirewallRule", in, id)
+    if m.CreateServerFirewallRuleFunc != nil {
+        return m.CreateServerFirewallRuleFunc(ctx, in, id)
+    }
+    return compute.ServerFirewallRuleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerFirewallRules(id string, qParams compute.GetServerFirewallRulesQueryParams) (compute.ServerFirewallRuleListResponse, *http.Response, error) {
+    return m.GetServerFirewallRulesWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerFirewallRulesWithContext(ctx context.Context, id string, qParams compute.GetServerFirewallRulesQueryParams) (compute.ServerFirewallRuleListResponse, *http.Response, error) {
+    m.record("GetServerFirewallRules", id, qParams)
+    if m.GetServerFirewallRulesFunc != nil {
+        return m.GetServerFirewallRulesFunc(ctx, id, qParams)
+    }
+    return compute.ServerFirewallRuleListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerFirewallRules(ctx context.Context, id string, qParams compute.GetServerFirewallRulesQueryParams) *compute.ServerFirewallRuleIterator {
+    return compute.NewServerFirewallRuleIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerFirewallRule, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerFirewallRulesWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerPriceRangeVolumePrice(in compute.ServerVolumePriceCreateRequest, id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    return m.CreateServerPriceRangeVolumePriceWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateServerPriceRangeVolumePriceWithContext(ctx context.Context, in compute.ServerVolumePriceCreateRequest, id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    m.record("CreateServerPriceRangeVolumePrice", in, id)
+    if m.CreateServerPriceRangeVolumePriceFunc != nil {
+        return m.CreateServerPriceRangeVolumePriceFunc(ctx, in, id)
+    }
+    return compute.ServerVolumePriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerPriceRangeVolumePrices(id string, qParams compute.GetServerPriceRangeVolumePricesQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error) {
+    return m.GetServerPriceRangeVolumePricesWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerPriceRangeVolumePricesWithContext(ctx context.Context, id string, qParams compute.GetServerPriceRangeVolumePricesQueryParams) (compute.ServerVolumePriceListResponse, *http.Response, error) {
+    m.record("GetServerPriceRangeVolumePrices", id, qParams)
+    if m.GetServerPriceRangeVolumePricesFunc != nil {
+        return m.GetServerPriceRangeVolumePricesFunc(ctx, id, qParams)
+    }
+    return compute.ServerVolumePriceListResponse{}, nil, nil
+}
+
+func (m *Mock) CreateScheduledServerAction(in compute.ScheduledServerActionCreateRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    return m.CreateScheduledServerActionWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateScheduledServerActionWithContext(ctx context.Context, in compute.ScheduledServerActionCreateRequest, id string) (compute.ScheduledServerActionSingleResponse, *http.Response, error) {
+    m.record("CreateScheduledServerAction", in, id)
+    if m.CreateScheduledServerActionFunc != nil {
+        return m.CreateScheduledServerActionFunc(ctx, in, id)
+    }
+    return compute.ScheduledServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetScheduledServerActions(id string, qParams compute.GetScheduledServerActionsQueryParams) (compute.ScheduledServerActionListResponse, *http.Response, error) {
+    return m.GetScheduledServerActionsWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetScheduledServerActionsWithContext(ctx context.Context, id string, qParams compute.GetScheduledServerActionsQueryParams) (compute.ScheduledServerActionListResponse, *http.Response, error) {
+    m.record("GetScheduledServerActions", id, qParams)
+    if m.GetScheduledServerActionsFunc != nil {
+        return m.GetScheduledServerActionsFunc(ctx, id, qParams)
+    }
+    return compute.ScheduledServerActionListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllScheduledServerActions(ctx context.Context, id string, qParams compute.GetScheduledServerActionsQueryParams) *compute.ScheduledServerActionIterator {
+    return compute.NewScheduledServerActionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ScheduledServerAction, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetScheduledServerActionsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerPricing(qParams compute.GetServerPricingQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error) {
+    return m.GetServerPricingWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerPricingWithContext(ctx context.Context, qParams compute.GetServerPricingQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error) {
+    m.record("GetServerPricing", qParams)
+    if m.GetServerPricingFunc != nil {
+        return m.GetServerPricingFunc(ctx, qParams)
+    }
+    return compute.ServerVariantPriceListResponse{}, nil, nil
+}
+
+func (m *Mock) StopServer(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.StopServerWithContext(context.Background(), id)
+}
+
+func (m *Mock) StopServerWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("StopServer", id)
+    if m.StopServerFunc != nil {
+        return m.StopServerFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVolume(id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.GetServerVolumeWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerVolumeWithContext(ctx context.Context, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("GetServerVolume", id)
+    if m.GetServerVolumeFunc != nil {
+        return m.GetServerVolumeFunc(ctx, id)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerVolume(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerVolumeWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerVolumeWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerVolume", id)
+    if m.DeleteServerVolumeFunc != nil {
+        return m.DeleteServerVolumeFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerVolume(in compute.ServerVolumeUpdateRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.UpdateServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServerVolumeWithContext(ctx context.Context, in compute.ServerVolumeUpdateRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("UpdateServerVolume", in, id)
+    if m.UpdateServerVolumeFunc != nil {
+        return m.UpdateServerVolumeFunc(ctx, in, id)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerNetwork(in compute.ServerNetworkCreateRequest, id string) (compute.ServerNetworkSingleResponse, *http.Response, error) {
+    return m.CreateServerNetworkWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateServerNetworkWithContext(ctx context.Context, in compute.ServerNetworkCreateRequest, id string) (compute.ServerNetworkSingleResponse, *http.Response, error) {
+    m.record("CreateServerNetwork", in, id)
+    if m.CreateServerNetworkFunc != nil {
+        return m.CreateServerNetworkFunc(ctx, in, id)
+    }
+    return compute.ServerNetworkSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerNetworks(id string, qParams compute.GetServerNetworksQueryParams) (compute.ServerNetworkListResponse, *http.Response, error) {
+    return m.GetServerNetworksWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerNetworksWithContext(ctx context.Context, id string, qParams compute.GetServerNetworksQueryParams) (compute.ServerNetworkListResponse, *http.Response, error) {
+    m.record("GetServerNetworks", id, qParams)
+    if m.GetServerNetworksFunc != nil {
+        return m.GetServerNetworksFunc(ctx, id, qParams)
+    }
+    return compute.ServerNetworkListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerNetworks(ctx context.Context, id string, qParams compute.GetServerNetworksQueryParams) *compute.ServerNetworkIterator {
+    return compute.NewServerNetworkIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerNetwork, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerNetworksWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerVariant(in compute.ServerVariantCreateRequest) (compute.ServerVariantSingleResponse, *http.Response, error) {
+    return m.CreateServerVariantWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerVariantWithContext(ctx context.Context, in compute.ServerVariantCreateRequest) (compute.ServerVariantSingleResponse, *http.Response, error) {
+    m.record("CreateServerVariant", in)
+    if m.CreateServerVariantFunc != nil {
+        return m.CreateServerVariantFunc(ctx, in)
+    }
+    return compute.ServerVariantSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVariants(qParams compute.GetServerVariantsQueryParams) (compute.ServerVariantListResponse, *http.Response, error) {
+    return m.GetServerVariantsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerVariantsWithContext(ctx context.Context, qParams compute.GetServerVariantsQueryParams) (compute.ServerVariantListResponse, *http.Response, error) {
+    m.record("GetServerVariants", qParams)
+    if m.GetServerVariantsFunc != nil {
+        return m.GetServerVariantsFunc(ctx, qParams)
+    }
+    return compute.ServerVariantListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerVariants(ctx context.Context, qParams compute.GetServerVariantsQueryParams) *compute.ServerVariantIterator {
+    return compute.NewServerVariantIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerVariant, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerVariantsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerStorage(id string) (compute.ServerStorageSingleResponse, *http.Response, error) {
+    return m.GetServerStorageWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerStorageWithContext(ctx context.Context, id string) (compute.ServerStorageSingleResponse, *http.Response, error) {
+    m.record("GetServerStorage", id)
+    if m.GetServerStorageFunc != nil {
+        return m.GetServerStorageFunc(ctx, id)
+    }
+    return compute.ServerStorageSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetSSHKey(id string) (compute.SSHKeySingleResponse, *http.Response, error) {
+    return m.GetSSHKeyWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSSHKeyWithContext(ctx context.Context, id string) (compute.SSHKeySingleResponse, *http.Response, error) {
+    m.record("GetSSHKey", id)
+    if m.GetSSHKeyFunc != nil {
+        return m.GetSSHKeyFunc(ctx, id)
+    }
+    return compute.SSHKeySingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteSSHKey(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteSSHKeyWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteSSHKeyWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteSSHKey", id)
+    if m.DeleteSSHKeyFunc != nil {
+        return m.DeleteSSHKeyFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateSSHKey(in compute.SSHKeyUpdateRequest, id string) (compute.SSHKeySingleResponse, *http.Response, error) {
+    return m.UpdateSSHKeyWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateSSHKeyWithContext(ctx context.Context, in compute.SSHKeyUpdateRequest, id string) (compute.SSHKeySingleResponse, *http.Response, error) {
+    m.record("UpdateSSHKey", in, id)
+    if m.UpdateSSHKeyFunc != nil {
+        return m.UpdateSSHKeyFunc(ctx, in, id)
+    }
+    return compute.SSHKeySingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerPriceRangeAssignment(in compute.ServerPriceRangeAssignmentCreateRequest) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    return m.CreateServerPriceRangeAssignmentWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerPriceRangeAssignmentWithContext(ctx context.Context, in compute.ServerPriceRangeAssignmentCreateRequest) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    m.record("CreateServerPriceRangeAssignment", in)
+    if m.CreateServerPriceRangeAssignmentFunc != nil {
+        return m.CreateServerPriceRangeAssignmentFunc(ctx, in)
+    }
+    return compute.ServerPriceRangeAssignmentSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerPriceRangeAssignments(qParams compute.GetServerPriceRangeAssignmentsQueryParams) (compute.ServerPriceRangeAssignmentListResponse, *http.Response, error) {
+    return m.GetServerPriceRangeAssignmentsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerPriceRangeAssignmentsWithContext(ctx context.Context, qParams compute.GetServerPriceRangeAssignmentsQueryParams) (compute.ServerPriceRangeAssignmentListResponse, *http.Response, error) {
+    m.record("GetServerPriceRangeAssignments", qParams)
+    if m.GetServerPriceRangeAssignmentsFunc != nil {
+        return m.GetServerPriceRangeAssignmentsFunc(ctx, qParams)
+    }
+    return compute.ServerPriceRangeAssignmentListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerPriceRangeAssignments(ctx context.Context, qParams compute.GetServerPriceRangeAssignmentsQueryParams) *compute.ServerPriceRangeAssignmentIterator {
+    return compute.NewServerPriceRangeAssignmentIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerPriceRangeAssignment, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerPriceRangeAssignmentsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetAddresses(qParams compute.GetAddressesQueryParams) (compute.AddressListResponse, *http.Response, error) {
+    return m.GetAddressesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetAddressesWithContext(ctx context.Context, qParams compute.GetAddressesQueryParams) (compute.AddressListResponse, *http.Response, error) {
+    m.record("GetAddresses", qParams)
+    if m.GetAddressesFunc != nil {
+        return m.GetAddressesFunc(ctx, qParams)
+    }
+    return compute.AddressListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllAddresses(ctx context.Context, qParams compute.GetAddressesQueryParams) *compute.AddressIterator {
+    return compute.NewAddressIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.Address, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetAddressesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerVariant(id string) (compute.ServerVariantSingleResponse, *http.Response, error) {
+    return m.GetServerVariantWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerVariantWithContext(ctx context.Context, id string) (compute.ServerVariantSingleResponse, *http.Response, error) {
+    m.record("GetServerVariant", id)
+    if m.GetServerVariantFunc != nil {
+        return m.GetServerVariantFunc(ctx, id)
+    }
+    return compute.ServerVariantSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerVariant(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerVariantWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerVariantWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerVariant", id)
+    if m.DeleteServerVariantFunc != nil {
+        return m.DeleteServerVariantFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteS3AccessKeyGrant(access_key_id string, id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteS3AccessKeyGrantWithContext(context.Background(), access_key_id, id)
+}
+
+func (m *Mock) DeleteS3AccessKeyGrantWithContext(ctx context.Context, access_key_id string, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteS3AccessKeyGrant", access_key_id, id)
+    if m.DeleteS3AccessKeyGrantFunc != nil {
+        return m.DeleteS3AccessKeyGrantFunc(ctx, access_key_id, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerMedia(in compute.ServerMediaCreateRequest) (compute.ServerMediaSingleResponse, *http.Response, error) {
+    return m.CreateServerMediaWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerMediaWithContext(ctx context.Context, in compute.ServerMediaCreateRequest) (compute.ServerMediaSingleResponse, *http.Response, error) {
+    m.record("CreateServerMedia", in)
+    if m.CreateServerMediaFunc != nil {
+        return m.CreateServerMediaFunc(ctx, in)
+    }
+    return compute.ServerMediaSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerMedias(qParams compute.GetServerMediasQueryParams) (compute.ServerMediaListResponse, *http.Response, error) {
+    return m.GetServerMediasWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerMediasWithContext(ctx context.Context, qParams compute.GetServerMediasQueryParams) (compute.ServerMediaListResponse, *http.Response, error) {
+    m.record("GetServerMedias", qParams)
+    if m.GetServerMediasFunc != nil {
+        return m.GetServerMediasFunc(ctx, qParams)
+    }
+    return compute.ServerMediaListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerMedias(ctx context.Context, qParams compute.GetServerMediasQueryParams) *compute.ServerMediaIterator {
+    return compute.NewServerMediaIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerMedia, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerMediasWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetSubnet(id string) (compute.SubnetSingleResponse, *http.Response, error) {
+    return m.GetSubnetWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetSubnetWithContext(ctx context.Context, id string) (compute.SubnetSingleResponse, *http.Response, error) {
+    m.record("GetSubnet", id)
+    if m.GetSubnetFunc != nil {
+        return m.GetSubnetFunc(ctx, id)
+    }
+    return compute.SubnetSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteSubnet(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteSubnetWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteSubnetWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteSubnet", id)
+    if m.DeleteSubnetFunc != nil {
+        return m.DeleteSubnetFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) AttachServerVolume(in compute.ServerVolumeAttachRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.AttachServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) AttachServerVolumeWithContext(ctx context.Context, in compute.ServerVolumeAttachRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("AttachServerVolume", in, id)
+    if m.AttachServerVolumeFunc != nil {
+        return m.AttachServerVolumeFunc(ctx, in, id)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerPriceRangeVolumePrice(id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    return m.GetServerPriceRangeVolumePriceWithContext(context.Background(), id, class_id)
+}
+
+func (m *Mock) GetServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    m.record("GetServerPriceRangeVolumePrice", id, class_id)
+    if m.GetServerPriceRangeVolumePriceFunc != nil {
+        return m.GetServerPriceRangeVolumePriceFunc(ctx, id, class_id)
+    }
+    return compute.ServerVolumePriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerPriceRangeVolumePrice(id string, class_id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerPriceRangeVolumePriceWithContext(context.Background(), id, class_id)
+}
+
+func (m *Mock) DeleteServerPriceRangeVolumePriceWithContext(ctx context.Context, id string, class_id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerPriceRangeVolumePrice", id, class_id)
+    if m.DeleteServerPriceRangeVolumePriceFunc != nil {
+        return m.DeleteServerPriceRangeVolumePriceFunc(ctx, id, class_id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerPriceRangeVolumePrice(in compute.ServerVolumePriceUpdateRequest, id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    return m.UpdateServerPriceRangeVolumePriceWithContext(context.Background(), in, id, class_id)
+}
+
+func (m *Mock) UpdateServerPriceRangeVolumePriceWithContext(ctx context.Context, in compute.ServerVolumePriceUpdateRequest, id string, class_id string) (compute.ServerVolumePriceSingleResponse, *http.Response, error) {
+    m.record("UpdateServerPriceRangeVolumePrice", in, id, class_id)
+    if m.UpdateServerPriceRangeVolumePriceFunc != nil {
+        return m.UpdateServerPriceRangeVolumePriceFunc(ctx, in, id, class_id)
+    }
+    return compute.ServerVolumePriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetS3AccessKey(id string) (compute.S3AccessKeySingleResponse, *http.Response, error) {
+    return m.GetS3AccessKeyWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetS3AccessKeyWithContext(ctx context.Context, id string) (compute.S3AccessKeySingleResponse, *http.Response, error) {
+    m.record("GetS3AccessKey", id)
+    if m.GetS3AccessKeyFunc != nil {
+        return m.GetS3AccessKeyFunc(ctx, id)
+    }
+    return compute.S3AccessKeySingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteS3AccessKey(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteS3AccessKeyWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteS3AccessKeyWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteS3AccessKey", id)
+    if m.DeleteS3AccessKeyFunc != nil {
+        return m.DeleteS3AccessKeyFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateS3AccessKey(in compute.S3AccessKeyCreateRequest) (compute.S3AccessKeySingleResponse, *http.Response, error) {
+    return m.CreateS3AccessKeyWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateS3AccessKeyWithContext(ctx context.Context, in compute.S3AccessKeyCreateRequest) (compute.S3AccessKeySingleResponse, *http.Response, error) {
+    m.record("CreateS3AccessKey", in)
+    if m.CreateS3AccessKeyFunc != nil {
+        return m.CreateS3AccessKeyFunc(ctx, in)
+    }
+    return compute.S3AccessKeySingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetS3AccessKeys(qParams compute.GetS3AccessKeysQueryParams) (compute.S3AccessKeyListResponse, *http.Response, error) {
+    return m.GetS3AccessKeysWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetS3AccessKeysWithContext(ctx context.Context, qParams compute.GetS3AccessKeysQueryParams) (compute.S3AccessKeyListResponse, *http.Response, error) {
+    m.record("GetS3AccessKeys", qParams)
+    if m.GetS3AccessKeysFunc != nil {
+        return m.GetS3AccessKeysFunc(ctx, qParams)
+    }
+    return compute.S3AccessKeyListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllS3AccessKeys(ctx context.Context, qParams compute.GetS3AccessKeysQueryParams) *compute.S3AccessKeyIterator {
+    return compute.NewS3AccessKeyIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.S3AccessKey, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetS3AccessKeysWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetAddress(id string) (compute.AddressSingleResponse, *http.Response, error) {
+    return m.GetAddressWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetAddressWithContext(ctx context.Context, id string) (compute.AddressSingleResponse, *http.Response, error) {
+    m.record("GetAddress", id)
+    if m.GetAddressFunc != nil {
+        return m.GetAddressFunc(ctx, id)
+    }
+    return compute.AddressSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerBackup(id string) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    return m.GetServerBackupWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerBackupWithContext(ctx context.Context, id string) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    m.record("GetServerBackup", id)
+    if m.GetServerBackupFunc != nil {
+        return m.GetServerBackupFunc(ctx, id)
+    }
+    return compute.ServerBackupSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerBackup(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerBackupWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerBackupWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerBackup", id)
+    if m.DeleteServerBackupFunc != nil {
+        return m.DeleteServerBackupFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerBackup(in compute.ServerBackupUpdateRequest, id string) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    return m.UpdateServerBackupWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServerBackupWithContext(ctx context.Context, in compute.ServerBackupUpdateRequest, id string) (compute.ServerBackupSingleResponse, *http.Response, error) {
+    m.record("UpdateServerBackup", in, id)
+    if m.UpdateServerBackupFunc != nil {
+        return m.UpdateServerBackupFunc(ctx, in, id)
+    }
+    return compute.ServerBackupSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateNetwork(in compute.NetworkCreateRequest) (compute.NetworkSingleResponse, *http.Response, error) {
+    return m.CreateNetworkWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateNetworkWithContext(ctx context.Context, in compute.NetworkCreateRequest) (compute.NetworkSingleResponse, *http.Response, error) {
+    m.record("CreateNetwork", in)
+    if m.CreateNetworkFunc != nil {
+        return m.CreateNetworkFunc(ctx, in)
+    }
+    return compute.NetworkSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetNetworks(qParams compute.GetNetworksQueryParams) (compute.NetworkListResponse, *http.Response, error) {
+    return m.GetNetworksWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetNetworksWithContext(ctx context.Context, qParams compute.GetNetworksQueryParams) (compute.NetworkListResponse, *http.Response, error) {
+    m.record("GetNetworks", qParams)
+    if m.GetNetworksFunc != nil {
+        return m.GetNetworksFunc(ctx, qParams)
+    }
+    return compute.NetworkListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllNetworks(ctx context.Context, qParams compute.GetNetworksQueryParams) *compute.NetworkIterator {
+    return compute.NewNetworkIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.Network, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetNetworksWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateServerStorage(in compute.ServerStorageCreateRequest) (compute.ServerStorageSingleResponse, *http.Response, error) {
+    return m.CreateServerStorageWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateServerStorageWithContext(ctx context.Context, in compute.ServerStorageCreateRequest) (compute.ServerStorageSingleResponse, *http.Response, error) {
+    m.record("CreateServerStorage", in)
+    if m.CreateServerStorageFunc != nil {
+        return m.CreateServerStorageFunc(ctx, in)
+    }
+    return compute.ServerStorageSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerStorages(qParams compute.GetServerStoragesQueryParams) (compute.ServerStorageListResponse, *http.Response, error) {
+    return m.GetServerStoragesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetServerStoragesWithContext(ctx context.Context, qParams compute.GetServerStoragesQueryParams) (compute.ServerStorageListResponse, *http.Response, error) {
+    m.record("GetServerStorages", qParams)
+    if m.GetServerStoragesFunc != nil {
+        return m.GetServerStoragesFunc(ctx, qParams)
+    }
+    return compute.ServerStorageListResponse{}, nil, nil
+}
+
+func (m *Mock) ResizeServer(in compute.ServerResizeRequest, id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.ResizeServerWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) ResizeServerWithContext(ctx context.Context, in compute.ServerResizeRequest, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("ResizeServer", in, id)
+    if m.ResizeServerFunc != nil {
+        return m.ResizeServerFunc(ctx, in, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerMedia(id string) (compute.ServerMediaSingleResponse, *http.Response, error) {
+    return m.GetServerMediaWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerMediaWithContext(ctx context.Context, id string) (compute.ServerMediaSingleResponse, *http.Response, error) {
+    m.record("GetServerMedia", id)
+    if m.GetServerMediaFunc != nil {
+        return m.GetServerMediaFunc(ctx, id)
+    }
+    return compute.ServerMediaSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerMedia(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerMediaWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerMediaWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerMedia", id)
+    if m.DeleteServerMediaFunc != nil {
+        return m.DeleteServerMediaFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CreateS3AccessKeyGrant(in compute.S3AccessGrantCreateRequest, access_key_id string) (compute.S3AccessGrantSingleResponse, *http.Response, error) {
+    return m.CreateS3AccessKeyGrantWithContext(context.Background(), in, access_key_id)
+}
+
+func (m *Mock) CreateS3AccessKeyGrantWithContext(ctx context.Context, in compute.S3AccessGrantCreateRequest, access_key_id string) (compute.S3AccessGrantSingleResponse, *http.Response, error) {
+    m.record("CreateS3AccessKeyGrant", in, access_key_id)
+    if m.CreateS3AccessKeyGrantFunc != nil {
+        return m.CreateS3AccessKeyGrantFunc(ctx, in, access_key_id)
+    }
+    return compute.S3AccessGrantSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetS3AccessKeyGrants(access_key_id string, qParams compute.GetS3AccessKeyGrantsQueryParams) (compute.S3AccessGrantListResponse, *http.Response, error) {
+    return m.GetS3AccessKeyGrantsWithContext(context.Background(), access_key_id, qParams)
+}
+
+func (m *Mock) GetS3AccessKeyGrantsWithContext(ctx context.Context, access_key_id string, qParams compute.GetS3AccessKeyGrantsQueryParams) (compute.S3AccessGrantListResponse, *http.Response, error) {
+    m.record("GetS3AccessKeyGrants", access_key_id, qParams)
+    if m.GetS3AccessKeyGrantsFunc != nil {
+        return m.GetS3AccessKeyGrantsFunc(ctx, access_key_id, qParams)
+    }
+    return compute.S3AccessGrantListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllS3AccessKeyGrants(ctx context.Context, access_key_id string, qParams compute.GetS3AccessKeyGrantsQueryParams) *compute.S3AccessGrantIterator {
+    return compute.NewS3AccessGrantIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.S3AccessGrant, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetS3AccessKeyGrantsWithContext(ctx, access_key_id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetServerPriceRangeAssignment(id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    return m.GetServerPriceRangeAssignmentWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    m.record("GetServerPriceRangeAssignment", id)
+    if m.GetServerPriceRangeAssignmentFunc != nil {
+        return m.GetServerPriceRangeAssignmentFunc(ctx, id)
+    }
+    return compute.ServerPriceRangeAssignmentSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteServerPriceRangeAssignment(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteServerPriceRangeAssignmentWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteServerPriceRangeAssignmentWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteServerPriceRangeAssignment", id)
+    if m.DeleteServerPriceRangeAssignmentFunc != nil {
+        return m.DeleteServerPriceRangeAssignmentFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateServerPriceRangeAssignment(in compute.ServerPriceRangeAssignmentUpdateRequest, id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    return m.UpdateServerPriceRangeAssignmentWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateServerPriceRangeAssignmentWithContext(ctx context.Context, in compute.ServerPriceRangeAssignmentUpdateRequest, id string) (compute.ServerPriceRangeAssignmentSingleResponse, *http.Response, error) {
+    m.record("UpdateServerPriceRangeAssignment", in, id)
+    if m.UpdateServerPriceRangeAssignmentFunc != nil {
+        return m.UpdateServerPriceRangeAssignmentFunc(ctx, in, id)
+    }
+    return compute.ServerPriceRangeAssignmentSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVNC(id string) (compute.ServerVNCResponse, *http.Response, error) {
+    return m.GetServerVNCWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetServerVNCWithContext(ctx context.Context, id string) (compute.ServerVNCResponse, *http.Response, error) {
+    m.record("GetServerVNC", id)
+    if m.GetServerVNCFunc != nil {
+        return m.GetServerVNCFunc(ctx, id)
+    }
+    return compute.ServerVNCResponse{}, nil, nil
+}
+
+func (m *Mock) CancelServerAction(id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    return m.CancelServerActionWithContext(context.Background(), id)
+}
+
+func (m *Mock) CancelServerActionWithContext(ctx context.Context, id string) (compute.ServerActionSingleResponse, *http.Response, error) {
+    m.record("CancelServerAction", id)
+    if m.CancelServerActionFunc != nil {
+        return m.CancelServerActionFunc(ctx, id)
+    }
+    return compute.ServerActionSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetNetwork(id string) (compute.NetworkSingleResponse, *http.Response, error) {
+    return m.GetNetworkWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetNetworkWithContext(ctx context.Context, id string) (compute.NetworkSingleResponse, *http.Response, error) {
+    m.record("GetNetwork", id)
+    if m.GetNetworkFunc != nil {
+        return m.GetNetworkFunc(ctx, id)
+    }
+    return compute.NetworkSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateNetwork(in compute.NetworkUpdateRequest, id string) (compute.NetworkSingleResponse, *http.Response, error) {
+    return m.UpdateNetworkWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) UpdateNetworkWithContext(ctx context.Context, in compute.NetworkUpdateRequest, id string) (compute.NetworkSingleResponse, *http.Response, error) {
+    m.record("UpdateNetwork", in, id)
+    if m.UpdateNetworkFunc != nil {
+        return m.UpdateNetworkFunc(ctx, in, id)
+    }
+    return compute.NetworkSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetLabels(qParams compute.GetLabelsQueryParams) (compute.LabelListResponse, *http.Response, error) {
+    return m.GetLabelsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetLabelsWithContext(ctx context.Context, qParams compute.GetLabelsQueryParams) (compute.LabelListResponse, *http.Response, error) {
+    m.record("GetLabels", qParams)
+    if m.GetLabelsFunc != nil {
+        return m.GetLabelsFunc(ctx, qParams)
+    }
+    return compute.LabelListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllLabels(ctx context.Context, qParams compute.GetLabelsQueryParams) *compute.LabelIterator {
+    return compute.NewLabelIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.Label, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetLabelsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) ResizeServerVolume(in compute.ServerVolumeResizeRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.ResizeServerVolumeWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) ResizeServerVolumeWithContext(ctx context.Context, in compute.ServerVolumeResizeRequest, id string) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("ResizeServerVolume", in, id)
+    if m.ResizeServerVolumeFunc != nil {
+        return m.ResizeServerVolumeFunc(ctx, in, id)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetS3Bucket(id string) (compute.S3BucketSingleResponse, *http.Response, error) {
+    return m.GetS3BucketWithContext(context.Background(), id)
+}
+
+func (m *Mock) GetS3BucketWithContext(ctx context.Context, id string) (compute.S3BucketSingleResponse, *http.Response, error) {
+    m.record("GetS3Bucket", id)
+    if m.GetS3BucketFunc != nil {
+        return m.GetS3BucketFunc(ctx, id)
+    }
+    return compute.S3BucketSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteS3Bucket(id string) (compute.EmptyResponse, *http.Response, error) {
+    return m.DeleteS3BucketWithContext(context.Background(), id)
+}
+
+func (m *Mock) DeleteS3BucketWithContext(ctx context.Context, id string) (compute.EmptyResponse, *http.Response, error) {
+    m.record("DeleteS3Bucket", id)
+    if m.DeleteS3BucketFunc != nil {
+        return m.DeleteS3BucketFunc(ctx, id)
+    }
+    return compute.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) DetachServerVolume(id string, qParams compute.DetachServerVolumeQueryParams) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    return m.DetachServerVolumeWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) DetachServerVolumeWithContext(ctx context.Context, id string, qParams compute.DetachServerVolumeQueryParams) (compute.ServerVolumeSingleResponse, *http.Response, error) {
+    m.record("DetachServerVolume", id, qParams)
+    if m.DetachServerVolumeFunc != nil {
+        return m.DetachServerVolumeFunc(ctx, id, qParams)
+    }
+    return compute.ServerVolumeSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateServerVariantPrice(in compute.ServerVariantPriceCreateRequest, id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    return m.CreateServerVariantPriceWithContext(context.Background(), in, id)
+}
+
+func (m *Mock) CreateServerVariantPriceWithContext(ctx context.Context, in compute.ServerVariantPriceCreateRequest, id string) (compute.ServerVariantPriceSingleResponse, *http.Response, error) {
+    m.record("CreateServerVariantPrice", in, id)
+    if m.CreateServerVariantPriceFunc != nil {
+        return m.CreateServerVariantPriceFunc(ctx, in, id)
+    }
+    return compute.ServerVariantPriceSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetServerVariantPrices(id string, qParams compute.GetServerVariantPricesQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error) {
+    return m.GetServerVariantPricesWithContext(context.Background(), id, qParams)
+}
+
+func (m *Mock) GetServerVariantPricesWithContext(ctx context.Context, id string, qParams compute.GetServerVariantPricesQueryParams) (compute.ServerVariantPriceListResponse, *http.Response, error) {
+    m.record("GetServerVariantPrices", id, qParams)
+    if m.GetServerVariantPricesFunc != nil {
+        return m.GetServerVariantPricesFunc(ctx, id, qParams)
+    }
+    return compute.ServerVariantPriceListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllServerVariantPrices(ctx context.Context, id string, qParams compute.GetServerVariantPricesQueryParams) *compute.ServerVariantPriceIterator {
+    return compute.NewServerVariantPriceIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]compute.ServerVariantPrice, *compute.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetServerVariantPricesWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
@@ -0,0 +1,443 @@
+package compute
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "sort"
+    "strings"
+)
+
+// FirewallPolicy is the desired state of a server firewall, which is identified by its
+// title within the project.
+type FirewallPolicy struct {
+    Title string `json:"title"`
+    ProjectId string `json:"project_id,omitempty"`
+    Rules []FirewallRule `json:"rules"`
+    Members []FirewallMember `json:"members"`
+}
+
+type FirewallRule struct {
+    Type ServerFirewallRuleType `json:"type"`
+    Protocol *ServerFirewallRuleProtocol `json:"protocol,omitempty"`
+    Ports []string `json:"ports,omitempty"`
+    Addresses []string `json:"addresses,omitempty"`
+    Description string `json:"description,omitempty"`
+}
+
+// FirewallMember is either a server or all servers with a label. A nil LabelValue matches
+// every value of the label.
+type FirewallMember struct {
+    ServerId string `json:"server_id,omitempty"`
+    LabelName string `json:"label_name,omitempty"`
+    LabelValue *string `json:"label_value,omitempty"`
+}
+
+// LoadFirewallPolicy reads a policy from its JSON representation.
+func LoadFirewallPolicy(r io.Reader) (FirewallPolicy, error) {
+    policy := FirewallPolicy{}
+    dec := json.NewDecoder(r)
+    dec.DisallowUnknownFields()
+    if err := dec.Decode(&policy); err != nil {
+        return policy, err
+    }
+    return policy, policy.Validate()
+}
+
+func (p FirewallPolicy) Validate() error {
+    if len(p.Title) == 0 {
+        return fmt.Errorf("firewall policy: title must not be empty")
+    }
+    for i, r := range p.Rules {
+        if !r.Type.IsValid() {
+            return fmt.Errorf("firewall policy: rule %d: invalid type %q", i, r.Type)
+        }
+        if r.Protocol != nil && !r.Protocol.IsValid() {
+            return fmt.Errorf("firewall policy: rule %d: invalid protocol %q", i, *r.Protocol)
+        }
+        if len(r.Ports) > 0 && (r.Protocol == nil || *r.Protocol == ServerFirewallRuleProtocolICMP) {
+            return fmt.Errorf("firewall policy: rule %d: ports require the TCP or UDP protocol", i)
+        }
+    }
+    for i, m := range p.Members {
+        if (len(m.ServerId) > 0) == (len(m.LabelName) > 0) {
+            return fmt.Errorf("firewall policy: member %d: either server_id or label_name must be set", i)
+        }
+    }
+    return nil
+}
+
+func sortedKey(values []string) string {
+    sorted := append([]string{}, values...)
+    sort.Strings(sorted)
+    return strings.Join(sorted, ",")
+}
+
+func (r FirewallRule) key() string {
+    protocol := ""
+    if r.Protocol != nil {
+        protocol = string(*r.Protocol)
+    }
+    return string(r.Type) + "|" + protocol + "|" + sortedKey(r.Ports) + "|" + sortedKey(r.Addresses)
+}
+
+func (m FirewallMember) key() string {
+    if len(m.ServerId) > 0 {
+        return "server|" + m.ServerId
+    }
+    value := "*"
+    if m.LabelValue != nil {
+        value = "=" + *m.LabelValue
+    }
+    return "label|" + m.LabelName + value
+}
+
+func (m FirewallMember) String() string {
+    if len(m.ServerId) > 0 {
+        return "server " + m.ServerId
+    }
+    if m.LabelValue == nil {
+        return "label " + m.LabelName
+    }
+    return "label " + m.LabelName + "=" + *m.LabelValue
+}
+
+func (r FirewallRule) String() string {
+    protocol := "ANY"
+    if r.Protocol != nil {
+        protocol = string(*r.Protocol)
+    }
+    s := fmt.Sprintf("%s %s", r.Type, protocol)
+    if len(r.Ports) > 0 {
+        s += " ports " + strings.Join(r.Ports, ",")
+    }
+    if len(r.Addresses) > 0 {
+        s += " from " + strings.Join(r.Addresses, ",")
+        if r.Type == ServerFirewallRuleTypeEgress {
+            s = strings.Replace(s, " from ", " to ", 1)
+        }
+    }
+    if len(r.Description) > 0 {
+        s += fmt.Sprintf(" (%s)", r.Description)
+    }
+    return s
+}
+
+// FirewallRuleFromServer converts a live rule into its policy representation.
+func FirewallRuleFromServer(r ServerFirewallRule) FirewallRule {
+    rule := FirewallRule{Type: r.Type, Protocol: r.Protocol}
+    if r.Ports != nil {
+        rule.Ports = *r.Ports
+    }
+    if r.Addresses != nil {
+        rule.Addresses = *r.Addresses
+    }
+    if r.Description != nil {
+        rule.Description = *r.Description
+    }
+    return rule
+}
+
+// FirewallMemberFromServer converts a live member into its policy representation.
+func FirewallMemberFromServer(m ServerFirewallMember) FirewallMember {
+    member := FirewallMember{LabelValue: m.LabelValue}
+    if m.ServerId != nil {
+        member.ServerId = *m.ServerId
+    }
+    if m.LabelName != nil {
+        member.LabelName = *m.LabelName
+    }
+    return member
+}
+
+type FirewallChangeAction string
+
+const (
+    FirewallChangeCreate FirewallChangeAction = "create"
+    FirewallChangeUpdate FirewallChangeAction = "update"
+    FirewallChangeDelete FirewallChangeAction = "delete"
+)
+
+// FirewallChange is a single step of a FirewallPlan, changing either a rule or a member.
+// Id is the id of the live rule or member for updates and deletes.
+type FirewallChange struct {
+    Action FirewallChangeAction
+    Id string
+    Rule *FirewallRule
+    Member *FirewallMember
+}
+
+func (c FirewallChange) String() string {
+    prefix := map[FirewallChangeAction]string{FirewallChangeCreate: "+", FirewallChangeUpdate: "~", FirewallChangeDelete: "-"}[c.Action]
+    if c.Rule != nil {
+        return prefix + " rule " + c.Rule.String()
+    }
+    return prefix + " member " + c.Member.String()
+}
+
+// FirewallPlan is the set of changes needed to bring a firewall to its policy. FirewallId
+// is empty if the firewall does not exist yet and will be created.
+type FirewallPlan struct {
+    FirewallId string
+    Policy FirewallPolicy
+    Changes []FirewallChange
+}
+
+// PlanFirewall computes the changes from the live rules and members of a firewall to the
+// policy. Rules are matched on type, protocol, ports and addresses, a changed description
+// is updated in place.
+func PlanFirewall(policy FirewallPolicy, firewallId string, rules []ServerFirewallRule, members []ServerFirewallMember) FirewallPlan {
+    plan := FirewallPlan{FirewallId: firewallId, Policy: policy, Changes: []FirewallChange{}}
+
+    liveRules := map[string][]ServerFirewallRule{}
+    for _, r := range rules {
+        key := FirewallRuleFromServer(r).key()
+        liveRules[key] = append(liveRules[key], r)
+    }
+    creates := []FirewallChange{}
+    for i := range policy.Rules {
+        desired := policy.Rules[i]
+        matches := liveRules[desired.key()]
+        if len(matches) == 0 {
+            creates = append(creates, FirewallChange{Action: FirewallChangeCreate, Rule: &desired})
+            continue
+        }
+        liveRules[desired.key()] = matches[1:]
+        if FirewallRuleFromServer(matches[0]).Description != desired.Description {
+            plan.Changes = append(plan.Changes, FirewallChange{Action: FirewallChangeUpdate, Id: matches[0].Id, Rule: &desired})
+        }
+    }
+
+    liveMembers := map[string][]ServerFirewallMember{}
+    for _, m := range members {
+        key := FirewallMemberFromServer(m).key()
+        liveMembers[key] = append(liveMembers[key], m)
+    }
+    for i := range policy.Members {
+        desired := policy.Members[i]
+        matches := liveMembers[desired.key()]
+        if len(matches) == 0 {
+            creates = append(creates, FirewallChange{Action: FirewallChangeCreate, Member: &desired})
+            continue
+        }
+        liveMembers[desired.key()] = matches[1:]
+    }
+
+    // New rules and members are added before the old ones are removed, so that traffic
+    // allowed by both states is not interrupted.
+    plan.Changes = append(plan.Changes, creates...)
+    for _, m := range members {
+        key := FirewallMemberFromServer(m).key()
+        if remaining := liveMembers[key]; len(remaining) > 0 && remaining[0].Id == m.Id {
+            liveMembers[key] = remaining[1:]
+            member := FirewallMemberFromServer(m)
+            plan.Changes = append(plan.Changes, FirewallChange{Action: FirewallChangeDelete, Id: m.Id, Member: &member})
+        }
+    }
+    for _, r := range rules {
+        key := FirewallRuleFromServer(r).key()
+        if remaining := liveRules[key]; len(remaining) > 0 && remaining[0].Id == r.Id {
+            liveRules[key] = remaining[1:]
+            rule := FirewallRuleFromServer(r)
+            plan.Changes = append(plan.Changes, FirewallChange{Action: FirewallChangeDelete, Id: r.Id, Rule: &rule})
+        }
+    }
+    return plan
+}
+
+func (p FirewallPlan) Empty() bool {
+    return len(p.FirewallId) > 0 && len(p.Changes) == 0
+}
+
+// WriteDiff writes a human readable diff of the plan to w.
+func (p FirewallPlan) WriteDiff(w io.Writer) error {
+    b := &strings.Builder{}
+    if len(p.FirewallId) == 0 {
+        fmt.Fprintf(b, "+ firewall %s\n", p.Policy.Title)
+    } else {
+        fmt.Fprintf(b, "firewall %s (%s): %d changes\n", p.Policy.Title, p.FirewallId, len(p.Changes))
+    }
+    for _, c := range p.Changes {
+        b.WriteString(c.String() + "\n")
+    }
+    _, err := io.WriteString(w, b.String())
+    return err
+}
+
+// FindFirewall returns the firewall with the title, if it exists.
+func (c ComputeClient) FindFirewall(ctx context.Context, title string, projectId string) (*ServerFirewall, error) {
+    filter := &GetServerFirewallsQueryParamsFilter{Title: &title}
+    if len(projectId) > 0 {
+        filter.ProjectId = &projectId
+    }
+    firewalls, err := c.ListAllServerFirewalls(ctx, GetServerFirewallsQueryParams{Filter: filter}).All()
+    if err != nil {
+        return nil, err
+    }
+    for _, f := range firewalls {
+        if f.Title == title {
+            return &f, nil
+        }
+    }
+    return nil, nil
+}
+
+// PlanFirewallPolicy fetches the live state of the firewall of the policy and plans the
+// changes to it.
+func (c ComputeClient) PlanFirewallPolicy(ctx context.Context, policy FirewallPolicy) (FirewallPlan, error) {
+    if err := policy.Validate(); err != nil {
+        return FirewallPlan{}, err
+    }
+    firewall, err := c.FindFirewall(ctx, policy.Title, policy.ProjectId)
+    if err != nil || firewall == nil {
+        return PlanFirewall(policy, "", nil, nil), err
+    }
+    rules, err := c.ListAllServerFirewallRules(ctx, firewall.Id, GetServerFirewallRulesQueryParams{}).All()
+    if err != nil {
+        return FirewallPlan{}, err
+    }
+    members, err := c.ListAllServerFirewallMembers(ctx, firewall.Id, GetServerFirewallMembersQueryParams{}).All()
+    if err != nil {
+        return FirewallPlan{}, err
+    }
+    return PlanFirewall(policy, firewall.Id, rules, members), nil
+}
+
+// ApplyFirewallPlan creates the firewall if needed and executes the changes of the plan in
+// order, stopping at the first error. plan.FirewallId is set to the id of a created firewall.
+func (c ComputeClient) ApplyFirewallPlan(ctx context.Context, plan *FirewallPlan) error {
+    if len(plan.FirewallId) == 0 {
+        res, _, err := c.CreateServerFirewallWithContext(ctx, ServerFirewallCreateRequest{Title: plan.Policy.Title, ProjectId: plan.Policy.ProjectId})
+        if err != nil {
+            return fmt.Errorf("creating firewall %s: %w", plan.Policy.Title, err)
+        }
+        plan.FirewallId = res.Data.Id
+    }
+    for _, change := range plan.Changes {
+        if err := c.applyFirewallChange(ctx, plan.FirewallId, change); err != nil {
+            return fmt.Errorf("%s: %w", change, err)
+        }
+    }
+    return nil
+}
+
+func (c ComputeClient) applyFirewallChange(ctx context.Context, id string, change FirewallChange) error {
+    var err error
+    switch {
+        case change.Rule != nil && change.Action == FirewallChangeCreate:
+            r := change.Rule
+            in := ServerFirewallRuleCreateRequest{Type: r.Type, Protocol: r.Protocol}
+            if len(r.Ports) > 0 {
+                in.Ports = &r.Ports
+            }
+            if len(r.Addresses) > 0 {
+                in.Addresses = &r.Addresses
+            }
+            if len(r.Description) > 0 {
+                in.Description = &r.Description
+            }
+            _, _, err = c.CreateServerFirewallRuleWithContext(ctx, in, id)
+        case change.Rule != nil && change.Action == FirewallChangeUpdate:
+            _, _, err = c.UpdateServerFirewallRuleWithContext(ctx, ServerFirewallRuleUpdateRequest{Description: &change.Rule.Description}, id, change.Id)
+        case change.Rule != nil && change.Action == FirewallChangeDelete:
+            _, _, err = c.DeleteServerFirewallRuleWithContext(ctx, id, change.Id)
+        case change.Member != nil && change.Action == FirewallChangeCreate:
+            m := change.Member
+            in := ServerFirewallMemberCreateRequest{Type: ServerFirewallMemberTypeServer, ServerId: &m.ServerId}
+            if len(m.LabelName) > 0 {
+                in = ServerFirewallMemberCreateRequest{Type: ServerFirewallMemberTypeLabel, LabelName: &m.LabelName, LabelValue: m.LabelValue}
+            }
+            _, _, err = c.CreateServerFirewallMemberWithContext(ctx, in, id)
+        case change.Member != nil && change.Action == FirewallChangeDelete:
+            _, _, err = c.DeleteServerFirewallMemberWithContext(ctx, id, change.Id)
+    }
+    return err
+}
+
+func membersApplied(members []ServerFirewallMember) bool {
+    for _, m := range members {
+        if !m.Applied || m.Children != nil && !membersApplied(*m.Children) {
+            return false
+        }
+    }
+    return true
+}
+
+// WaitForFirewallApplied polls the firewall until all of its rules and members are applied.
+func (c ComputeClient) WaitForFirewallApplied(ctx context.Context, id string, opts WaitOptions) error {
+    opts = opts.withDefaults()
+    err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+        rules, err := c.ListAllServerFirewallRules(ctx, id, GetServerFirewallRulesQueryParams{}).All()
+        if err != nil {
+            return false, err
+        }
+        for _, r := range rules {
+            if !r.Applied {
+                return false, nil
+            }
+        }
+        members, err := c.ListAllServerFirewallMembers(ctx, id, GetServerFirewallMembersQueryParams{}).All()
+        if err != nil {
+            return false, err
+        }
+        return membersApplied(members), nil
+    })
+    if err != nil {
+        return fmt.Errorf("waiting for firewall %s to be applied: %w", id, err)
+    }
+    return nil
+}
+
+// FirewallReconcileOptions configures ReconcileFirewall.
+type FirewallReconcileOptions struct {
+    // DryRun only computes the plan without applying it.
+    DryRun bool
+    // NoWait returns after applying the plan without waiting for the changes to be applied
+    // to the servers.
+    NoWait bool
+    Wait WaitOptions
+}
+
+// ReconcileFirewall brings the firewall to the policy and waits until all rules and
+// members are applied. The plan is returned in any case.
+func (c ComputeClient) ReconcileFirewall(ctx context.Context, policy FirewallPolicy, opts FirewallReconcileOptions) (FirewallPlan, error) {
+    plan, err := c.PlanFirewallPolicy(ctx, policy)
+    if err != nil || opts.DryRun {
+        return plan, err
+    }
+    if err := c.ApplyFirewallPlan(ctx, &plan); err != nil {
+        return plan, err
+    }
+    if opts.NoWait {
+        return plan, nil
+    }
+    return plan, c.WaitForFirewallApplied(ctx, plan.FirewallId, opts.Wait)
+}
+
+// ExportFirewallPolicy returns the live state of the firewall with the title as policy.
+func (c ComputeClient) ExportFirewallPolicy(ctx context.Context, title string, projectId string) (FirewallPolicy, error) {
+    policy := FirewallPolicy{Title: title, ProjectId: projectId, Rules: []FirewallRule{}, Members: []FirewallMember{}}
+    firewall, err := c.FindFirewall(ctx, title, projectId)
+    if err != nil {
+        return policy, err
+    }
+    if firewall == nil {
+        return policy, fmt.Errorf("firewall %s not found", title)
+    }
+    rules, err := c.ListAllServerFirewallRules(ctx, firewall.Id, GetServerFirewallRulesQueryParams{}).All()
+    if err != nil {
+        return policy, err
+    }
+    for _, r := range rules {
+        policy.Rules = append(policy.Rules, FirewallRuleFromServer(r))
+    }
+    members, err := c.ListAllServerFirewallMembers(ctx, firewall.Id, GetServerFirewallMembersQueryParams{}).All()
+    if err != nil {
+        return policy, err
+    }
+    for _, m := range members {
+        policy.Members = append(policy.Members, FirewallMemberFromServer(m))
+    }
+    return policy, nil
+}
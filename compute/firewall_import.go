@@ -0,0 +1,584 @@
+package compute
+
+import (
+    "bufio"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net"
+    "strconv"
+    "strings"
+)
+
+// FirewallImportIssue describes a construct of an imported rule set that can not be
+// represented as firewall rule. Line is the line of the construct, or the index of the
+// rule for formats without lines.
+type FirewallImportIssue struct {
+    Line int `json:"line"`
+    Source string `json:"source"`
+    Reason string `json:"reason"`
+}
+
+func (i FirewallImportIssue) String() string {
+    return fmt.Sprintf("line %d: %s: %s", i.Line, i.Reason, i.Source)
+}
+
+// FirewallImport is the result of importing a rule set. Firewall rules only allow
+// traffic, so only accepting rules are converted, everything else is reported in Issues.
+type FirewallImport struct {
+    Rules []FirewallRule `json:"rules"`
+    Issues []FirewallImportIssue `json:"issues"`
+}
+
+func (im *FirewallImport) add(rule FirewallRule) {
+    for _, r := range im.Rules {
+        if r.key() == rule.key() {
+            return
+        }
+    }
+    im.Rules = append(im.Rules, rule)
+}
+
+func (im *FirewallImport) issue(line int, source string, format string, args ...interface{}) {
+    im.Issues = append(im.Issues, FirewallImportIssue{Line: line, Source: source, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Policy returns a policy with the imported rules and no members.
+func (im FirewallImport) Policy(title string) FirewallPolicy {
+    return FirewallPolicy{Title: title, Rules: im.Rules, Members: []FirewallMember{}}
+}
+
+func newFirewallImport() FirewallImport {
+    return FirewallImport{Rules: []FirewallRule{}, Issues: []FirewallImportIssue{}}
+}
+
+func parseFirewallProtocol(value string) (*ServerFirewallRuleProtocol, bool) {
+    switch strings.ToLower(value) {
+        case "", "all", "any", "-1":
+            return nil, true
+        case "tcp", "6":
+            p := ServerFirewallRuleProtocolTCP
+            return &p, true
+        case "udp", "17":
+            p := ServerFirewallRuleProtocolUDP
+            return &p, true
+        case "icmp", "1", "icmpv6", "ipv6-icmp", "58":
+            p := ServerFirewallRuleProtocolICMP
+            return &p, true
+    }
+    return nil, false
+}
+
+// formatPortRange formats ports as used in firewall rules, a single port or a range in
+// the form from-to.
+func formatPortRange(from int, to int) string {
+    if from == to {
+        return strconv.Itoa(from)
+    }
+    return strconv.Itoa(from) + "-" + strconv.Itoa(to)
+}
+
+// parsePorts parses a comma separated list of ports and ranges using : or - as separator.
+func parsePorts(value string) ([]string, error) {
+    ports := []string{}
+    for _, part := range strings.Split(value, ",") {
+        bounds := strings.FieldsFunc(part, func(r rune) bool { return r == ':' || r == '-' })
+        if len(bounds) < 1 || len(bounds) > 2 {
+            return nil, fmt.Errorf("invalid port %q", part)
+        }
+        from, err := strconv.Atoi(bounds[0])
+        if err != nil || from < 0 || from > 65535 {
+            return nil, fmt.Errorf("invalid port %q", part)
+        }
+        to := from
+        if len(bounds) == 2 {
+            if to, err = strconv.Atoi(bounds[1]); err != nil || to < from || to > 65535 {
+                return nil, fmt.Errorf("invalid port range %q", part)
+            }
+        }
+        ports = append(ports, formatPortRange(from, to))
+    }
+    return ports, nil
+}
+
+// normalizeAddress returns the address as CIDR, plain addresses get a host prefix.
+func normalizeAddress(value string) (string, error) {
+    if _, network, err := net.ParseCIDR(value); err == nil {
+        return network.String(), nil
+    }
+    ip := net.ParseIP(value)
+    if ip == nil {
+        return "", fmt.Errorf("invalid address %q", value)
+    }
+    if ip.To4() != nil {
+        return ip.String() + "/32", nil
+    }
+    return ip.String() + "/128", nil
+}
+
+func isAnyAddress(address string) bool {
+    return address == "0.0.0.0/0" || address == "::/0"
+}
+
+// ImportIptablesSave converts the filter table of iptables-save or ip6tables-save output.
+// Accepting rules of the INPUT and OUTPUT chains, and of custom chains jumped to from them
+// without further matches, become ingress and egress rules.
+func ImportIptablesSave(r io.Reader) (FirewallImport, error) {
+    im := newFirewallImport()
+    type iptablesRule struct {
+        line int
+        text string
+        chain string
+        args []string
+    }
+    rules := []iptablesRule{}
+    table := ""
+    scanner := bufio.NewScanner(r)
+    line := 0
+    for scanner.Scan() {
+        line++
+        text := strings.TrimSpace(scanner.Text())
+        switch {
+            case len(text) == 0 || strings.HasPrefix(text, "#") || text == "COMMIT":
+            case strings.HasPrefix(text, "*"):
+                table = text[1:]
+            case strings.HasPrefix(text, ":"):
+                fields := strings.Fields(text[1:])
+                if table == "filter" && len(fields) >= 2 && (fields[0] == "INPUT" || fields[0] == "OUTPUT") && fields[1] == "ACCEPT" {
+                    im.issue(line, text, "chain policy ACCEPT can not be represented, only traffic matching the imported rules is allowed")
+                }
+            case strings.HasPrefix(text, "-A "):
+                if table != "filter" {
+                    im.issue(line, text, "rules of the %s table are not supported", table)
+                    continue
+                }
+                args, err := splitShellWords(text)
+                if err != nil {
+                    return im, fmt.Errorf("line %d: %v", line, err)
+                }
+                rules = append(rules, iptablesRule{line: line, text: text, chain: args[1], args: args[2:]})
+            default:
+                im.issue(line, text, "unknown statement")
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        return im, err
+    }
+
+    directions := map[string]ServerFirewallRuleType{"INPUT": ServerFirewallRuleTypeIngress, "OUTPUT": ServerFirewallRuleTypeEgress}
+    // Resolve custom chains, which may jump to further custom chains.
+    for changed := true; changed; {
+        changed = false
+        for _, rule := range rules {
+            direction, ok := directions[rule.chain]
+            if !ok || len(rule.args) != 2 || rule.args[0] != "-j" {
+                continue
+            }
+            if _, known := directions[rule.args[1]]; !known && !isIptablesTarget(rule.args[1]) {
+                directions[rule.args[1]] = direction
+                changed = true
+            }
+        }
+    }
+
+    for _, rule := range rules {
+        direction, ok := directions[rule.chain]
+        if !ok {
+            im.issue(rule.line, rule.text, "rules of chain %s are not supported", rule.chain)
+            continue
+        }
+        converted, reason := convertIptablesRule(direction, rule.args)
+        if len(reason) > 0 {
+            im.issue(rule.line, rule.text, "%s", reason)
+            continue
+        }
+        if converted != nil {
+            im.add(*converted)
+        }
+    }
+    return im, nil
+}
+
+func isIptablesTarget(target string) bool {
+    switch target {
+        case "ACCEPT", "DROP", "REJECT", "RETURN", "LOG", "QUEUE", "NFQUEUE", "MARK", "CONNMARK":
+            return true
+    }
+    return false
+}
+
+// convertIptablesRule converts the arguments of a rule. It returns nil without reason for
+// rules that need no conversion, like jumps to custom chains or a final drop.
+func convertIptablesRule(direction ServerFirewallRuleType, args []string) (*FirewallRule, string) {
+    rule := FirewallRule{Type: direction}
+    target := ""
+    matches := 0
+    addresses := []string{}
+    for i := 0; i < len(args); i++ {
+        arg := args[i]
+        value := func() string {
+            if i+1 < len(args) {
+                i++
+                return args[i]
+            }
+            return ""
+        }
+        switch arg {
+            case "!":
+                return nil, "negated matches are not supported"
+            case "-j", "--jump":
+                target = value()
+            case "-p", "--protocol":
+                matches++
+                p := value()
+                protocol, ok := parseFirewallProtocol(p)
+                if !ok {
+                    return nil, fmt.Sprintf("protocol %s is not supported", p)
+                }
+                rule.Protocol = protocol
+            case "-s", "--source", "-d", "--destination":
+                matches++
+                v := value()
+                isSource := arg == "-s" || arg == "--source"
+                if isSource != (direction == ServerFirewallRuleTypeIngress) {
+                    return nil, "matching the address of the server itself is not supported"
+                }
+                for _, a := range strings.Split(v, ",") {
+                    address, err := normalizeAddress(a)
+                    if err != nil {
+                        return nil, err.Error()
+                    }
+                    if !isAnyAddress(address) {
+                        addresses = append(addresses, address)
+                    }
+                }
+            case "--dport", "--destination-port", "--dports", "--destination-ports":
+                matches++
+                ports, err := parsePorts(value())
+                if err != nil {
+                    return nil, err.Error()
+                }
+                rule.Ports = append(rule.Ports, ports...)
+            case "-m", "--match":
+                v := value()
+                if v != "tcp" && v != "udp" && v != "multiport" && v != "comment" && v != "icmp" && v != "icmp6" {
+                    return nil, fmt.Sprintf("match extension %s is not supported", v)
+                }
+            case "--comment":
+                rule.Description = value()
+            case "-i", "--in-interface", "-o", "--out-interface":
+                return nil, "interface matches are not supported"
+            case "--sport", "--source-port", "--sports", "--source-ports":
+                return nil, "source port matches are not supported"
+            case "--icmp-type", "--icmpv6-type":
+                return nil, "ICMP type matches are not supported"
+            default:
+                return nil, fmt.Sprintf("option %s is not supported", arg)
+        }
+    }
+    rule.Addresses = addresses
+
+    switch target {
+        case "ACCEPT":
+            if len(rule.Ports) > 0 && rule.Protocol == nil {
+                return nil, "ports without protocol"
+            }
+            return &rule, ""
+        case "DROP", "REJECT":
+            if matches == 0 {
+                // Traffic that is not allowed is dropped anyways.
+                return nil, ""
+            }
+            return nil, "only accepting rules can be represented"
+        case "":
+            return nil, "rule without target"
+    }
+    if !isIptablesTarget(target) && matches == 0 {
+        return nil, ""
+    }
+    return nil, fmt.Sprintf("target %s is not supported", target)
+}
+
+// splitShellWords splits a line into words, honoring double quotes as used by
+// iptables-save for comments.
+func splitShellWords(line string) ([]string, error) {
+    words := []string{}
+    b := strings.Builder{}
+    inWord := false
+    inQuote := false
+    for i := 0; i < len(line); i++ {
+        c := line[i]
+        switch {
+            case c == '\\' && inQuote && i+1 < len(line):
+                i++
+                b.WriteByte(line[i])
+            case c == '"':
+                inQuote = !inQuote
+                inWord = true
+            case (c == ' ' || c == '\t') && !inQuote:
+                if inWord {
+                    words = append(words, b.String())
+                    b.Reset()
+                    inWord = false
+                }
+            default:
+                b.WriteByte(c)
+                inWord = true
+        }
+    }
+    if inQuote {
+        return nil, fmt.Errorf("unterminated quote")
+    }
+    if inWord {
+        words = append(words, b.String())
+    }
+    return words, nil
+}
+
+// ImportNftablesJSON converts the output of nft -j list ruleset. Accepting rules of base
+// chains with the input and output hooks become ingress and egress rules. Issues refer to
+// rules by their index in the nftables array.
+func ImportNftablesJSON(r io.Reader) (FirewallImport, error) {
+    im := newFirewallImport()
+    doc := struct {
+        Nftables []map[string]json.RawMessage `json:"nftables"`
+    }{}
+    if err := json.NewDecoder(r).Decode(&doc); err != nil {
+        return im, err
+    }
+
+    type nftChain struct {
+        Family string `json:"family"`
+        Table string `json:"table"`
+        Name string `json:"name"`
+        Hook string `json:"hook"`
+        Policy string `json:"policy"`
+    }
+    type nftRule struct {
+        Family string `json:"family"`
+        Table string `json:"table"`
+        Chain string `json:"chain"`
+        Comment string `json:"comment"`
+        Expr []map[string]json.RawMessage `json:"expr"`
+    }
+    directions := map[string]ServerFirewallRuleType{}
+    for i, obj := range doc.Nftables {
+        if raw, ok := obj["chain"]; ok {
+            chain := nftChain{}
+            if err := json.Unmarshal(raw, &chain); err != nil {
+                return im, fmt.Errorf("object %d: %v", i, err)
+            }
+            key := chain.Family + " " + chain.Table + " " + chain.Name
+            switch chain.Hook {
+                case "input":
+                    directions[key] = ServerFirewallRuleTypeIngress
+                case "output":
+                    directions[key] = ServerFirewallRuleTypeEgress
+            }
+            if (chain.Hook == "input" || chain.Hook == "output") && chain.Policy == "accept" {
+                im.issue(i, "chain "+chain.Name, "chain policy accept can not be represented, only traffic matching the imported rules is allowed")
+            }
+        }
+    }
+
+    for i, obj := range doc.Nftables {
+        raw, ok := obj["rule"]
+        if !ok {
+            continue
+        }
+        rule := nftRule{}
+        if err := json.Unmarshal(raw, &rule); err != nil {
+            return im, fmt.Errorf("object %d: %v", i, err)
+        }
+        source := fmt.Sprintf("%s %s %s rule", rule.Family, rule.Table, rule.Chain)
+        direction, ok := directions[rule.Family+" "+rule.Table+" "+rule.Chain]
+        if !ok {
+            im.issue(i, source, "rules of chain %s are not supported", rule.Chain)
+            continue
+        }
+        converted, reason := convertNftRule(direction, rule.Expr)
+        if len(reason) > 0 {
+            im.issue(i, source, "%s", reason)
+            continue
+        }
+        if converted != nil {
+            if len(converted.Description) == 0 {
+                converted.Description = rule.Comment
+            }
+            im.add(*converted)
+        }
+    }
+    return im, nil
+}
+
+func convertNftRule(direction ServerFirewallRuleType, exprs []map[string]json.RawMessage) (*FirewallRule, string) {
+    rule := FirewallRule{Type: direction}
+    verdict := ""
+    matches := 0
+    for _, expr := range exprs {
+        for kind, raw := range expr {
+            switch kind {
+                case "counter":
+                case "accept", "drop", "reject", "return":
+                    verdict = kind
+                case "jump", "goto":
+                    return nil, kind + " to other chains is not supported"
+                case "log":
+                    return nil, "log statements are not supported"
+                case "match":
+                    matches++
+                    if reason := applyNftMatch(&rule, raw); len(reason) > 0 {
+                        return nil, reason
+                    }
+                default:
+                    return nil, fmt.Sprintf("expression %s is not supported", kind)
+            }
+        }
+    }
+
+    switch verdict {
+        case "accept":
+            if len(rule.Ports) > 0 && rule.Protocol == nil {
+                return nil, "ports without protocol"
+            }
+            return &rule, ""
+        case "drop", "reject":
+            if matches == 0 {
+                return nil, ""
+            }
+            return nil, "only accepting rules can be represented"
+    }
+    return nil, "rule without accept verdict"
+}
+
+func applyNftMatch(rule *FirewallRule, raw json.RawMessage) string {
+    m := struct {
+        Op string `json:"op"`
+        Left struct {
+            Payload *struct {
+                Protocol string `json:"protocol"`
+                Field string `json:"field"`
+            } `json:"payload"`
+            Meta *struct {
+                Key string `json:"key"`
+            } `json:"meta"`
+            Ct *struct {
+                Key string `json:"key"`
+            } `json:"ct"`
+        } `json:"left"`
+        Right json.RawMessage `json:"right"`
+    }{}
+    if err := json.Unmarshal(raw, &m); err != nil {
+        return err.Error()
+    }
+    if m.Op != "==" && m.Op != "in" && m.Op != "" {
+        return fmt.Sprintf("match operator %s is not supported", m.Op)
+    }
+    values, err := nftValues(m.Right)
+    if err != nil {
+        return err.Error()
+    }
+
+    switch {
+        case m.Left.Meta != nil && (m.Left.Meta.Key == "l4proto" || m.Left.Meta.Key == "nfproto"):
+            if m.Left.Meta.Key == "nfproto" {
+                return ""
+            }
+            if len(values) != 1 {
+                return "matching multiple protocols is not supported"
+            }
+            protocol, ok := parseFirewallProtocol(values[0])
+            if !ok {
+                return fmt.Sprintf("protocol %s is not supported", values[0])
+            }
+            rule.Protocol = protocol
+        case m.Left.Meta != nil:
+            return fmt.Sprintf("meta %s matches are not supported", m.Left.Meta.Key)
+        case m.Left.Ct != nil:
+            return fmt.Sprintf("conntrack %s matches are not supported", m.Left.Ct.Key)
+        case m.Left.Payload != nil:
+            p := m.Left.Payload
+            switch p.Field {
+                case "dport":
+                    protocol, ok := parseFirewallProtocol(p.Protocol)
+                    if !ok || protocol == nil {
+                        return fmt.Sprintf("protocol %s is not supported", p.Protocol)
+                    }
+                    rule.Protocol = protocol
+                    ports, err := parsePorts(strings.Join(values, ","))
+                    if err != nil {
+                        return err.Error()
+                    }
+                    rule.Ports = append(rule.Ports, ports...)
+                case "saddr", "daddr":
+                    if (p.Field == "saddr") != (rule.Type == ServerFirewallRuleTypeIngress) {
+                        return "matching the address of the server itself is not supported"
+                    }
+                    for _, v := range values {
+                        address, err := normalizeAddress(v)
+                        if err != nil {
+                            return err.Error()
+                        }
+                        if !isAnyAddress(address) {
+                            rule.Addresses = append(rule.Addresses, address)
+                        }
+                    }
+                case "sport":
+                    return "source port matches are not supported"
+                case "type":
+                    return "ICMP type matches are not supported"
+                default:
+                    return fmt.Sprintf("%s %s matches are not supported", p.Protocol, p.Field)
+            }
+        default:
+            return "unsupported match"
+    }
+    return ""
+}
+
+// nftValues flattens the right hand side of a match into strings. Ranges are returned as
+// from-to and prefixes as CIDR.
+func nftValues(raw json.RawMessage) ([]string, error) {
+    var v interface{}
+    if err := json.Unmarshal(raw, &v); err != nil {
+        return nil, err
+    }
+    var flatten func(v interface{}) ([]string, error)
+    flatten = func(v interface{}) ([]string, error) {
+        switch t := v.(type) {
+            case string:
+                return []string{t}, nil
+            case float64:
+                return []string{strconv.Itoa(int(t))}, nil
+            case []interface{}:
+                values := []string{}
+                for _, e := range t {
+                    sub, err := flatten(e)
+                    if err != nil {
+                        return nil, err
+                    }
+                    values = append(values, sub...)
+                }
+                return values, nil
+            case map[string]interface{}:
+                if set, ok := t["set"]; ok {
+                    return flatten(set)
+                }
+                if r, ok := t["range"].([]interface{}); ok && len(r) == 2 {
+                    from, errFrom := flatten(r[0])
+                    to, errTo := flatten(r[1])
+                    if errFrom != nil || errTo != nil || len(from) != 1 || len(to) != 1 {
+                        return nil, fmt.Errorf("invalid range")
+                    }
+                    return []string{from[0] + "-" + to[0]}, nil
+                }
+                if prefix, ok := t["prefix"].(map[string]interface{}); ok {
+                    addr, _ := prefix["addr"].(string)
+                    length, _ := prefix["len"].(float64)
+                    return []string{addr + "/" + strconv.Itoa(int(length))}, nil
+                }
+        }
+        return nil, fmt.Errorf("unsupported value %v", v)
+    }
+    return flatten(v)
+}
@@ -0,0 +1,200 @@
+package compute_test
+
+import (
+    "reflect"
+    "strings"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+)
+
+// formatRules renders rules as "<type> <protocol> <ports> <addresses>" for comparisons.
+func formatRules(rules []compute.FirewallRule) []string {
+    lines := []string{}
+    for _, r := range rules {
+        protocol := "all"
+        if r.Protocol != nil {
+            protocol = strings.ToLower(string(*r.Protocol))
+        }
+        lines = append(lines, strings.Join([]string{string(r.Type), protocol, strings.Join(r.Ports, ","), strings.Join(r.Addresses, ",")}, " "))
+    }
+    return lines
+}
+
+func issueReasons(im compute.FirewallImport) []string {
+    reasons := []string{}
+    for _, issue := range im.Issues {
+        reasons = append(reasons, issue.Reason)
+    }
+    return reasons
+}
+
+func checkImport(t *testing.T, im compute.FirewallImport, err error, rules []string, reasons []string) {
+    t.Helper()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if got := formatRules(im.Rules); !reflect.DeepEqual(got, rules) {
+        t.Errorf("got rules\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(rules, "\n"))
+    }
+    got := issueReasons(im)
+    if len(got) != len(reasons) {
+        t.Fatalf("got issues %q, want %d", got, len(reasons))
+    }
+    for i, reason := range reasons {
+        if !strings.Contains(got[i], reason) {
+            t.Errorf("issue %d: got %q, want %q", i, got[i], reason)
+        }
+    }
+}
+
+func TestImportIptablesSave(t *testing.T) {
+    input := `# Generated by iptables-save
+*filter
+:INPUT DROP [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT ACCEPT [0:0]
+:web - [0:0]
+-A INPUT -p tcp -m tcp --dport 22 -s 10.0.0.0/8 -m comment --comment "ssh from vpn" -j ACCEPT
+-A INPUT -j web
+-A web -p tcp -m multiport --dports 80,443 -j ACCEPT
+-A INPUT -p udp --sport 53 -j ACCEPT
+-A INPUT -i lo -j ACCEPT
+-A INPUT -s 192.0.2.1 -j DROP
+-A INPUT -j DROP
+-A OUTPUT -p icmp -j ACCEPT
+-A FORWARD -j ACCEPT
+COMMIT
+*nat
+-A PREROUTING -p tcp --dport 8080 -j REDIRECT --to-ports 80
+COMMIT
+`
+    im, err := compute.ImportIptablesSave(strings.NewReader(input))
+    checkImport(t, im, err, []string{
+        "INGRESS tcp 22 10.0.0.0/8",
+        "INGRESS tcp 80,443 ",
+        "EGRESS icmp  ",
+    }, []string{
+        "chain policy ACCEPT",
+        "rules of the nat table",
+        "source port matches",
+        "interface matches",
+        "only accepting rules",
+        "rules of chain FORWARD",
+    })
+    if im.Rules[0].Description != "ssh from vpn" {
+        t.Errorf("comment not imported: %q", im.Rules[0].Description)
+    }
+}
+
+func TestImportNftablesJSON(t *testing.T) {
+    input := `{"nftables": [
+        {"chain": {"family": "inet", "table": "filter", "name": "input", "hook": "input", "policy": "drop"}},
+        {"chain": {"family": "inet", "table": "filter", "name": "output", "hook": "output", "policy": "accept"}},
+        {"rule": {"family": "inet", "table": "filter", "chain": "input", "comment": "web", "expr": [
+            {"match": {"op": "==", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": {"set": [80, 443]}}},
+            {"counter": {"packets": 0, "bytes": 0}},
+            {"accept": null}
+        ]}},
+        {"rule": {"family": "inet", "table": "filter", "chain": "input", "expr": [
+            {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": {"prefix": {"addr": "10.0.0.0", "len": 8}}}},
+            {"match": {"op": "==", "left": {"payload": {"protocol": "udp", "field": "dport"}}, "right": {"range": [60000, 61000]}}},
+            {"accept": null}
+        ]}},
+        {"rule": {"family": "inet", "table": "filter", "chain": "input", "expr": [
+            {"match": {"op": "==", "left": {"ct": {"key": "state"}}, "right": ["established", "related"]}},
+            {"accept": null}
+        ]}},
+        {"rule": {"family": "inet", "table": "filter", "chain": "input", "expr": [
+            {"match": {"op": "!=", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": 22}},
+            {"drop": null}
+        ]}},
+        {"rule": {"family": "inet", "table": "filter", "chain": "input", "expr": [{"drop": null}]}}
+    ]}`
+    im, err := compute.ImportNftablesJSON(strings.NewReader(input))
+    checkImport(t, im, err, []string{
+        "INGRESS tcp 80,443 ",
+        "INGRESS udp 60000-61000 10.0.0.0/8",
+    }, []string{
+        "chain policy accept",
+        "conntrack state matches",
+        "match operator != is not supported",
+    })
+    if im.Rules[0].Description != "web" {
+        t.Errorf("comment not imported: %q", im.Rules[0].Description)
+    }
+}
+
+func TestImportSecurityGroup(t *testing.T) {
+    tests := []struct {
+        name string
+        input string
+        rules []string
+        reasons []string
+    }{
+        {
+            "yaml",
+            `name: web
+ingress:
+  - protocol: tcp
+    ports: [80, 443]
+    cidrs: [0.0.0.0/0]
+    description: "http: plain and tls"
+  - protocol: tcp
+    from_port: 8000
+    to_port: 8100
+    cidr: 10.0.0.0/8 # internal
+egress:
+  - protocol: all
+`,
+            []string{"INGRESS tcp 80,443 ", "INGRESS tcp 8000-8100 10.0.0.0/8", "EGRESS all  "},
+            nil,
+        },
+        {
+            "terraform egress",
+            `{"egress": [{"protocol": "-1", "from_port": 0, "to_port": 0, "cidr_blocks": ["0.0.0.0/0"]}]}`,
+            []string{"EGRESS all  "},
+            nil,
+        },
+        {
+            "all protocols full range",
+            `{"egress": [{"protocol": "-1", "from_port": 0, "to_port": 65535}]}`,
+            []string{"EGRESS all  "},
+            nil,
+        },
+        {
+            "all protocols with port",
+            `{"egress": [{"protocol": "-1", "from_port": 443, "to_port": 443}]}`,
+            []string{},
+            []string{"ports require the TCP or UDP protocol"},
+        },
+        {
+            "openstack",
+            `{"rules": [
+                {"direction": "ingress", "ethertype": "IPv4", "protocol": "tcp", "port_range_min": 22, "port_range_max": 22, "remote_ip_prefix": "192.0.2.0/24"},
+                {"direction": "ingress", "protocol": "tcp", "remote_group_id": "abc"},
+                {"protocol": "udp", "port": 53}
+            ]}`,
+            []string{"INGRESS tcp 22 192.0.2.0/24"},
+            []string{"references to other security groups", "rule without direction"},
+        },
+        {
+            "unsupported fields",
+            `{"vpc_id": "vpc-1", "ingress": [{"protocol": "icmp", "icmp_type": 8}, "tcp"]}`,
+            []string{},
+            []string{"ICMP type matches", "rule must be an object", "field vpc_id is not supported"},
+        },
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            im, err := compute.ImportSecurityGroup(strings.NewReader(tt.input))
+            checkImport(t, im, err, tt.rules, tt.reasons)
+        })
+    }
+
+    if _, err := compute.ImportSecurityGroup(strings.NewReader("- just\n- a list\n")); err == nil {
+        t.Errorf("list accepted as security group")
+    }
+    if _, err := compute.ImportSecurityGroup(strings.NewReader("ingress: [\n")); err == nil {
+        t.Errorf("invalid yaml accepted")
+    }
+}
@@ -0,0 +1,205 @@
+package compute
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "sort"
+    "strconv"
+    "strings"
+    "gopkg.in/yaml.v3"
+)
+
+// ImportSecurityGroup converts a security group in JSON or YAML. The group is an object
+// with a list of rules, either in rules with a direction per rule or in ingress and egress:
+//
+//  name: web
+//  ingress:
+//    - protocol: tcp
+//      ports: [80, 443]
+//      cidrs: [0.0.0.0/0]
+//      description: http
+//  egress:
+//    - protocol: all
+//
+// Rules support the fields direction, protocol, port, ports, from_port, to_port, cidr,
+// cidrs, description and action. Common aliases like ip_protocol, cidr_blocks,
+// remote_ip_prefix and port_range_min are accepted as well. Issues refer to rules by
+// their position in the list named in the source.
+func ImportSecurityGroup(r io.Reader) (FirewallImport, error) {
+    im := newFirewallImport()
+    data, err := ioutil.ReadAll(r)
+    if err != nil {
+        return im, err
+    }
+    // JSON is valid YAML, so both are decoded by the YAML parser.
+    var doc interface{}
+    if err := yaml.Unmarshal(data, &doc); err != nil {
+        return im, err
+    }
+    group, ok := doc.(map[string]interface{})
+    if !ok {
+        return im, fmt.Errorf("security group must be an object")
+    }
+
+    keys := make([]string, 0, len(group))
+    for k := range group {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    for _, key := range []string{"rules", "ingress", "egress"} {
+        value, ok := group[key]
+        if !ok || value == nil {
+            continue
+        }
+        list, ok := value.([]interface{})
+        if !ok {
+            return im, fmt.Errorf("%s must be a list", key)
+        }
+        direction := ServerFirewallRuleType("")
+        switch key {
+            case "ingress":
+                direction = ServerFirewallRuleTypeIngress
+            case "egress":
+                direction = ServerFirewallRuleTypeEgress
+        }
+        for i, item := range list {
+            index := i + 1
+            encoded, _ := json.Marshal(item)
+            source := key + " " + string(encoded)
+            fields, ok := item.(map[string]interface{})
+            if !ok {
+                im.issue(index, source, "rule must be an object")
+                continue
+            }
+            rule, reason := convertSecurityGroupRule(direction, fields)
+            if len(reason) > 0 {
+                im.issue(index, source, "%s", reason)
+                continue
+            }
+            im.add(rule)
+        }
+    }
+    for _, key := range keys {
+        switch key {
+            case "rules", "ingress", "egress", "name", "id", "description":
+            default:
+                im.issue(0, key, "field %s is not supported", key)
+        }
+    }
+    return im, nil
+}
+
+func convertSecurityGroupRule(direction ServerFirewallRuleType, fields map[string]interface{}) (FirewallRule, string) {
+    rule := FirewallRule{Type: direction}
+    keys := make([]string, 0, len(fields))
+    for k := range fields {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    fromPort, toPort := "", ""
+    for _, key := range keys {
+        value := fields[key]
+        switch strings.ToLower(key) {
+            case "direction", "type":
+                switch strings.ToLower(scalarString(value)) {
+                    case "ingress", "in", "inbound":
+                        rule.Type = ServerFirewallRuleTypeIngress
+                    case "egress", "out", "outbound":
+                        rule.Type = ServerFirewallRuleTypeEgress
+                    default:
+                        return rule, fmt.Sprintf("direction %v is not supported", value)
+                }
+            case "protocol", "ip_protocol":
+                protocol, ok := parseFirewallProtocol(scalarString(value))
+                if !ok {
+                    return rule, fmt.Sprintf("protocol %v is not supported", value)
+                }
+                rule.Protocol = protocol
+            case "port", "ports":
+                ports, err := parsePorts(strings.Join(scalarList(value), ","))
+                if err != nil {
+                    return rule, err.Error()
+                }
+                rule.Ports = append(rule.Ports, ports...)
+            case "from_port", "port_range_min":
+                fromPort = scalarString(value)
+            case "to_port", "port_range_max":
+                toPort = scalarString(value)
+            case "cidr", "cidrs", "cidr_blocks", "ipv6_cidr_blocks", "remote_ip_prefix", "addresses", "sources", "destinations":
+                for _, a := range scalarList(value) {
+                    address, err := normalizeAddress(a)
+                    if err != nil {
+                        return rule, err.Error()
+                    }
+                    if !isAnyAddress(address) {
+                        rule.Addresses = append(rule.Addresses, address)
+                    }
+                }
+            case "description", "comment":
+                rule.Description = scalarString(value)
+            case "action":
+                action := strings.ToLower(scalarString(value))
+                if action != "allow" && action != "accept" {
+                    return rule, "only allowing rules can be represented"
+                }
+            case "ethertype":
+            case "source_security_group", "source_security_group_id", "remote_group_id", "security_groups":
+                return rule, "references to other security groups are not supported"
+            case "icmp_type", "icmp_code":
+                return rule, "ICMP type matches are not supported"
+            default:
+                return rule, fmt.Sprintf("field %s is not supported", key)
+        }
+    }
+    if len(rule.Type) == 0 {
+        return rule, "rule without direction"
+    }
+    if len(fromPort) > 0 || len(toPort) > 0 {
+        if len(fromPort) == 0 {
+            fromPort = toPort
+        }
+        if len(toPort) == 0 {
+            toPort = fromPort
+        }
+        // -1 is used for all ports, mostly with ICMP. Rules for all protocols, like the
+        // egress rule created by Terraform, come with the range 0-0 or 0-65535.
+        allPorts := fromPort == "-1" || rule.Protocol == nil && fromPort == "0" && (toPort == "0" || toPort == "65535")
+        if !allPorts {
+            ports, err := parsePorts(fromPort + "-" + toPort)
+            if err != nil {
+                return rule, err.Error()
+            }
+            rule.Ports = append(rule.Ports, ports...)
+        }
+    }
+    if len(rule.Ports) > 0 && (rule.Protocol == nil || *rule.Protocol == ServerFirewallRuleProtocolICMP) {
+        return rule, "ports require the TCP or UDP protocol"
+    }
+    return rule, ""
+}
+
+func scalarString(v interface{}) string {
+    switch t := v.(type) {
+        case string:
+            return t
+        case float64:
+            return strconv.FormatFloat(t, 'f', -1, 64)
+        case nil:
+            return ""
+    }
+    return fmt.Sprint(v)
+}
+
+func scalarList(v interface{}) []string {
+    list, ok := v.([]interface{})
+    if !ok {
+        return strings.Split(scalarString(v), ",")
+    }
+    values := []string{}
+    for _, e := range list {
+        values = append(values, scalarString(e))
+    }
+    return values
+}
@@ -0,0 +1,333 @@
+package compute
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// FirewallSnapshot is the state of all firewalls and servers of a project, which can be
+// stored as JSON and evaluated offline.
+type FirewallSnapshot struct {
+    Firewalls []FirewallSnapshotEntry `json:"firewalls"`
+    Servers []Server `json:"servers"`
+}
+
+type FirewallSnapshotEntry struct {
+    Firewall ServerFirewall `json:"firewall"`
+    Rules []ServerFirewallRule `json:"rules"`
+    Members []ServerFirewallMember `json:"members"`
+}
+
+// FirewallQuery describes traffic to evaluate. Address is the remote address or network,
+// an empty address stands for traffic from or to anywhere. Port is ignored for ICMP.
+type FirewallQuery struct {
+    ServerId string
+    Direction ServerFirewallRuleType
+    Protocol ServerFirewallRuleProtocol
+    Port int
+    Address string
+}
+
+type FirewallRuleMatch struct {
+    Firewall ServerFirewall `json:"firewall"`
+    Rule ServerFirewallRule `json:"rule"`
+}
+
+// FirewallVerdict is the result of a query. Servers without any firewall are not
+// filtered, otherwise traffic is allowed if at least one rule of an applying firewall
+// matches.
+type FirewallVerdict struct {
+    Allowed bool `json:"allowed"`
+    Firewalls []ServerFirewall `json:"firewalls"`
+    Matches []FirewallRuleMatch `json:"matches"`
+}
+
+type EffectiveFirewallRule struct {
+    FirewallId string `json:"firewall_id"`
+    FirewallTitle string `json:"firewall_title"`
+    Rule FirewallRule `json:"rule"`
+    Applied bool `json:"applied"`
+}
+
+// ServerEffectivePolicy lists all rules that apply to a server through its firewalls.
+type ServerEffectivePolicy struct {
+    ServerId string `json:"server_id"`
+    ServerName string `json:"server_name"`
+    Firewalls []ServerFirewall `json:"firewalls"`
+    Rules []EffectiveFirewallRule `json:"rules"`
+}
+
+// LoadFirewallSnapshot fetches all firewalls with their rules and members and all servers
+// with their labels. An empty project id loads everything accessible.
+func (c ComputeClient) LoadFirewallSnapshot(ctx context.Context, projectId string) (FirewallSnapshot, error) {
+    snapshot := FirewallSnapshot{Firewalls: []FirewallSnapshotEntry{}}
+    firewallParams := GetServerFirewallsQueryParams{}
+    withLabels := true
+    serverParams := GetServersQueryParams{WithLabels: &withLabels}
+    if len(projectId) > 0 {
+        firewallParams.Filter = &GetServerFirewallsQueryParamsFilter{ProjectId: &projectId}
+        serverParams.Filter = &GetServersQueryParamsFilter{ProjectId: &projectId}
+    }
+    firewalls, err := c.ListAllServerFirewalls(ctx, firewallParams).All()
+    if err != nil {
+        return snapshot, err
+    }
+    for _, f := range firewalls {
+        rules, err := c.ListAllServerFirewallRules(ctx, f.Id, GetServerFirewallRulesQueryParams{}).All()
+        if err != nil {
+            return snapshot, err
+        }
+        members, err := c.ListAllServerFirewallMembers(ctx, f.Id, GetServerFirewallMembersQueryParams{}).All()
+        if err != nil {
+            return snapshot, err
+        }
+        snapshot.Firewalls = append(snapshot.Firewalls, FirewallSnapshotEntry{Firewall: f, Rules: rules, Members: members})
+    }
+    snapshot.Servers, err = c.ListAllServers(ctx, serverParams).All()
+    return snapshot, err
+}
+
+// ReadFirewallSnapshot reads a snapshot from its JSON representation.
+func ReadFirewallSnapshot(r io.Reader) (FirewallSnapshot, error) {
+    snapshot := FirewallSnapshot{}
+    err := json.NewDecoder(r).Decode(&snapshot)
+    return snapshot, err
+}
+
+func (s FirewallSnapshot) WriteJSON(w io.Writer) error {
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(s)
+}
+
+// FindServer returns the server with the id, or with the name if no id matches.
+func (s FirewallSnapshot) FindServer(idOrName string) (*Server, error) {
+    var found *Server
+    for i := range s.Servers {
+        if s.Servers[i].Id == idOrName {
+            return &s.Servers[i], nil
+        }
+        if s.Servers[i].Name == idOrName {
+            if found != nil {
+                return nil, fmt.Errorf("server name %s is ambiguous", idOrName)
+            }
+            found = &s.Servers[i]
+        }
+    }
+    if found == nil {
+        return nil, fmt.Errorf("server %s not found", idOrName)
+    }
+    return found, nil
+}
+
+// MemberMatchesServer reports whether a firewall member includes the server. Label members
+// without value match every value of the label. Nested children further restrict a
+// member, at least one of them has to match as well.
+func MemberMatchesServer(m ServerFirewallMember, server Server) bool {
+    matches := false
+    switch {
+        case m.Type == ServerFirewallMemberTypeServer || (len(m.Type) == 0 && m.ServerId != nil):
+            matches = m.ServerId != nil && *m.ServerId == server.Id
+        case m.LabelName != nil:
+            value, ok := server.Labels[*m.LabelName]
+            matches = ok && (m.LabelValue == nil || (value != nil && *value == *m.LabelValue))
+    }
+    if !matches || m.Children == nil || len(*m.Children) == 0 {
+        return matches
+    }
+    for _, child := range *m.Children {
+        if MemberMatchesServer(child, server) {
+            return true
+        }
+    }
+    return false
+}
+
+// FirewallsOf returns the firewalls that apply to the server.
+func (s FirewallSnapshot) FirewallsOf(server Server) []FirewallSnapshotEntry {
+    entries := []FirewallSnapshotEntry{}
+    for _, e := range s.Firewalls {
+        if e.Firewall.ProjectId != "" && server.ProjectId != "" && e.Firewall.ProjectId != server.ProjectId {
+            continue
+        }
+        for _, m := range e.Members {
+            if MemberMatchesServer(m, server) {
+                entries = append(entries, e)
+                break
+            }
+        }
+    }
+    return entries
+}
+
+// Check evaluates the query against the firewalls of the server.
+func (s FirewallSnapshot) Check(q FirewallQuery) (FirewallVerdict, error) {
+    verdict := FirewallVerdict{Firewalls: []ServerFirewall{}, Matches: []FirewallRuleMatch{}}
+    if len(q.Direction) == 0 {
+        q.Direction = ServerFirewallRuleTypeIngress
+    }
+    if !q.Direction.IsValid() {
+        return verdict, fmt.Errorf("invalid direction %q", q.Direction)
+    }
+    if !q.Protocol.IsValid() {
+        return verdict, fmt.Errorf("invalid protocol %q", q.Protocol)
+    }
+    var network *net.IPNet
+    if len(q.Address) > 0 {
+        address, err := normalizeAddress(q.Address)
+        if err != nil {
+            return verdict, err
+        }
+        _, network, _ = net.ParseCIDR(address)
+    }
+    server, err := s.FindServer(q.ServerId)
+    if err != nil {
+        return verdict, err
+    }
+
+    entries := s.FirewallsOf(*server)
+    for _, e := range entries {
+        verdict.Firewalls = append(verdict.Firewalls, e.Firewall)
+        for _, r := range e.Rules {
+            if ruleMatches(r, q, network) {
+                verdict.Matches = append(verdict.Matches, FirewallRuleMatch{Firewall: e.Firewall, Rule: r})
+            }
+        }
+    }
+    verdict.Allowed = len(entries) == 0 || len(verdict.Matches) > 0
+    return verdict, nil
+}
+
+func ruleMatches(r ServerFirewallRule, q FirewallQuery, network *net.IPNet) bool {
+    if r.Type != q.Direction {
+        return false
+    }
+    if r.Protocol != nil && *r.Protocol != q.Protocol {
+        return false
+    }
+    if q.Protocol != ServerFirewallRuleProtocolICMP && r.Ports != nil && len(*r.Ports) > 0 {
+        found := false
+        for _, p := range *r.Ports {
+            if portInRange(p, q.Port) {
+                found = true
+                break
+            }
+        }
+        if !found {
+            return false
+        }
+    }
+    if r.Addresses == nil || len(*r.Addresses) == 0 {
+        return true
+    }
+    for _, a := range *r.Addresses {
+        address, err := normalizeAddress(a)
+        if err != nil {
+            continue
+        }
+        _, allowed, _ := net.ParseCIDR(address)
+        if network == nil {
+            if isAnyAddress(address) {
+                return true
+            }
+            continue
+        }
+        // The whole queried network has to be allowed.
+        allowedOnes, allowedBits := allowed.Mask.Size()
+        ones, bits := network.Mask.Size()
+        if allowedBits == bits && allowedOnes <= ones && allowed.Contains(network.IP) {
+            return true
+        }
+    }
+    return false
+}
+
+func portInRange(spec string, port int) bool {
+    bounds := strings.FieldsFunc(spec, func(r rune) bool { return r == ':' || r == '-' })
+    if len(bounds) < 1 || len(bounds) > 2 {
+        return false
+    }
+    from, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+    if err != nil {
+        return false
+    }
+    to := from
+    if len(bounds) == 2 {
+        if to, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+            return false
+        }
+    }
+    return port >= from && port <= to
+}
+
+// EffectivePolicies returns the effective rules of every server, ordered by server name
+// with ingress rules first.
+func (s FirewallSnapshot) EffectivePolicies() []ServerEffectivePolicy {
+    policies := []ServerEffectivePolicy{}
+    for _, server := range s.Servers {
+        policy := ServerEffectivePolicy{ServerId: server.Id, ServerName: server.Name, Firewalls: []ServerFirewall{}, Rules: []EffectiveFirewallRule{}}
+        for _, e := range s.FirewallsOf(server) {
+            policy.Firewalls = append(policy.Firewalls, e.Firewall)
+            for _, r := range e.Rules {
+                policy.Rules = append(policy.Rules, EffectiveFirewallRule{
+                    FirewallId: e.Firewall.Id,
+                    FirewallTitle: e.Firewall.Title,
+                    Rule: FirewallRuleFromServer(r),
+                    Applied: r.Applied,
+                })
+            }
+        }
+        sort.SliceStable(policy.Rules, func(i, j int) bool {
+            return policy.Rules[i].Rule.Type == ServerFirewallRuleTypeIngress && policy.Rules[j].Rule.Type != ServerFirewallRuleTypeIngress
+        })
+        policies = append(policies, policy)
+    }
+    sort.SliceStable(policies, func(i, j int) bool {
+        if policies[i].ServerName != policies[j].ServerName {
+            return policies[i].ServerName < policies[j].ServerName
+        }
+        return policies[i].ServerId < policies[j].ServerId
+    })
+    return policies
+}
+
+// WriteEffectivePolicies writes the policies as text, one block per server. Rules that
+// are not applied to the servers yet are marked as pending.
+func WriteEffectivePolicies(w io.Writer, policies []ServerEffectivePolicy) error {
+    for i, p := range policies {
+        if i > 0 {
+            if _, err := fmt.Fprintln(w); err != nil {
+                return err
+            }
+        }
+        if _, err := fmt.Fprintf(w, "%s (%s)\n", p.ServerName, p.ServerId); err != nil {
+            return err
+        }
+        lines := []string{}
+        switch {
+            case len(p.Firewalls) == 0:
+                lines = append(lines, "no firewall, all traffic is allowed")
+            case len(p.Rules) == 0:
+                lines = append(lines, "no rules, all traffic is denied")
+        }
+        for _, r := range p.Rules {
+            line := r.FirewallTitle + ": " + r.Rule.String()
+            if !r.Applied {
+                line += " [pending]"
+            }
+            lines = append(lines, line)
+        }
+        for _, line := range lines {
+            if _, err := fmt.Fprintln(w, "  "+line); err != nil {
+                return err
+            }
+        }
+    }
+    return nil
+}
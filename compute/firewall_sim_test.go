@@ -0,0 +1,146 @@
+package compute_test
+
+import (
+    "bytes"
+    "context"
+    "strings"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+const testSnapshot = `{
+    "servers": [
+        {"id": "s1", "name": "web-1", "labels": {"role": "web", "env": "prod"}},
+        {"id": "s2", "name": "db-1", "labels": {"role": "db", "env": "prod"}},
+        {"id": "s3", "name": "db-2", "labels": {"role": "db", "env": "dev"}},
+        {"id": "s4", "name": "build", "labels": {}}
+    ],
+    "firewalls": [
+        {
+            "firewall": {"id": "f1", "title": "web"},
+            "members": [{"type": "LABEL", "label_name": "role", "label_value": "web"}],
+            "rules": [
+                {"id": "r1", "type": "INGRESS", "protocol": "TCP", "ports": ["80", "443"], "applied": true},
+                {"id": "r2", "type": "INGRESS", "protocol": "TCP", "ports": ["22"], "addresses": ["10.0.0.0/8"], "applied": true}
+            ]
+        },
+        {
+            "firewall": {"id": "f2", "title": "db"},
+            "members": [{"type": "LABEL", "label_name": "role", "label_value": "db", "children": [
+                {"type": "LABEL", "label_name": "env", "label_value": "prod"}
+            ]}],
+            "rules": [
+                {"id": "r3", "type": "INGRESS", "protocol": "TCP", "ports": ["5432"], "addresses": ["10.0.0.0/8"], "applied": true},
+                {"id": "r4", "type": "EGRESS", "applied": false}
+            ]
+        },
+        {
+            "firewall": {"id": "f3", "title": "ping"},
+            "members": [{"type": "SERVER", "server_id": "s1"}, {"type": "LABEL", "label_name": "env"}],
+            "rules": [{"id": "r5", "type": "INGRESS", "protocol": "ICMP", "applied": true}]
+        }
+    ]
+}`
+
+func readSnapshot(t *testing.T) compute.FirewallSnapshot {
+    t.Helper()
+    snapshot, err := compute.ReadFirewallSnapshot(strings.NewReader(testSnapshot))
+    if err != nil {
+        t.Fatal(err)
+    }
+    return snapshot
+}
+
+func TestFirewallCheck(t *testing.T) {
+    snapshot := readSnapshot(t)
+    tests := []struct {
+        query compute.FirewallQuery
+        allowed bool
+        matches int
+    }{
+        {compute.FirewallQuery{ServerId: "web-1", Protocol: "TCP", Port: 443}, true, 1},
+        {compute.FirewallQuery{ServerId: "web-1", Protocol: "TCP", Port: 8080}, false, 0},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "TCP", Port: 22, Address: "10.1.2.3"}, true, 1},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "TCP", Port: 22, Address: "10.1.0.0/16"}, true, 1},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "TCP", Port: 22, Address: "0.0.0.0/0"}, false, 0},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "TCP", Port: 22}, false, 0},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "UDP", Port: 443}, false, 0},
+        {compute.FirewallQuery{ServerId: "s1", Protocol: "ICMP"}, true, 1},
+        {compute.FirewallQuery{ServerId: "db-1", Protocol: "TCP", Port: 5432, Address: "10.0.0.5"}, true, 1},
+        {compute.FirewallQuery{ServerId: "db-1", Direction: "EGRESS", Protocol: "UDP", Port: 53}, true, 1},
+        // db-2 is not in prod, so only the ping firewall applies through the env label.
+        {compute.FirewallQuery{ServerId: "db-2", Protocol: "TCP", Port: 5432, Address: "10.0.0.5"}, false, 0},
+        {compute.FirewallQuery{ServerId: "db-2", Protocol: "ICMP"}, true, 1},
+        {compute.FirewallQuery{ServerId: "build", Protocol: "TCP", Port: 1234}, true, 0},
+    }
+    for _, tt := range tests {
+        verdict, err := snapshot.Check(tt.query)
+        if err != nil {
+            t.Errorf("%+v: %v", tt.query, err)
+            continue
+        }
+        if verdict.Allowed != tt.allowed || len(verdict.Matches) != tt.matches {
+            t.Errorf("%+v: got allowed %v with %d matches, want %v with %d", tt.query, verdict.Allowed, len(verdict.Matches), tt.allowed, tt.matches)
+        }
+    }
+
+    for _, q := range []compute.FirewallQuery{
+        {ServerId: "missing", Protocol: "TCP"},
+        {ServerId: "s1", Protocol: "SCTP"},
+        {ServerId: "s1", Direction: "FORWARD", Protocol: "TCP"},
+        {ServerId: "s1", Protocol: "TCP", Address: "not an address"},
+    } {
+        if _, err := snapshot.Check(q); err == nil {
+            t.Errorf("%+v: invalid query accepted", q)
+        }
+    }
+}
+
+func TestEffectivePolicies(t *testing.T) {
+    b := &bytes.Buffer{}
+    if err := compute.WriteEffectivePolicies(b, readSnapshot(t).EffectivePolicies()); err != nil {
+        t.Fatal(err)
+    }
+    want := `build (s4)
+  no firewall, all traffic is allowed
+
+db-1 (s2)
+  db: INGRESS TCP ports 5432 from 10.0.0.0/8
+  ping: INGRESS ICMP
+  db: EGRESS ANY [pending]
+
+db-2 (s3)
+  ping: INGRESS ICMP
+
+web-1 (s1)
+  web: INGRESS TCP ports 80,443
+  web: INGRESS TCP ports 22 from 10.0.0.0/8
+  ping: INGRESS ICMP
+`
+    if b.String() != want {
+        t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+    }
+}
+
+func TestLoadFirewallSnapshot(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/compute/servers", map[string]interface{}{"id": "s1", "name": "web-1", "labels": map[string]string{"role": "web"}})
+    s.Seed("/compute/server-firewalls", map[string]interface{}{"id": "f1", "title": "web"})
+    s.Seed("/compute/server-firewalls/f1/rules", map[string]interface{}{"type": "INGRESS", "protocol": "TCP", "ports": []string{"443"}})
+    s.Seed("/compute/server-firewalls/f1/members", map[string]interface{}{"type": "LABEL", "label_name": "role", "label_value": "web"})
+    c := compute.NewClientWithUrl("token", s.ServiceUrl("compute"))
+
+    snapshot, err := c.LoadFirewallSnapshot(context.Background(), "")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(snapshot.Firewalls) != 1 || len(snapshot.Firewalls[0].Rules) != 1 || len(snapshot.Firewalls[0].Members) != 1 || len(snapshot.Servers) != 1 {
+        t.Fatalf("incomplete snapshot: %+v", snapshot)
+    }
+    verdict, err := snapshot.Check(compute.FirewallQuery{ServerId: "web-1", Protocol: "TCP", Port: 443})
+    if err != nil || !verdict.Allowed {
+        t.Errorf("got %+v, %v", verdict, err)
+    }
+}
@@ -0,0 +1,203 @@
+package compute_test
+
+import (
+    "context"
+    "reflect"
+    "strings"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func protocol(p compute.ServerFirewallRuleProtocol) *compute.ServerFirewallRuleProtocol {
+    return &p
+}
+
+func strPtr(s string) *string {
+    return &s
+}
+
+func liveRule(id string, ports []string, description string) compute.ServerFirewallRule {
+    r := compute.ServerFirewallRule{Id: id, Type: compute.ServerFirewallRuleTypeIngress, Protocol: protocol(compute.ServerFirewallRuleProtocolTCP), Applied: true}
+    if ports != nil {
+        r.Ports = &ports
+    }
+    if len(description) > 0 {
+        r.Description = &description
+    }
+    return r
+}
+
+func tcpRule(description string, ports ...string) compute.FirewallRule {
+    return compute.FirewallRule{Type: compute.ServerFirewallRuleTypeIngress, Protocol: protocol(compute.ServerFirewallRuleProtocolTCP), Ports: ports, Description: description}
+}
+
+func serverMember(id string, serverId string) compute.ServerFirewallMember {
+    return compute.ServerFirewallMember{Id: id, Type: compute.ServerFirewallMemberTypeServer, ServerId: &serverId, Applied: true}
+}
+
+// changes formats the changes of a plan, appending the id of the live rule or member.
+func changes(plan compute.FirewallPlan) []string {
+    lines := []string{}
+    for _, c := range plan.Changes {
+        line := c.String()
+        if len(c.Id) > 0 {
+            line += " #" + c.Id
+        }
+        lines = append(lines, line)
+    }
+    return lines
+}
+
+func TestPlanFirewall(t *testing.T) {
+    web := "web"
+    tests := []struct {
+        name string
+        policy compute.FirewallPolicy
+        rules []compute.ServerFirewallRule
+        members []compute.ServerFirewallMember
+        want []string
+    }{
+        {
+            name: "unchanged",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{tcpRule("", "443", "80")}, Members: []compute.FirewallMember{{ServerId: "s1"}}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"80", "443"}, "")},
+            members: []compute.ServerFirewallMember{serverMember("m1", "s1")},
+            want: []string{},
+        },
+        {
+            name: "description only",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{tcpRule("ssh", "22")}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"22"}, "old")},
+            want: []string{"~ rule INGRESS TCP ports 22 (ssh) #r1"},
+        },
+        {
+            name: "changed ports",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{tcpRule("", "8080")}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"80"}, "")},
+            want: []string{"+ rule INGRESS TCP ports 8080", "- rule INGRESS TCP ports 80 #r1"},
+        },
+        {
+            name: "protocol",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{{Type: compute.ServerFirewallRuleTypeIngress, Ports: []string{"80"}}}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"80"}, "")},
+            want: []string{"+ rule INGRESS ANY ports 80", "- rule INGRESS TCP ports 80 #r1"},
+        },
+        {
+            name: "duplicate live rules",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{tcpRule("", "22")}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"22"}, ""), liveRule("r2", []string{"22"}, "")},
+            want: []string{"- rule INGRESS TCP ports 22 #r2"},
+        },
+        {
+            name: "duplicate policy rules",
+            policy: compute.FirewallPolicy{Rules: []compute.FirewallRule{tcpRule("", "22"), tcpRule("", "22")}},
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"22"}, "")},
+            want: []string{"+ rule INGRESS TCP ports 22"},
+        },
+        {
+            name: "members",
+            policy: compute.FirewallPolicy{Members: []compute.FirewallMember{{ServerId: "s2"}, {LabelName: "role", LabelValue: &web}, {LabelName: "env"}}},
+            members: []compute.ServerFirewallMember{
+                serverMember("m1", "s1"),
+                {Id: "m2", Type: compute.ServerFirewallMemberTypeLabel, LabelName: strPtr("role"), LabelValue: &web},
+                {Id: "m3", Type: compute.ServerFirewallMemberTypeLabel, LabelName: strPtr("env"), LabelValue: strPtr("prod")},
+            },
+            want: []string{"+ member server s2", "+ member label env", "- member server s1 #m1", "- member label env=prod #m3"},
+        },
+        {
+            name: "creates before deletes",
+            policy: compute.FirewallPolicy{
+                Rules: []compute.FirewallRule{tcpRule("https", "443"), tcpRule("", "8443")},
+                Members: []compute.FirewallMember{{ServerId: "s2"}},
+            },
+            rules: []compute.ServerFirewallRule{liveRule("r1", []string{"80"}, ""), liveRule("r2", []string{"443"}, "")},
+            members: []compute.ServerFirewallMember{serverMember("m1", "s1")},
+            want: []string{
+                "~ rule INGRESS TCP ports 443 (https) #r2",
+                "+ rule INGRESS TCP ports 8443",
+                "+ member server s2",
+                "- member server s1 #m1",
+                "- rule INGRESS TCP ports 80 #r1",
+            },
+        },
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            tt.policy.Title = "web"
+            plan := compute.PlanFirewall(tt.policy, "f1", tt.rules, tt.members)
+            if got := changes(plan); !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("got changes\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+            }
+            if plan.Empty() != (len(tt.want) == 0) {
+                t.Errorf("Empty() = %v with %d changes", plan.Empty(), len(plan.Changes))
+            }
+        })
+    }
+
+    plan := compute.PlanFirewall(compute.FirewallPolicy{Title: "web"}, "", nil, nil)
+    if plan.Empty() {
+        t.Errorf("a plan creating the firewall is empty")
+    }
+}
+
+func TestReconcileFirewall(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    c := s.ComputeClient()
+    ctx := context.Background()
+    opts := compute.FirewallReconcileOptions{Wait: fastWait}
+
+    policy := compute.FirewallPolicy{
+        Title: "web",
+        Rules: []compute.FirewallRule{tcpRule("http", "80"), tcpRule("", "22")},
+        Members: []compute.FirewallMember{{ServerId: "s1"}},
+    }
+    plan, err := c.ReconcileFirewall(ctx, policy, opts)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(plan.FirewallId) == 0 || len(plan.Changes) != 3 {
+        t.Fatalf("got plan %+v", plan)
+    }
+    rules := "/compute/server-firewalls/" + plan.FirewallId + "/rules"
+    if n := len(s.Items(rules)); n != 2 {
+        t.Errorf("got %d rules, want 2", n)
+    }
+
+    plan, err = c.ReconcileFirewall(ctx, policy, opts)
+    if err != nil || !plan.Empty() {
+        t.Errorf("second run: got %v, %+v", err, plan.Changes)
+    }
+
+    // A failed create stops the plan before the replaced rule is deleted.
+    policy.Rules[1] = tcpRule("", "2222")
+    s.InjectFault(lumaservtest.Fault{Method: "POST", Path: rules, StatusCode: 500, Count: 1})
+    if _, err := c.ReconcileFirewall(ctx, policy, opts); err == nil || !strings.Contains(err.Error(), "+ rule INGRESS TCP ports 2222") {
+        t.Errorf("got %v, want the failed create", err)
+    }
+    if n := len(s.Items(rules)); n != 2 {
+        t.Errorf("got %d rules after the failed apply, want the 2 old ones", n)
+    }
+
+    dry, err := c.ReconcileFirewall(ctx, policy, compute.FirewallReconcileOptions{DryRun: true})
+    if err != nil || len(dry.Changes) != 2 || len(s.Items(rules)) != 2 {
+        t.Errorf("dry run: got %v, %+v", err, dry.Changes)
+    }
+
+    if _, err := c.ReconcileFirewall(ctx, policy, opts); err != nil {
+        t.Fatal(err)
+    }
+    exported, err := c.ExportFirewallPolicy(ctx, "web", "")
+    if err != nil {
+        t.Fatal(err)
+    }
+    got := []string{}
+    for _, r := range exported.Rules {
+        got = append(got, r.String())
+    }
+    want := []string{"INGRESS TCP ports 80 (http)", "INGRESS TCP ports 2222"}
+    if !reflect.DeepEqual(got, want) || len(exported.Members) != 1 {
+        t.Errorf("got rules %v and members %+v, want %v", got, exported.Members, want)
+    }
+}
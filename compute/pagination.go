@@ -0,0 +1,1646 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package compute
+
+import (
+    "context"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// SSHKeyIterator iterates over the SSHKey items of all pages of a list.
+type SSHKeyIterator struct {
+    pager *core.Pager
+    items []SSHKey
+    current SSHKey
+}
+
+// NewSSHKeyIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSSHKeyIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]SSHKey, *ResponsePagination, error)) *SSHKeyIterator {
+    it := &SSHKeyIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SSHKeyIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SSHKeyIterator) Value() SSHKey {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SSHKeyIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SSHKeyIterator) All() ([]SSHKey, error) {
+    all := []SSHKey{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerPriceRangeIterator iterates over the ServerPriceRange items of all pages of a list.
+type ServerPriceRangeIterator struct {
+    pager *core.Pager
+    items []ServerPriceRange
+    current ServerPriceRange
+}
+
+// NewServerPriceRangeIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerPriceRangeIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerPriceRange, *ResponsePagination, error)) *ServerPriceRangeIterator {
+    it := &ServerPriceRangeIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerPriceRangeIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerPriceRangeIterator) Value() ServerPriceRange {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerPriceRangeIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerPriceRangeIterator) All() ([]ServerPriceRange, error) {
+    all := []ServerPriceRange{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// AvailabilityZoneIterator iterates over the AvailabilityZone items of all pages of a list.
+type AvailabilityZoneIterator struct {
+    pager *core.Pager
+    items []AvailabilityZone
+    current AvailabilityZone
+}
+
+// NewAvailabilityZoneIterator returns an iterator over the items returned by fetch, starting at page.
+func NewAvailabilityZoneIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]AvailabilityZone, *ResponsePagination, error)) *AvailabilityZoneIterator {
+    it := &AvailabilityZoneIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *AvailabilityZoneIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *AvailabilityZoneIterator) Value() AvailabilityZone {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AvailabilityZoneIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *AvailabilityZoneIterator) All() ([]AvailabilityZone, error) {
+    all := []AvailabilityZone{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerActionIterator iterates over the ServerAction items of all pages of a list.
+type ServerActionIterator struct {
+    pager *core.Pager
+    items []ServerAction
+    current ServerAction
+}
+
+// NewServerActionIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerActionIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerAction, *ResponsePagination, error)) *ServerActionIterator {
+    it := &ServerActionIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerActionIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerActionIterator) Value() ServerAction {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerActionIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerActionIterator) All() ([]ServerAction, error) {
+    all := []ServerAction{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerFirewallIterator iterates over the ServerFirewall items of all pages of a list.
+type ServerFirewallIterator struct {
+    pager *core.Pager
+    items []ServerFirewall
+    current ServerFirewall
+}
+
+// NewServerFirewallIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerFirewallIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerFirewall, *ResponsePagination, error)) *ServerFirewallIterator {
+    it := &ServerFirewallIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerFirewallIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerFirewallIterator) Value() ServerFirewall {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerFirewallIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerFirewallIterator) All() ([]ServerFirewall, error) {
+    all := []ServerFirewall{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerHostIterator iterates over the ServerHost items of all pages of a list.
+type ServerHostIterator struct {
+    pager *core.Pager
+    items []ServerHost
+    current ServerHost
+}
+
+// NewServerHostIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerHostIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerHost, *ResponsePagination, error)) *ServerHostIterator {
+    it := &ServerHostIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerHostIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerHostIterator) Value() ServerHost {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerHostIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerHostIterator) All() ([]ServerHost, error) {
+    all := []ServerHost{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerIterator iterates over the Server items of all pages of a list.
+type ServerIterator struct {
+    pager *core.Pager
+    items []Server
+    current Server
+}
+
+// NewServerIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Server, *ResponsePagination, error)) *ServerIterator {
+    it := &ServerIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerIterator) Value() Server {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerIterator) All() ([]Server, error) {
+    all := []Server{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerBackupIterator iterates over the ServerBackup items of all pages of a list.
+type ServerBackupIterator struct {
+    pager *core.Pager
+    items []ServerBackup
+    current ServerBackup
+}
+
+// NewServerBackupIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerBackupIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerBackup, *ResponsePagination, error)) *ServerBackupIterator {
+    it := &ServerBackupIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerBackupIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerBackupIterator) Value() ServerBackup {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerBackupIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerBackupIterator) All() ([]ServerBackup, error) {
+    all := []ServerBackup{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// SubnetIterator iterates over the Subnet items of all pages of a list.
+type SubnetIterator struct {
+    pager *core.Pager
+    items []Subnet
+    current Subnet
+}
+
+// NewSubnetIterator returns an iterator over the items returned by fetch, starting at page.
+func NewSubnetIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Subnet, *ResponsePagination, error)) *SubnetIterator {
+    it := &SubnetIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *SubnetIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *SubnetIterator) Value() Subnet {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SubnetIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *SubnetIterator) All() ([]Subnet, error) {
+    all := []Subnet{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerVolumeIterator iterates over the ServerVolume items of all pages of a list.
+type ServerVolumeIterator struct {
+    pager *core.Pager
+    items []ServerVolume
+    current ServerVolume
+}
+
+// NewServerVolumeIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerVolumeIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerVolume, *ResponsePagination, error)) *ServerVolumeIterator {
+    it := &ServerVolumeIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerVolumeIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerVolumeIterator) Value() ServerVolume {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerVolumeIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerVolumeIterator) All() ([]ServerVolume, error) {
+    all := []ServerVolume{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerStorageClassIterator iterates over the ServerStorageClass items of all pages of a list.
+type ServerStorageClassIterator struct {
+    pager *core.Pager
+    items []ServerStorageClass
+    current ServerStorageClass
+}
+
+// NewServerStorageClassIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerStorageClassIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerStorageClass, *ResponsePagination, error)) *ServerStorageClassIterator {
+    it := &ServerStorageClassIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerStorageClassIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerStorageClassIterator) Value() ServerStorageClass {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerStorageClassIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerStorageClassIterator) All() ([]ServerStorageClass, error) {
+    all := []ServerStorageClass{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// S3BucketIterator iterates over the S3Bucket items of all pages of a list.
+type S3BucketIterator struct {
+    pager *core.Pager
+    items []S3Bucket
+    current S3Bucket
+}
+
+// NewS3BucketIterator returns an iterator over the items returned by fetch, starting at page.
+func NewS3BucketIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]S3Bucket, *ResponsePagination, error)) *S3BucketIterator {
+    it := &S3BucketIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *S3BucketIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *S3BucketIterator) Value() S3Bucket {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *S3BucketIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *S3BucketIterator) All() ([]S3Bucket, error) {
+    all := []S3Bucket{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerFirewallMemberIterator iterates over the ServerFirewallMember items of all pages of a list.
+type ServerFirewallMemberIterator struct {
+    pager *core.Pager
+    items []ServerFirewallMember
+    current ServerFirewallMember
+}
+
+// NewServerFirewallMemberIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerFirewallMemberIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerFirewallMember, *ResponsePagination, error)) *ServerFirewallMemberIterator {
+    it := &ServerFirewallMemberIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerFirewallMemberIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerFirewallMemberIterator) Value() ServerFirewallMember {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerFirewallMemberIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerFirewallMemberIterator) All() ([]ServerFirewallMember, error) {
+    all := []ServerFirewallMember{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerTemplateIterator iterates over the ServerTemplate items of all pages of a list.
+type ServerTemplateIterator struct {
+    pager *core.Pager
+    items []ServerTemplate
+    current ServerTemplate
+}
+
+// NewServerTemplateIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerTemplateIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerTemplate, *ResponsePagination, error)) *ServerTemplateIterator {
+    it := &ServerTemplateIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerTemplateIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerTemplateIterator) Value() ServerTemplate {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerTemplateIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerTemplateIterator) All() ([]ServerTemplate, error) {
+    all := []ServerTemplate{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerFirewallRuleIterator iterates over the ServerFirewallRule items of all pages of a list.
+type ServerFirewallRuleIterator struct {
+    pager *core.Pager
+    items []ServerFirewallRule
+    current ServerFirewallRule
+}
+
+// NewServerFirewallRuleIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerFirewallRuleIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerFirewallRule, *ResponsePagination, error)) *ServerFirewallRuleIterator {
+    it := &ServerFirewallRuleIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerFirewallRuleIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerFirewallRuleIterator) Value() ServerFirewallRule {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerFirewallRuleIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerFirewallRuleIterator) All() ([]ServerFirewallRule, error) {
+    all := []ServerFirewallRule{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ScheduledServerActionIterator iterates over the ScheduledServerAction items of all pages of a list.
+type ScheduledServerActionIterator struct {
+    pager *core.Pager
+    items []ScheduledServerAction
+    current ScheduledServerAction
+}
+
+// NewScheduledServerActionIterator returns an iterator over the items returned by fetch, starting at page.
+func NewScheduledServerActionIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ScheduledServerAction, *ResponsePagination, error)) *ScheduledServerActionIterator {
+    it := &ScheduledServerActionIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ScheduledServerActionIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ScheduledServerActionIterator) Value() ScheduledServerAction {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ScheduledServerActionIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ScheduledServerActionIterator) All() ([]ScheduledServerAction, error) {
+    all := []ScheduledServerAction{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerNetworkIterator iterates over the ServerNetwork items of all pages of a list.
+type ServerNetworkIterator struct {
+    pager *core.Pager
+    items []ServerNetwork
+    current ServerNetwork
+}
+
+// NewServerNetworkIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerNetworkIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerNetwork, *ResponsePagination, error)) *ServerNetworkIterator {
+    it := &ServerNetworkIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerNetworkIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerNetworkIterator) Value() ServerNetwork {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerNetworkIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerNetworkIterator) All() ([]ServerNetwork, error) {
+    all := []ServerNetwork{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerVariantIterator iterates over the ServerVariant items of all pages of a list.
+type ServerVariantIterator struct {
+    pager *core.Pager
+    items []ServerVariant
+    current ServerVariant
+}
+
+// NewServerVariantIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerVariantIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerVariant, *ResponsePagination, error)) *ServerVariantIterator {
+    it := &ServerVariantIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerVariantIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerVariantIterator) Value() ServerVariant {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerVariantIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerVariantIterator) All() ([]ServerVariant, error) {
+    all := []ServerVariant{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerPriceRangeAssignmentIterator iterates over the ServerPriceRangeAssignment items of all pages of a list.
+type ServerPriceRangeAssignmentIterator struct {
+    pager *core.Pager
+    items []ServerPriceRangeAssignment
+    current ServerPriceRangeAssignment
+}
+
+// NewServerPriceRangeAssignmentIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerPriceRangeAssignmentIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerPriceRangeAssignment, *ResponsePagination, error)) *ServerPriceRangeAssignmentIterator {
+    it := &ServerPriceRangeAssignmentIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerPriceRangeAssignmentIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerPriceRangeAssignmentIterator) Value() ServerPriceRangeAssignment {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerPriceRangeAssignmentIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerPriceRangeAssignmentIterator) All() ([]ServerPriceRangeAssignment, error) {
+    all := []ServerPriceRangeAssignment{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// AddressIterator iterates over the Address items of all pages of a list.
+type AddressIterator struct {
+    pager *core.Pager
+    items []Address
+    current Address
+}
+
+// NewAddressIterator returns an iterator over the items returned by fetch, starting at page.
+func NewAddressIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Address, *ResponsePagination, error)) *AddressIterator {
+    it := &AddressIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *AddressIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *AddressIterator) Value() Address {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AddressIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *AddressIterator) All() ([]Address, error) {
+    all := []Address{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerMediaIterator iterates over the ServerMedia items of all pages of a list.
+type ServerMediaIterator struct {
+    pager *core.Pager
+    items []ServerMedia
+    current ServerMedia
+}
+
+// NewServerMediaIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerMediaIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerMedia, *ResponsePagination, error)) *ServerMediaIterator {
+    it := &ServerMediaIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerMediaIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerMediaIterator) Value() ServerMedia {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerMediaIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerMediaIterator) All() ([]ServerMedia, error) {
+    all := []ServerMedia{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// S3AccessKeyIterator iterates over the S3AccessKey items of all pages of a list.
+type S3AccessKeyIterator struct {
+    pager *core.Pager
+    items []S3AccessKey
+    current S3AccessKey
+}
+
+// NewS3AccessKeyIterator returns an iterator over the items returned by fetch, starting at page.
+func NewS3AccessKeyIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]S3AccessKey, *ResponsePagination, error)) *S3AccessKeyIterator {
+    it := &S3AccessKeyIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *S3AccessKeyIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *S3AccessKeyIterator) Value() S3AccessKey {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *S3AccessKeyIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *S3AccessKeyIterator) All() ([]S3AccessKey, error) {
+    all := []S3AccessKey{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// NetworkIterator iterates over the Network items of all pages of a list.
+type NetworkIterator struct {
+    pager *core.Pager
+    items []Network
+    current Network
+}
+
+// NewNetworkIterator returns an iterator over the items returned by fetch, starting at page.
+func NewNetworkIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Network, *ResponsePagination, error)) *NetworkIterator {
+    it := &NetworkIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *NetworkIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *NetworkIterator) Value() Network {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *NetworkIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *NetworkIterator) All() ([]Network, error) {
+    all := []Network{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// S3AccessGrantIterator iterates over the S3AccessGrant items of all pages of a list.
+type S3AccessGrantIterator struct {
+    pager *core.Pager
+    items []S3AccessGrant
+    current S3AccessGrant
+}
+
+// NewS3AccessGrantIterator returns an iterator over the items returned by fetch, starting at page.
+func NewS3AccessGrantIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]S3AccessGrant, *ResponsePagination, error)) *S3AccessGrantIterator {
+    it := &S3AccessGrantIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *S3AccessGrantIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *S3AccessGrantIterator) Value() S3AccessGrant {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *S3AccessGrantIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *S3AccessGrantIterator) All() ([]S3AccessGrant, error) {
+    all := []S3AccessGrant{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// LabelIterator iterates over the Label items of all pages of a list.
+type LabelIterator struct {
+    pager *core.Pager
+    items []Label
+    current Label
+}
+
+// NewLabelIterator returns an iterator over the items returned by fetch, starting at page.
+func NewLabelIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Label, *ResponsePagination, error)) *LabelIterator {
+    it := &LabelIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *LabelIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *LabelIterator) Value() Label {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *LabelIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *LabelIterator) All() ([]Label, error) {
+    all := []Label{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ServerVariantPriceIterator iterates over the ServerVariantPrice items of all pages of a list.
+type ServerVariantPriceIterator struct {
+    pager *core.Pager
+    items []ServerVariantPrice
+    current ServerVariantPrice
+}
+
+// NewServerVariantPriceIterator returns an iterator over the items returned by fetch, starting at page.
+func NewServerVariantPriceIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]ServerVariantPrice, *ResponsePagination, error)) *ServerVariantPriceIterator {
+    it := &ServerVariantPriceIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *ServerVariantPriceIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *ServerVariantPriceIterator) Value() ServerVariantPrice {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ServerVariantPriceIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *ServerVariantPriceIterator) All() ([]ServerVariantPrice, error) {
+    all := []ServerVariantPrice{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ListAllSSHKeys iterates over the items of all pages of GetSSHKeys.
+func (c ComputeClient) ListAllSSHKeys(ctx context.Context, qParams GetSSHKeysQueryParams) *SSHKeyIterator {
+    return NewSSHKeyIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]SSHKey, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSSHKeysWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerPriceRanges iterates over the items of all pages of GetServerPriceRanges.
+func (c ComputeClient) ListAllServerPriceRanges(ctx context.Context, qParams GetServerPriceRangesQueryParams) *ServerPriceRangeIterator {
+    return NewServerPriceRangeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerPriceRange, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerPriceRangesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllAvailabilityZones iterates over the items of all pages of GetAvailabilityZones.
+func (c ComputeClient) ListAllAvailabilityZones(ctx context.Context, qParams GetAvailabilityZonesQueryParams) *AvailabilityZoneIterator {
+    return NewAvailabilityZoneIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]AvailabilityZone, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetAvailabilityZonesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerActions iterates over the items of all pages of GetServerActions.
+func (c ComputeClient) ListAllServerActions(ctx context.Context, qParams GetServerActionsQueryParams) *ServerActionIterator {
+    return NewServerActionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerAction, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerActionsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerFirewalls iterates over the items of all pages of GetServerFirewalls.
+func (c ComputeClient) ListAllServerFirewalls(ctx context.Context, qParams GetServerFirewallsQueryParams) *ServerFirewallIterator {
+    return NewServerFirewallIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerFirewall, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerFirewallsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerHosts iterates over the items of all pages of GetServerHosts.
+func (c ComputeClient) ListAllServerHosts(ctx context.Context, qParams GetServerHostsQueryParams) *ServerHostIterator {
+    return NewServerHostIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerHost, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerHostsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServers iterates over the items of all pages of GetServers.
+func (c ComputeClient) ListAllServers(ctx context.Context, qParams GetServersQueryParams) *ServerIterator {
+    return NewServerIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Server, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServersWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerBackups iterates over the items of all pages of GetServerBackups.
+func (c ComputeClient) ListAllServerBackups(ctx context.Context, qParams GetServerBackupsQueryParams) *ServerBackupIterator {
+    return NewServerBackupIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerBackup, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerBackupsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllSubnets iterates over the items of all pages of GetSubnets.
+func (c ComputeClient) ListAllSubnets(ctx context.Context, qParams GetSubnetsQueryParams) *SubnetIterator {
+    return NewSubnetIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Subnet, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetSubnetsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerVolumes iterates over the items of all pages of GetServerVolumes.
+func (c ComputeClient) ListAllServerVolumes(ctx context.Context, qParams GetServerVolumesQueryParams) *ServerVolumeIterator {
+    return NewServerVolumeIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerVolume, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerVolumesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerStorageClasses iterates over the items of all pages of GetServerStorageClasses.
+func (c ComputeClient) ListAllServerStorageClasses(ctx context.Context, qParams GetServerStorageClassesQueryParams) *ServerStorageClassIterator {
+    return NewServerStorageClassIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerStorageClass, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerStorageClassesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllS3Buckets iterates over the items of all pages of GetS3Buckets.
+func (c ComputeClient) ListAllS3Buckets(ctx context.Context, qParams GetS3BucketsQueryParams) *S3BucketIterator {
+    return NewS3BucketIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]S3Bucket, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetS3BucketsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerFirewallMembers iterates over the items of all pages of GetServerFirewallMembers.
+func (c ComputeClient) ListAllServerFirewallMembers(ctx context.Context, id string, qParams GetServerFirewallMembersQueryParams) *ServerFirewallMemberIterator {
+    return NewServerFirewallMemberIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerFirewallMember, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerFirewallMembersWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerTemplates iterates over the items of all pages of GetServerTemplates.
+func (c ComputeClient) ListAllServerTemplates(ctx context.Context, qParams GetServerTemplatesQueryParams) *ServerTemplateIterator {
+    return NewServerTemplateIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerTemplate, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerTemplatesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerFirewallRules iterates over the items of all pages of GetServerFirewallRules.
+func (c ComputeClient) ListAllServerFirewallRules(ctx context.Context, id string, qParams GetServerFirewallRulesQueryParams) *ServerFirewallRuleIterator {
+    return NewServerFirewallRuleIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerFirewallRule, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerFirewallRulesWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllScheduledServerActions iterates over the items of all pages of GetScheduledServerActions.
+func (c ComputeClient) ListAllScheduledServerActions(ctx context.Context, id string, qParams GetScheduledServerActionsQueryParams) *ScheduledServerActionIterator {
+    return NewScheduledServerActionIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ScheduledServerAction, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetScheduledServerActionsWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerNetworks iterates over the items of all pages of GetServerNetworks.
+func (c ComputeClient) ListAllServerNetworks(ctx context.Context, id string, qParams GetServerNetworksQueryParams) *ServerNetworkIterator {
+    return NewServerNetworkIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerNetwork, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerNetworksWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerVariants iterates over the items of all pages of GetServerVariants.
+func (c ComputeClient) ListAllServerVariants(ctx context.Context, qParams GetServerVariantsQueryParams) *ServerVariantIterator {
+    return NewServerVariantIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerVariant, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerVariantsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerPriceRangeAssignments iterates over the items of all pages of GetServerPriceRangeAssignments.
+func (c ComputeClient) ListAllServerPriceRangeAssignments(ctx context.Context, qParams GetServerPriceRangeAssignmentsQueryParams) *ServerPriceRangeAssignmentIterator {
+    return NewServerPriceRangeAssignmentIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerPriceRangeAssignment, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerPriceRangeAssignmentsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllAddresses iterates over the items of all pages of GetAddresses.
+func (c ComputeClient) ListAllAddresses(ctx context.Context, qParams GetAddressesQueryParams) *AddressIterator {
+    return NewAddressIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Address, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetAddressesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerMedias iterates over the items of all pages of GetServerMedias.
+func (c ComputeClient) ListAllServerMedias(ctx context.Context, qParams GetServerMediasQueryParams) *ServerMediaIterator {
+    return NewServerMediaIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerMedia, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerMediasWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllS3AccessKeys iterates over the items of all pages of GetS3AccessKeys.
+func (c ComputeClient) ListAllS3AccessKeys(ctx context.Context, qParams GetS3AccessKeysQueryParams) *S3AccessKeyIterator {
+    return NewS3AccessKeyIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]S3AccessKey, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetS3AccessKeysWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllNetworks iterates over the items of all pages of GetNetworks.
+func (c ComputeClient) ListAllNetworks(ctx context.Context, qParams GetNetworksQueryParams) *NetworkIterator {
+    return NewNetworkIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Network, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetNetworksWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllS3AccessKeyGrants iterates over the items of all pages of GetS3AccessKeyGrants.
+func (c ComputeClient) ListAllS3AccessKeyGrants(ctx context.Context, access_key_id string, qParams GetS3AccessKeyGrantsQueryParams) *S3AccessGrantIterator {
+    return NewS3AccessGrantIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]S3AccessGrant, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetS3AccessKeyGrantsWithContext(ctx, access_key_id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllLabels iterates over the items of all pages of GetLabels.
+func (c ComputeClient) ListAllLabels(ctx context.Context, qParams GetLabelsQueryParams) *LabelIterator {
+    return NewLabelIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Label, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetLabelsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllServerVariantPrices iterates over the items of all pages of GetServerVariantPrices.
+func (c ComputeClient) ListAllServerVariantPrices(ctx context.Context, id string, qParams GetServerVariantPricesQueryParams) *ServerVariantPriceIterator {
+    return NewServerVariantPriceIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]ServerVariantPrice, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetServerVariantPricesWithContext(ctx, id, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
@@ -0,0 +1,109 @@
+package compute
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "time"
+)
+
+// WaitOptions configures the polling of the WaitFor methods. Zero values fall back to a
+// timeout of 10 minutes and a poll interval of 2 seconds.
+type WaitOptions struct {
+    Timeout time.Duration
+    PollInterval time.Duration
+    OnServerProgress func(server Server)
+    OnActionProgress func(action ServerAction)
+}
+
+func (o WaitOptions) withDefaults() WaitOptions {
+    if o.Timeout <= 0 {
+        o.Timeout = time.Minute * 10
+    }
+    if o.PollInterval <= 0 {
+        o.PollInterval = time.Second * 2
+    }
+    return o
+}
+
+func poll(ctx context.Context, opts WaitOptions, check func(ctx context.Context) (bool, error)) error {
+    ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+    defer cancel()
+
+    ticker := time.NewTicker(opts.PollInterval)
+    defer ticker.Stop()
+    for {
+        done, err := check(ctx)
+        if err != nil || done {
+            return err
+        }
+        select {
+            case <-ctx.Done():
+                return ctx.Err()
+            case <-ticker.C:
+        }
+    }
+}
+
+// WaitForServerState polls the server until it reaches the given state. A server in
+// ServerStateError is reported as an error right away, unless that is the state waited for.
+func (c ComputeClient) WaitForServerState(ctx context.Context, id string, state ServerState, opts WaitOptions) (Server, error) {
+    opts = opts.withDefaults()
+    server := Server{}
+    err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+        res, _, err := c.GetServerWithContext(ctx, id)
+        if err != nil {
+            return false, err
+        }
+        server = res.Data
+        if opts.OnServerProgress != nil {
+            opts.OnServerProgress(server)
+        }
+        if server.State == ServerStateError && state != ServerStateError {
+            return false, fmt.Errorf("server is in state %s", server.State)
+        }
+        return server.State == state, nil
+    })
+    if err != nil {
+        return server, fmt.Errorf("waiting for server %s to reach state %s: %w", id, state, err)
+    }
+    return server, nil
+}
+
+// WaitForServerAction polls the server action until it has finished and returns its final
+// state. An action ending in ServerActionStateFailed or ServerActionStateCancelled is
+// reported as an error.
+func (c ComputeClient) WaitForServerAction(ctx context.Context, id string, opts WaitOptions) (ServerAction, error) {
+    opts = opts.withDefaults()
+    action := ServerAction{}
+    err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+        res, _, err := c.GetServerActionWithContext(ctx, id)
+        if err != nil {
+            return false, err
+        }
+        action = res.Data
+        if opts.OnActionProgress != nil {
+            opts.OnActionProgress(action)
+        }
+        switch action.State {
+            case ServerActionStateFinished:
+                return true, nil
+            case ServerActionStateFailed, ServerActionStateCancelled:
+                return true, fmt.Errorf("server action %s", strings.ToLower(string(action.State)))
+        }
+        return false, nil
+    })
+    if err != nil {
+        return action, fmt.Errorf("waiting for server action %s: %w", id, err)
+    }
+    return action, nil
+}
+
+// RestartServerAndWait restarts the server and waits for the resulting action to end.
+func (c ComputeClient) RestartServerAndWait(ctx context.Context, id string, opts WaitOptions) (ServerAction, error) {
+    res, _, err := c.RestartServerWithContext(ctx, id)
+    if err != nil {
+        return res.Data, err
+    }
+    return c.WaitForServerAction(ctx, res.Data.Id, opts)
+}
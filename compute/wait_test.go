@@ -0,0 +1,59 @@
+package compute_test
+
+import (
+    "context"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+var fastWait = compute.WaitOptions{Timeout: time.Second, PollInterval: time.Millisecond * 10}
+
+func TestWaitForServerAction(t *testing.T) {
+    tests := []struct {
+        state compute.ServerActionState
+        ok bool
+    }{
+        {compute.ServerActionStateFinished, true},
+        {compute.ServerActionStateFailed, false},
+        {compute.ServerActionStateCancelled, false},
+        {compute.ServerActionStateRunning, false},
+    }
+    for _, tt := range tests {
+        t.Run(string(tt.state), func(t *testing.T) {
+            s := lumaservtest.NewServer()
+            defer s.Close()
+            now := "2026-01-01T00:00:00Z"
+            s.Seed("/compute/server-actions", compute.ServerAction{Id: "a1", State: tt.state, EndedAt: &now})
+
+            action, err := s.ComputeClient().WaitForServerAction(context.Background(), "a1", fastWait)
+            if (err == nil) != tt.ok {
+                t.Fatalf("got error %v, want success %v", err, tt.ok)
+            }
+            if action.State != tt.state {
+                t.Errorf("got state %s, want %s", action.State, tt.state)
+            }
+        })
+    }
+}
+
+func TestWaitForServerStateError(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/compute/servers", compute.Server{Id: "s1", State: compute.ServerStateError})
+
+    start := time.Now()
+    _, err := s.ComputeClient().WaitForServerState(context.Background(), "s1", compute.ServerStateRunning, compute.WaitOptions{Timeout: time.Minute})
+    if err == nil {
+        t.Fatal("expected an error for a server in state ERROR")
+    }
+    if time.Since(start) > time.Second*5 {
+        t.Errorf("did not fail immediately, took %s", time.Since(start))
+    }
+
+    server, err := s.ComputeClient().WaitForServerState(context.Background(), "s1", compute.ServerStateError, fastWait)
+    if err != nil || server.State != compute.ServerStateError {
+        t.Errorf("waiting for state ERROR: %v, %s", err, server.State)
+    }
+}
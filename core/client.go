@@ -0,0 +1,299 @@
+package core
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "reflect"
+    "strconv"
+    "time"
+)
+
+// Client is the HTTP transport shared by all service clients.
+type Client struct {
+    baseUrl string
+    apiKey  string
+    client  *http.Client
+    currentProject string
+    retryPolicy RetryPolicy
+    timeout time.Duration
+}
+
+// DefaultTimeout limits a request including reading its response, unless the response
+// is streamed by DownloadWithContext.
+const DefaultTimeout = time.Second * 5
+
+func NewClient (apiKey string, baseUrl string) *Client {
+    return &Client {
+        apiKey: apiKey,
+        baseUrl: baseUrl,
+        client: &http.Client{},
+    }
+}
+
+func (c *Client) SetCurrentProject (project string) {
+    c.currentProject = project
+}
+
+func (c *Client) GetCurrentProject () string {
+    return c.currentProject
+}
+
+// SetHttpClient replaces the HTTP client used for requests, nil restores the default. It
+// must not be called concurrently with requests.
+func (c *Client) SetHttpClient(client *http.Client) {
+    if client == nil {
+        client = &http.Client{}
+    }
+    c.client = client
+}
+
+func (c *Client) SetAccessToken(token string) {
+    c.apiKey = token
+}
+
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+    c.retryPolicy = policy
+}
+
+func (c *Client) GetRetryPolicy() RetryPolicy {
+    return c.retryPolicy
+}
+
+// SetTimeout changes the timeout of each request attempt, see DefaultTimeout.
+func (c *Client) SetTimeout(timeout time.Duration) {
+    c.timeout = timeout
+}
+
+func (c *Client) GetBaseUrl() string {
+    return c.baseUrl
+}
+
+func (c *Client) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
+    return c.RequestWithContext(context.Background(), method, path, postBody)
+}
+
+func (c *Client) RequestWithContext(ctx context.Context, method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
+    var postBytes []byte
+    if postBody != nil {
+        b, err := ioutil.ReadAll(postBody)
+        if err != nil {
+            return nil, nil, err
+        }
+        postBytes = b
+    }
+
+    return c.retry(ctx, method, path, postBytes, "application/json", nil)
+}
+
+// DownloadWithContext streams the response body of a successful request to w instead of
+// decoding it. The timeout of the client only applies until the response headers arrived,
+// the transfer of the body is bounded by ctx. Error responses are returned as *APIError.
+func (c *Client) DownloadWithContext(ctx context.Context, path string, accept string, w io.Writer) (*http.Response, error) {
+    res, body, err := c.retry(ctx, http.MethodGet, path, nil, accept, w)
+    if err != nil {
+        return res, err
+    }
+    if res.StatusCode >= 400 {
+        envelope := Envelope{}
+        json.Unmarshal(body, &envelope)
+        return res, newAPIError(res, envelope)
+    }
+    return res, nil
+}
+
+func (c *Client) retry(ctx context.Context, method string, path string, postBytes []byte, accept string, w io.Writer) (*http.Response, []byte, error) {
+    policy := c.retryPolicy
+    retryable := policy.retryableMethod(method)
+    for attempt := 1; ; attempt++ {
+        res, body, err := c.send(ctx, method, path, postBytes, accept, w)
+        if attempt >= policy.MaxAttempts || !retryable || ctx.Err() != nil {
+            return res, body, err
+        }
+        if err != nil && !policy.retryableError(err) {
+            return res, body, err
+        }
+        if err == nil && !policy.retryableStatus(res.StatusCode) {
+            return res, body, err
+        }
+
+        timer := time.NewTimer(policy.backoff(attempt, res))
+        select {
+            case <-ctx.Done():
+                timer.Stop()
+                return res, body, err
+            case <-timer.C:
+        }
+    }
+}
+
+func (c *Client) send(ctx context.Context, method string, path string, postBytes []byte, accept string, w io.Writer) (*http.Response, []byte, error) {
+    var postBody io.Reader
+    if postBytes != nil {
+        postBody = bytes.NewReader(postBytes)
+    }
+
+    timeout := c.timeout
+    if timeout <= 0 {
+        timeout = DefaultTimeout
+    }
+    parent := ctx
+    ctx, cancel := context.WithCancel(ctx)
+    defer cancel()
+    timer := time.AfterFunc(timeout, cancel)
+    defer timer.Stop()
+    timedOut := func(err error) error {
+        if parent.Err() == nil && ctx.Err() != nil {
+            return fmt.Errorf("%w (timeout of %s exceeded)", err, timeout)
+        }
+        return err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path, postBody)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    req.Header.Add("Authorization", "Bearer "+c.apiKey)
+    req.Header.Add("User-Agent", "LUMASERV-go-client")
+    req.Header.Add("Accept", accept)
+    res, err := c.client.Do(req)
+    if err != nil {
+        return res, nil, timedOut(err)
+    }
+
+    if res.Body != nil {
+        defer res.Body.Close()
+    }
+
+    if w != nil && res.StatusCode < 400 {
+        timer.Stop()
+        ew := &errWriter{w: w}
+        // Not wrapped with %w, as a partially written stream must not be retried.
+        if _, err = io.Copy(ew, res.Body); err != nil {
+            if ew.err != nil {
+                return res, nil, fmt.Errorf("writing response body: %v", err)
+            }
+            return res, nil, fmt.Errorf("reading response body: %v", err)
+        }
+        return res, nil, nil
+    }
+
+    body, err := ioutil.ReadAll(res.Body)
+    if err != nil {
+        err = timedOut(err)
+    }
+
+    return res, body, err
+}
+
+// errWriter remembers write errors, telling them apart from read errors of io.Copy.
+type errWriter struct {
+    w io.Writer
+    err error
+}
+
+func (ew *errWriter) Write(p []byte) (int, error) {
+    n, err := ew.w.Write(p)
+    if err != nil {
+        ew.err = err
+    }
+    return n, err
+}
+
+// Do sends a request with qParams encoded into the query string and in encoded as the
+// JSON body, both optional, and decodes the response envelope into out. Responses without
+// success are returned as *APIError.
+func (c *Client) Do(method string, path string, qParams interface{}, in interface{}, out interface{}) (*http.Response, error) {
+    return c.DoWithContext(context.Background(), method, path, qParams, in, out)
+}
+
+// DoWithContext is like Do but carries ctx into the underlying HTTP request.
+func (c *Client) DoWithContext(ctx context.Context, method string, path string, qParams interface{}, in interface{}, out interface{}) (*http.Response, error) {
+    if qParams != nil {
+        q, err := QueryValues(qParams)
+        if err != nil {
+            return nil, err
+        }
+        path += "?" + q.Encode()
+    }
+
+    var postBody io.Reader
+    if in != nil {
+        inJson, err := json.Marshal(in)
+        if err != nil {
+            return nil, err
+        }
+        postBody = bytes.NewBuffer(inJson)
+    }
+
+    res, j, err := c.RequestWithContext(ctx, method, path, postBody)
+    if err != nil {
+        return res, err
+    }
+
+    envelope := Envelope{}
+    err = json.Unmarshal(j, &envelope)
+    if err != nil {
+        if res.StatusCode >= 400 {
+            return res, newAPIError(res, envelope)
+        }
+        return res, err
+    }
+    if out != nil {
+        err = json.Unmarshal(j, out)
+        if err != nil {
+            return res, err
+        }
+    }
+    if !envelope.Success {
+        return res, newAPIError(res, envelope)
+    }
+    return res, nil
+}
+
+// ApplyCurrentProject fills empty ProjectId fields of the struct v points to, including
+// nested structs, with the current project.
+func (c *Client) ApplyCurrentProject (v reflect.Value) {
+    if len(c.currentProject) > 0 {
+        if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+            x := v.Elem()
+            f := x.FieldByName("ProjectId")
+            if f.IsValid() && f.CanSet() {
+                if f.Kind() == reflect.String && len(f.String()) == 0 {
+                    f.SetString(c.currentProject)
+                } else if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String && f.IsNil() {
+                    project := c.currentProject
+                    f.Set(reflect.ValueOf(&project))
+                }
+            }
+
+            for i := 0; i < x.NumField(); i++ {
+                field := x.Field(i)
+                if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct {
+                    if field.IsNil() && field.CanSet() {
+                        field.Set(reflect.New(field.Type().Elem()))
+                    }
+                    c.ApplyCurrentProject(field)
+                } else if field.Kind() == reflect.Struct && field.CanAddr() {
+                    c.ApplyCurrentProject(field.Addr())
+                }
+            }
+        }
+    }
+}
+
+func ToStr(in interface{}) string {
+    switch in.(type) {
+        case string:
+            return in.(string)
+        case int:
+            return strconv.Itoa(in.(int))
+    }
+
+    panic("Unhandled type in toStr")
+}
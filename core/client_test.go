@@ -0,0 +1,141 @@
+package core_test
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "net/http"
+    "net/http/httptest"
+    "reflect"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// slowServer sends the headers right away and the body in chunks with a delay.
+func slowServer(chunks int, delay time.Duration) *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/pdf")
+        w.WriteHeader(http.StatusOK)
+        for i := 0; i < chunks; i++ {
+            w.Write([]byte("chunk\n"))
+            w.(http.Flusher).Flush()
+            time.Sleep(delay)
+        }
+    }))
+}
+
+func TestDownloadNotLimitedByTimeout(t *testing.T) {
+    s := slowServer(4, time.Millisecond * 50)
+    defer s.Close()
+    c := core.NewClient("token", s.URL)
+    c.SetTimeout(time.Millisecond * 100)
+
+    buf := bytes.Buffer{}
+    if _, err := c.DownloadWithContext(context.Background(), "/file", "*/*", &buf); err != nil {
+        t.Fatal(err)
+    }
+    if buf.String() != strings.Repeat("chunk\n", 4) {
+        t.Errorf("got %q", buf.String())
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond * 75)
+    defer cancel()
+    if _, err := c.DownloadWithContext(ctx, "/file", "*/*", &bytes.Buffer{}); err == nil {
+        t.Errorf("download not canceled by the context deadline")
+    }
+}
+
+func TestRequestTimeout(t *testing.T) {
+    s := slowServer(4, time.Millisecond * 50)
+    defer s.Close()
+    c := core.NewClient("token", s.URL)
+    c.SetTimeout(time.Millisecond * 100)
+
+    _, _, err := c.Request(http.MethodGet, "/slow", nil)
+    if err == nil || !strings.Contains(err.Error(), "timeout") {
+        t.Errorf("got %v, want a timeout", err)
+    }
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+    return 0, errors.New("disk full")
+}
+
+func TestDownloadWriterError(t *testing.T) {
+    s := slowServer(1, 0)
+    defer s.Close()
+    c := core.NewClient("token", s.URL)
+
+    _, err := c.DownloadWithContext(context.Background(), "/file", "*/*", failingWriter{})
+    if err == nil || !strings.HasPrefix(err.Error(), "writing response body") {
+        t.Errorf("got %v, want a write error", err)
+    }
+}
+
+func TestApplyCurrentProject(t *testing.T) {
+    type filter struct {
+        ProjectId *string
+    }
+    type params struct {
+        ProjectId string
+        Filter *filter
+    }
+    c := core.NewClient("token", "")
+    c.SetCurrentProject("current")
+
+    other := "other"
+    set := params{ProjectId: "explicit", Filter: &filter{ProjectId: &other}}
+    c.ApplyCurrentProject(reflect.ValueOf(&set))
+    if set.ProjectId != "explicit" || *set.Filter.ProjectId != "other" || other != "other" {
+        t.Errorf("explicit project ids overwritten: %q, %q", set.ProjectId, *set.Filter.ProjectId)
+    }
+
+    empty := params{}
+    c.ApplyCurrentProject(reflect.ValueOf(&empty))
+    if empty.ProjectId != "current" || empty.Filter == nil || empty.Filter.ProjectId == nil || *empty.Filter.ProjectId != "current" {
+        t.Fatalf("empty project ids not filled: %+v", empty)
+    }
+    *empty.Filter.ProjectId = "changed"
+    again := params{}
+    c.ApplyCurrentProject(reflect.ValueOf(&again))
+    if *again.Filter.ProjectId != "current" {
+        t.Errorf("filled project id shares memory with the client")
+    }
+}
+
+// TestConcurrentRequests shares a fresh client between goroutines, run with -race.
+func TestConcurrentRequests(t *testing.T) {
+    s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(`{"success":true,"data":{}}`))
+    }))
+    defer s.Close()
+    c := core.NewClient("token", s.URL)
+
+    wg := sync.WaitGroup{}
+    errs := make(chan error, 16)
+    for i := 0; i < 8; i++ {
+        wg.Add(2)
+        go func() {
+            defer wg.Done()
+            _, _, err := c.RequestWithContext(context.Background(), http.MethodGet, "/", nil)
+            errs <- err
+        }()
+        go func() {
+            defer wg.Done()
+            _, err := c.DownloadWithContext(context.Background(), "/", "*/*", &bytes.Buffer{})
+            errs <- err
+        }()
+    }
+    wg.Wait()
+    close(errs)
+    for err := range errs {
+        if err != nil {
+            t.Error(err)
+        }
+    }
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "strings"
+)
+
+// APIError is returned when the API responds with success set to false or with a
+// non-JSON error response.
+type APIError struct {
+    StatusCode int
+    TransactionId string
+    Errors []ResponseMessage
+    Warnings []ResponseMessage
+    Infos []ResponseMessage
+}
+
+func newAPIError(res *http.Response, envelope Envelope) *APIError {
+    e := &APIError{
+        TransactionId: envelope.Metadata.TransactionId,
+        Errors: envelope.Messages.Errors,
+        Warnings: envelope.Messages.Warnings,
+        Infos: envelope.Messages.Infos,
+    }
+    if res != nil {
+        e.StatusCode = res.StatusCode
+    }
+    return e
+}
+
+func (e *APIError) Error() string {
+    messages := make([]string, 0, len(e.Errors))
+    for _, m := range e.Errors {
+        if len(m.Key) > 0 {
+            messages = append(messages, m.Key+": "+m.Message)
+        } else {
+            messages = append(messages, m.Message)
+        }
+    }
+    msg := fmt.Sprintf("lumaserv: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+    if len(messages) > 0 {
+        msg += ": " + strings.Join(messages, "; ")
+    }
+    if len(e.TransactionId) > 0 {
+        msg += " (transaction " + e.TransactionId + ")"
+    }
+    return msg
+}
+
+// HasKey reports whether one of the error messages carries the given key.
+func (e *APIError) HasKey(key string) bool {
+    for _, m := range e.Errors {
+        if m.Key == key {
+            return true
+        }
+    }
+    return false
+}
+
+func AsAPIError(err error) (*APIError, bool) {
+    var apiErr *APIError
+    if errors.As(err, &apiErr) {
+        return apiErr, true
+    }
+    return nil, false
+}
+
+func hasStatus(err error, codes ...int) bool {
+    apiErr, ok := AsAPIError(err)
+    if !ok {
+        return false
+    }
+    for _, code := range codes {
+        if apiErr.StatusCode == code {
+            return true
+        }
+    }
+    return false
+}
+
+func IsNotFound(err error) bool {
+    return hasStatus(err, http.StatusNotFound)
+}
+
+func IsUnauthorized(err error) bool {
+    return hasStatus(err, http.StatusUnauthorized)
+}
+
+func IsForbidden(err error) bool {
+    return hasStatus(err, http.StatusForbidden)
+}
+
+func IsConflict(err error) bool {
+    return hasStatus(err, http.StatusConflict)
+}
+
+func IsValidation(err error) bool {
+    return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+func IsRateLimited(err error) bool {
+    return hasStatus(err, http.StatusTooManyRequests)
+}
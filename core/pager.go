@@ -0,0 +1,73 @@
+package core
+
+import (
+    "context"
+)
+
+// PageFunc fetches a single page and returns the number of items it contained along
+// with the pagination info of the response.
+type PageFunc func(ctx context.Context, page int, pageSize *int) (int, *ResponsePagination, error)
+
+// Pager walks the pages of a list endpoint lazily, one request per page, until the
+// total reported by the API has been reached or a page is shorter than the page size.
+type Pager struct {
+    ctx context.Context
+    fetch PageFunc
+    page int
+    pageSize *int
+    done bool
+    err error
+}
+
+// NewPager creates a pager starting at the given page (1 if nil). A nil pageSize leaves
+// the page size up to the API.
+func NewPager(ctx context.Context, page *int, pageSize *int, fetch PageFunc) *Pager {
+    p := &Pager{
+        ctx: ctx,
+        fetch: fetch,
+        page: 1,
+        pageSize: pageSize,
+    }
+    if page != nil && *page > 0 {
+        p.page = *page
+    }
+    return p
+}
+
+// NextPage fetches the next page. It returns false once all pages have been read or
+// an error occurred, which is then available through Err.
+func (p *Pager) NextPage() bool {
+    if p.done {
+        return false
+    }
+    if err := p.ctx.Err(); err != nil {
+        p.err = err
+        p.done = true
+        return false
+    }
+
+    n, pagination, err := p.fetch(p.ctx, p.page, p.pageSize)
+    if err != nil {
+        p.err = err
+        p.done = true
+        return false
+    }
+
+    if n == 0 || pagination == nil {
+        p.done = true
+    } else {
+        size := pagination.PageSize
+        if size <= 0 {
+            size = n
+        }
+        if n < size || (p.page-1)*size+n >= pagination.Total {
+            p.done = true
+        }
+    }
+    p.page++
+    return n > 0
+}
+
+func (p *Pager) Err() error {
+    return p.err
+}
@@ -0,0 +1,113 @@
+package core_test
+
+import (
+    "context"
+    "errors"
+    "reflect"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+func intPtr(v int) *int {
+    return &v
+}
+
+// pages serves the page sizes in counts, reporting total and pageSize as pagination. A
+// negative total omits the pagination block.
+func pages(counts []int, total int, pageSize int, fetched *[]int) core.PageFunc {
+    return func(ctx context.Context, page int, size *int) (int, *core.ResponsePagination, error) {
+        *fetched = append(*fetched, page)
+        if page > len(counts) {
+            return 0, &core.ResponsePagination{Total: total, Page: page, PageSize: pageSize}, nil
+        }
+        if total < 0 {
+            return counts[page-1], nil, nil
+        }
+        return counts[page-1], &core.ResponsePagination{Total: total, Page: page, PageSize: pageSize}, nil
+    }
+}
+
+func TestPager(t *testing.T) {
+    for _, tc := range []struct {
+        name string
+        start *int
+        counts []int
+        total int
+        pageSize int
+        want []int
+    }{
+        {"total reached", nil, []int{2, 2, 2}, 6, 2, []int{1, 2, 3}},
+        {"short last page", nil, []int{2, 2, 1}, 10, 2, []int{1, 2, 3}},
+        {"empty page", nil, []int{2, 2}, 10, 2, []int{1, 2, 3}},
+        {"no pagination", nil, []int{2, 2}, -1, 2, []int{1}},
+        {"no page size", nil, []int{2, 2}, 4, 0, []int{1, 2}},
+        {"start page", intPtr(2), []int{2, 2, 2}, 6, 2, []int{2, 3}},
+        {"start page zero", intPtr(0), []int{2}, 2, 2, []int{1}},
+    } {
+        t.Run(tc.name, func(t *testing.T) {
+            fetched := []int{}
+            p := core.NewPager(context.Background(), tc.start, nil, pages(tc.counts, tc.total, tc.pageSize, &fetched))
+            for p.NextPage() {
+            }
+            if p.Err() != nil {
+                t.Fatal(p.Err())
+            }
+            if !reflect.DeepEqual(fetched, tc.want) {
+                t.Errorf("fetched pages %v, want %v", fetched, tc.want)
+            }
+            if p.NextPage() || len(fetched) != len(tc.want) {
+                t.Errorf("fetched again after the last page")
+            }
+        })
+    }
+}
+
+func TestPagerPassesPageSize(t *testing.T) {
+    size := 3
+    got := []*int{}
+    p := core.NewPager(context.Background(), nil, &size, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        got = append(got, pageSize)
+        return 3, &core.ResponsePagination{Total: 6, PageSize: 3}, nil
+    })
+    for p.NextPage() {
+    }
+    if len(got) != 2 || got[0] != &size || got[1] != &size {
+        t.Errorf("got page sizes %v", got)
+    }
+}
+
+func TestPagerError(t *testing.T) {
+    failure := errors.New("boom")
+    calls := 0
+    p := core.NewPager(context.Background(), nil, nil, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        calls++
+        if page == 2 {
+            return 0, nil, failure
+        }
+        return 1, &core.ResponsePagination{Total: 3, PageSize: 1}, nil
+    })
+    if !p.NextPage() {
+        t.Fatal("first page failed")
+    }
+    if p.NextPage() || p.NextPage() {
+        t.Errorf("continued after an error")
+    }
+    if p.Err() != failure || calls != 2 {
+        t.Errorf("got %v after %d calls", p.Err(), calls)
+    }
+}
+
+func TestPagerCancel(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    calls := 0
+    p := core.NewPager(ctx, nil, nil, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        calls++
+        cancel()
+        return 1, &core.ResponsePagination{Total: 3, PageSize: 1}, nil
+    })
+    for p.NextPage() {
+    }
+    if p.Err() != context.Canceled || calls != 1 {
+        t.Errorf("got %v after %d calls", p.Err(), calls)
+    }
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+    "net/url"
+    "reflect"
+    "strings"
+    "github.com/google/go-querystring/query"
+)
+
+// QueryValues encodes query parameters like query.Values, but also encodes map fields,
+// e.g. label filters, as name[key]=value which go-querystring does not support.
+func QueryValues(qParams interface{}) (url.Values, error) {
+    values, err := query.Values(qParams)
+    if err != nil {
+        return nil, err
+    }
+    encodeMaps(values, reflect.ValueOf(qParams), "")
+    return values, nil
+}
+
+func encodeMaps(values url.Values, val reflect.Value, scope string) {
+    for val.Kind() == reflect.Ptr {
+        if val.IsNil() {
+            return
+        }
+        val = val.Elem()
+    }
+    if val.Kind() != reflect.Struct {
+        return
+    }
+    typ := val.Type()
+    for i := 0; i < typ.NumField(); i++ {
+        field := typ.Field(i)
+        tag := field.Tag.Get("url")
+        if field.PkgPath != "" || tag == "-" {
+            continue
+        }
+        name := strings.Split(tag, ",")[0]
+        if len(name) == 0 {
+            name = field.Name
+        }
+        if len(scope) > 0 {
+            name = scope + "[" + name + "]"
+        }
+        fv := val.Field(i)
+        switch fv.Kind() {
+            case reflect.Ptr, reflect.Struct:
+                encodeMaps(values, fv, name)
+            case reflect.Map:
+                values.Del(name)
+                if fv.Type().Key().Kind() != reflect.String {
+                    continue
+                }
+                iter := fv.MapRange()
+                for iter.Next() {
+                    v := iter.Value()
+                    for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+                        if v.IsNil() {
+                            break
+                        }
+                        v = v.Elem()
+                    }
+                    value := ""
+                    if v.Kind() == reflect.String {
+                        value = v.String()
+                    }
+                    values.Add(name+"["+iter.Key().String()+"]", value)
+                }
+        }
+    }
+}
@@ -0,0 +1,31 @@
+package core
+
+type ResponseMessages struct {
+    Warnings []ResponseMessage `json:"warnings"`
+    Errors []ResponseMessage `json:"errors"`
+    Infos []ResponseMessage `json:"infos"`
+}
+
+type ResponseMessage struct {
+    Message string `json:"message"`
+    Key string `json:"key"`
+}
+
+type ResponsePagination struct {
+    Total int `json:"total"`
+    Page int `json:"page"`
+    PageSize int `json:"page_size"`
+}
+
+type ResponseMetadata struct {
+    TransactionId string `json:"transaction_id"`
+    BuildCommit string `json:"build_commit"`
+    BuildTimestamp string `json:"build_timestamp"`
+}
+
+// Envelope holds the fields every API response shares, independent of its data payload.
+type Envelope struct {
+    Metadata ResponseMetadata `json:"metadata"`
+    Success bool `json:"success"`
+    Messages ResponseMessages `json:"messages"`
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+    "errors"
+    "io"
+    "math/rand"
+    "net"
+    "net/http"
+    "strconv"
+    "syscall"
+    "time"
+)
+
+// RetryPolicy controls how failed requests are retried. The zero value disables retries.
+type RetryPolicy struct {
+    // MaxAttempts is the total number of attempts including the first one.
+    MaxAttempts int
+    // MinBackoff is the delay before the first retry, doubled for every further attempt.
+    MinBackoff time.Duration
+    // MaxBackoff caps the delay between attempts, including delays requested via Retry-After.
+    MaxBackoff time.Duration
+    // Jitter is the fraction (0-1) of each delay that is randomized.
+    Jitter float64
+    // RetryNonIdempotent allows retrying POST and PATCH requests as well.
+    RetryNonIdempotent bool
+    // RetryStatusCodes lists the HTTP status codes that are retried. Defaults to 429, 502, 503 and 504.
+    RetryStatusCodes []int
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+    return RetryPolicy{
+        MaxAttempts: 4,
+        MinBackoff: time.Millisecond * 500,
+        MaxBackoff: time.Second * 30,
+        Jitter: 0.2,
+    }
+}
+
+func (p RetryPolicy) retryableMethod(method string) bool {
+    switch method {
+        case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+            return true
+    }
+    return p.RetryNonIdempotent
+}
+
+func (p RetryPolicy) retryableStatus(code int) bool {
+    codes := p.RetryStatusCodes
+    if codes == nil {
+        codes = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+    }
+    for _, c := range codes {
+        if c == code {
+            return true
+        }
+    }
+    return false
+}
+
+func (p RetryPolicy) retryableError(err error) bool {
+    var netErr net.Error
+    if errors.As(err, &netErr) {
+        return true
+    }
+    return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns the delay before the given retry (starting at 1), preferring the
+// Retry-After header of res if present.
+func (p RetryPolicy) backoff(retry int, res *http.Response) time.Duration {
+    if res != nil {
+        if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+            if p.MaxBackoff > 0 && d > p.MaxBackoff {
+                d = p.MaxBackoff
+            }
+            return d
+        }
+    }
+
+    d := p.MinBackoff
+    for i := 1; i < retry; i++ {
+        d *= 2
+        if p.MaxBackoff > 0 && d > p.MaxBackoff {
+            break
+        }
+    }
+    if p.MaxBackoff > 0 && d > p.MaxBackoff {
+        d = p.MaxBackoff
+    }
+    if p.Jitter > 0 {
+        delta := float64(d) * p.Jitter
+        d = time.Duration(float64(d) - delta + rand.Float64()*2*delta)
+    }
+    return d
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+    if len(value) == 0 {
+        return 0, false
+    }
+    if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+        return time.Duration(seconds) * time.Second, true
+    }
+    if t, err := http.ParseTime(value); err == nil {
+        d := time.Until(t)
+        if d < 0 {
+            d = 0
+        }
+        return d, true
+    }
+    return 0, false
+}
@@ -0,0 +1,190 @@
+package core_test
+
+import (
+    "context"
+    "net/http"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+const zones = "/domain/dns/zones"
+
+func newRetryServer() (*lumaservtest.Server, *core.Client) {
+    s := lumaservtest.NewServer()
+    s.Seed(zones, domain.DNSZone{Name: "example.com"})
+    s.Seed(zones+"/example.com/records", domain.DNSRecord{Id: "r1", Name: "www", Type: "A", Data: "192.0.2.1"})
+    return s, core.NewClient(s.Token, s.ServiceUrl("domain"))
+}
+
+// fastRetries is the default policy with backoffs short enough for tests.
+func fastRetries() core.RetryPolicy {
+    policy := core.DefaultRetryPolicy()
+    policy.MinBackoff = time.Millisecond
+    policy.MaxBackoff = time.Millisecond * 10
+    return policy
+}
+
+func attempts(s *lumaservtest.Server, method string, path string) int {
+    n := 0
+    for _, r := range s.Requests() {
+        if r.Method == method && r.Path == path {
+            n++
+        }
+    }
+    return n
+}
+
+func TestRetryStatusCodes(t *testing.T) {
+    for _, tt := range []struct {
+        status int
+        attempts int
+        ok bool
+    }{
+        {http.StatusServiceUnavailable, 3, true},
+        {http.StatusTooManyRequests, 3, true},
+        {http.StatusBadGateway, 3, true},
+        {http.StatusInternalServerError, 1, false},
+        {http.StatusNotFound, 1, false},
+    } {
+        s, c := newRetryServer()
+        c.SetRetryPolicy(fastRetries())
+        s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: tt.status, Count: 2})
+
+        res, err := c.DoWithContext(context.Background(), "GET", "/dns/zones", nil, nil, nil)
+        if (err == nil) != tt.ok {
+            t.Errorf("%d: got %v", tt.status, err)
+        }
+        if tt.ok && res.StatusCode != http.StatusOK {
+            t.Errorf("%d: got status %d after retrying", tt.status, res.StatusCode)
+        }
+        if n := attempts(s, "GET", zones); n != tt.attempts {
+            t.Errorf("%d: got %d attempts, want %d", tt.status, n, tt.attempts)
+        }
+        s.Close()
+    }
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+    s, c := newRetryServer()
+    defer s.Close()
+    c.SetRetryPolicy(fastRetries())
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: http.StatusServiceUnavailable})
+
+    _, err := c.DoWithContext(context.Background(), "GET", "/dns/zones", nil, nil, nil)
+    if apiErr, ok := core.AsAPIError(err); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+        t.Errorf("got %v, want the last 503", err)
+    }
+    if n := attempts(s, "GET", zones); n != 4 {
+        t.Errorf("got %d attempts, want 4", n)
+    }
+}
+
+func TestZeroRetryPolicy(t *testing.T) {
+    s, c := newRetryServer()
+    defer s.Close()
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: http.StatusServiceUnavailable, Count: 1})
+
+    if _, err := c.DoWithContext(context.Background(), "GET", "/dns/zones", nil, nil, nil); err == nil {
+        t.Errorf("the zero policy retried")
+    }
+    if n := attempts(s, "GET", zones); n != 1 {
+        t.Errorf("got %d attempts, want 1", n)
+    }
+}
+
+func TestRetryAfter(t *testing.T) {
+    s, c := newRetryServer()
+    defer s.Close()
+
+    // Retry-After takes precedence over the exponential backoff.
+    policy := fastRetries()
+    policy.MinBackoff = time.Hour
+    policy.MaxBackoff = time.Hour
+    c.SetRetryPolicy(policy)
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"0"}}, Count: 1})
+    start := time.Now()
+    if _, err := c.DoWithContext(context.Background(), "GET", "/dns/zones", nil, nil, nil); err != nil {
+        t.Fatal(err)
+    }
+    if d := time.Since(start); d > time.Second {
+        t.Errorf("waited %v despite Retry-After: 0", d)
+    }
+
+    // MaxBackoff caps the delay requested by the server.
+    policy.MaxBackoff = time.Millisecond * 20
+    c.SetRetryPolicy(policy)
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {"3600"}}, Count: 1})
+    start = time.Now()
+    if _, err := c.DoWithContext(context.Background(), "GET", "/dns/zones", nil, nil, nil); err != nil {
+        t.Fatal(err)
+    }
+    if d := time.Since(start); d < time.Millisecond*20 || d > time.Second {
+        t.Errorf("waited %v, want MaxBackoff", d)
+    }
+    if n := attempts(s, "GET", zones); n != 4 {
+        t.Errorf("got %d attempts, want 4", n)
+    }
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+    records := zones + "/example.com/records"
+    for _, tt := range []struct {
+        method string
+        path string
+        body interface{}
+    }{
+        {"POST", records, domain.DNSRecordCreateRequest{Name: "mail", Type: "A", Data: "192.0.2.2"}},
+        {"PATCH", records + "/r1", map[string]string{"data": "192.0.2.3"}},
+    } {
+        s, c := newRetryServer()
+        c.SetRetryPolicy(fastRetries())
+        s.InjectFault(lumaservtest.Fault{Method: tt.method, Path: tt.path, StatusCode: http.StatusServiceUnavailable, Count: 1})
+        if _, err := c.DoWithContext(context.Background(), tt.method, tt.path[len("/domain"):], nil, tt.body, nil); err == nil {
+            t.Errorf("%s was retried", tt.method)
+        }
+        if n := attempts(s, tt.method, tt.path); n != 1 {
+            t.Errorf("%s: got %d attempts, want 1", tt.method, n)
+        }
+
+        policy := fastRetries()
+        policy.RetryNonIdempotent = true
+        c.SetRetryPolicy(policy)
+        s.InjectFault(lumaservtest.Fault{Method: tt.method, Path: tt.path, StatusCode: http.StatusServiceUnavailable, Count: 1})
+        // The fake has no PATCH routes, so only the 503 matters, not the final outcome.
+        _, err := c.DoWithContext(context.Background(), tt.method, tt.path[len("/domain"):], nil, tt.body, nil)
+        if apiErr, ok := core.AsAPIError(err); ok && apiErr.StatusCode == http.StatusServiceUnavailable {
+            t.Errorf("%s with RetryNonIdempotent was not retried: %v", tt.method, err)
+        }
+        if n := attempts(s, tt.method, tt.path); n != 3 {
+            t.Errorf("%s: got %d attempts, want 3", tt.method, n)
+        }
+        s.Close()
+    }
+}
+
+func TestRetryCancelDuringBackoff(t *testing.T) {
+    s, c := newRetryServer()
+    defer s.Close()
+    policy := fastRetries()
+    policy.MinBackoff = time.Hour
+    policy.MaxBackoff = time.Hour
+    c.SetRetryPolicy(policy)
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: zones, StatusCode: http.StatusServiceUnavailable})
+
+    ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+    defer cancel()
+    start := time.Now()
+    _, err := c.DoWithContext(ctx, "GET", "/dns/zones", nil, nil, nil)
+    if d := time.Since(start); d > time.Second {
+        t.Errorf("returned %v after the context was done", d)
+    }
+    if apiErr, ok := core.AsAPIError(err); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+        t.Errorf("got %v, want the 503 of the only attempt", err)
+    }
+    if n := attempts(s, "GET", zones); n != 1 {
+        t.Errorf("got %d attempts, want 1", n)
+    }
+}
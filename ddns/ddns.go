@@ -0,0 +1,259 @@
+package ddns
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "strings"
+    "sync"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+// Config configures an Updater. Zero durations fall back to an interval of 5 minutes and
+// a backoff between 10 seconds and 30 minutes.
+type Config struct {
+    Zone string
+    // Hostnames are the record names relative to the zone, an empty name is the apex.
+    Hostnames []string
+    // IPv4 and IPv6 detect the current addresses, a nil source disables the family.
+    IPv4 Source
+    IPv6 Source
+    Ttl *int
+    Interval time.Duration
+    MinBackoff time.Duration
+    MaxBackoff time.Duration
+    // OnUpdate is called for every record that was created or updated.
+    OnUpdate func(record domain.DNSRecord)
+    // OnError is called for errors in Run before backing off.
+    OnError func(err error)
+}
+
+func (c Config) withDefaults() Config {
+    if c.Interval <= 0 {
+        c.Interval = time.Minute * 5
+    }
+    if c.MinBackoff <= 0 {
+        c.MinBackoff = time.Second * 10
+    }
+    if c.MaxBackoff <= 0 {
+        c.MaxBackoff = time.Minute * 30
+    }
+    return c
+}
+
+// Updater keeps the A and AAAA records of hostnames in a zone pointed at the current
+// addresses of the host.
+type Updater struct {
+    client domain.API
+    config Config
+    mutex sync.Mutex
+    // applied holds the last address successfully applied per record type.
+    applied map[string]string
+}
+
+func NewUpdater(client domain.API, config Config) *Updater {
+    return &Updater{
+        client: client,
+        config: config.withDefaults(),
+        applied: map[string]string{},
+    }
+}
+
+// UpdateError collects the errors of the address families that could not be detected
+// or applied. The other families are updated nevertheless.
+type UpdateError struct {
+    Errors []error
+    // Partial is set if at least one family was detected and applied successfully.
+    Partial bool
+}
+
+func (e *UpdateError) Error() string {
+    msgs := []string{}
+    for _, err := range e.Errors {
+        msgs = append(msgs, err.Error())
+    }
+    return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the collected errors matches target.
+func (e *UpdateError) Is(target error) bool {
+    for _, err := range e.Errors {
+        if errors.Is(err, target) {
+            return true
+        }
+    }
+    return false
+}
+
+// As finds the first of the collected errors that matches target.
+func (e *UpdateError) As(target interface{}) bool {
+    for _, err := range e.Errors {
+        if errors.As(err, target) {
+            return true
+        }
+    }
+    return false
+}
+
+// Update detects the current addresses and updates the records of every family whose
+// address changed since its last successful update. Each family is detected and applied
+// on its own, so an IPv4-only host still gets its A records updated. It returns the
+// records that were changed and an *UpdateError if a family failed.
+func (u *Updater) Update(ctx context.Context) ([]domain.DNSRecord, error) {
+    u.mutex.Lock()
+    defer u.mutex.Unlock()
+
+    errs := []error{}
+    succeeded := 0
+    addresses := map[string]string{}
+    sources := map[string]Source{"A": u.config.IPv4, "AAAA": u.config.IPv6}
+    for _, recordType := range []string{"A", "AAAA"} {
+        source := sources[recordType]
+        if source == nil {
+            continue
+        }
+        ip, err := source.Detect(ctx)
+        if err != nil {
+            errs = append(errs, fmt.Errorf("detecting %s address: %w", recordType, err))
+            continue
+        }
+        if ip.String() != u.applied[recordType] {
+            addresses[recordType] = ip.String()
+        } else {
+            succeeded++
+        }
+    }
+    if len(addresses) == 0 {
+        return []domain.DNSRecord{}, updateError(errs, succeeded)
+    }
+
+    records, err := u.client.ListAllDNSZoneRecords(ctx, u.config.Zone, domain.GetDNSZoneRecordsQueryParams{}).All()
+    if err != nil {
+        return nil, updateError(append(errs, err), 0)
+    }
+
+    changed := []domain.DNSRecord{}
+    for _, recordType := range []string{"A", "AAAA"} {
+        address, ok := addresses[recordType]
+        if !ok {
+            continue
+        }
+        failed := false
+        for _, hostname := range u.config.Hostnames {
+            updated, err := u.apply(ctx, records, hostname, recordType, address)
+            for _, record := range updated {
+                changed = append(changed, record)
+                if u.config.OnUpdate != nil {
+                    u.config.OnUpdate(record)
+                }
+            }
+            if err != nil {
+                errs = append(errs, err)
+                failed = true
+            }
+        }
+        if !failed {
+            u.applied[recordType] = address
+            succeeded++
+        }
+    }
+    return changed, updateError(errs, succeeded)
+}
+
+func updateError(errs []error, succeeded int) error {
+    if len(errs) == 0 {
+        return nil
+    }
+    return &UpdateError{Errors: errs, Partial: succeeded > 0}
+}
+
+// apply points all records of the hostname and type at the address, creating one if there
+// is none. Duplicate records are all updated.
+func (u *Updater) apply(ctx context.Context, records []domain.DNSRecord, hostname string, recordType string, address string) ([]domain.DNSRecord, error) {
+    changed := []domain.DNSRecord{}
+    found := false
+    for _, r := range records {
+        if !strings.EqualFold(r.Type, recordType) || !sameName(r.Name, hostname) {
+            continue
+        }
+        found = true
+        if r.Data == address {
+            continue
+        }
+        ttl := r.Ttl
+        if u.config.Ttl != nil {
+            ttl = u.config.Ttl
+        }
+        in := domain.DNSRecordUpdateRequest{Name: r.Name, Type: r.Type, Data: address, Ttl: ttl}
+        res, _, err := u.client.UpdateDNSRecordWithContext(ctx, in, u.config.Zone, r.Id)
+        if err != nil {
+            return changed, fmt.Errorf("updating %s record %q: %w", recordType, hostname, err)
+        }
+        changed = append(changed, res.Data)
+    }
+    if found {
+        return changed, nil
+    }
+
+    in := domain.DNSRecordCreateRequest{Name: hostname, Type: recordType, Data: address, Ttl: u.config.Ttl}
+    res, _, err := u.client.CreateDNSZoneRecordWithContext(ctx, in, u.config.Zone)
+    if err != nil {
+        return changed, fmt.Errorf("creating %s record %q: %w", recordType, hostname, err)
+    }
+    return append(changed, res.Data), nil
+}
+
+func sameName(a string, b string) bool {
+    if a == "@" {
+        a = ""
+    }
+    if b == "@" {
+        b = ""
+    }
+    return strings.EqualFold(a, b)
+}
+
+// Run calls Update every interval until ctx is done. Errors are reported to OnError and
+// retried with an exponential backoff, unless another family was updated successfully.
+func (u *Updater) Run(ctx context.Context) error {
+    failures := 0
+    for {
+        delay := u.config.Interval
+        _, err := u.Update(ctx)
+        if err != nil {
+            if ctx.Err() != nil {
+                return ctx.Err()
+            }
+            if u.config.OnError != nil {
+                u.config.OnError(err)
+            }
+        }
+        var updateErr *UpdateError
+        if err != nil && !(errors.As(err, &updateErr) && updateErr.Partial) {
+            delay = u.backoff(failures)
+            failures++
+        } else {
+            failures = 0
+        }
+
+        timer := time.NewTimer(delay)
+        select {
+            case <-ctx.Done():
+                timer.Stop()
+                return ctx.Err()
+            case <-timer.C:
+        }
+    }
+}
+
+func (u *Updater) backoff(failures int) time.Duration {
+    delay := u.config.MinBackoff
+    for i := 0; i < failures && delay < u.config.MaxBackoff; i++ {
+        delay *= 2
+    }
+    if delay > u.config.MaxBackoff {
+        delay = u.config.MaxBackoff
+    }
+    return delay
+}
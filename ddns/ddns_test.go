@@ -0,0 +1,141 @@
+package ddns_test
+
+import (
+    "context"
+    "errors"
+    "net"
+    "net/http"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/ddns"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/domain/domainmock"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func staticSource(ip string) ddns.Source {
+    return ddns.SourceFunc(func(ctx context.Context) (net.IP, error) {
+        return net.ParseIP(ip), nil
+    })
+}
+
+var errUnreachable = errors.New("network is unreachable")
+
+var failingSource = ddns.SourceFunc(func(ctx context.Context) (net.IP, error) {
+    return nil, errUnreachable
+})
+
+const records = "/domain/dns/zones/example.com/records"
+
+func newServer() *lumaservtest.Server {
+    s := lumaservtest.NewServer()
+    s.Seed("/domain/dns/zones", domain.DNSZone{Name: "example.com"})
+    return s
+}
+
+func TestUpdateWithoutIPv6(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    s.Seed(records, domain.DNSRecord{Id: "r1", Name: "home", Type: "A", Data: "192.0.2.1"})
+
+    u := ddns.NewUpdater(domain.NewClientWithUrl("token", s.ServiceUrl("domain")), ddns.Config{
+        Zone: "example.com",
+        Hostnames: []string{"home"},
+        IPv4: staticSource("192.0.2.2"),
+        IPv6: failingSource,
+    })
+    changed, err := u.Update(context.Background())
+    updateErr := &ddns.UpdateError{}
+    if !errors.As(err, &updateErr) || len(updateErr.Errors) != 1 || !updateErr.Partial {
+        t.Fatalf("got %v, want a partial error for the AAAA record", err)
+    }
+    if len(changed) != 1 || s.Items(records)[0]["data"] != "192.0.2.2" {
+        t.Errorf("A record not updated: %+v", s.Items(records))
+    }
+}
+
+func TestUpdateErrorMatchesEveryFamily(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: records, StatusCode: 500})
+
+    u := ddns.NewUpdater(domain.NewClientWithUrl("token", s.ServiceUrl("domain")), ddns.Config{
+        Zone: "example.com",
+        Hostnames: []string{"home"},
+        IPv4: staticSource("192.0.2.2"),
+        IPv6: failingSource,
+    })
+    _, err := u.Update(context.Background())
+    if !errors.Is(err, errUnreachable) {
+        t.Errorf("got %v, want it to match the failed AAAA detection", err)
+    }
+    if apiErr, ok := core.AsAPIError(err); !ok || apiErr.StatusCode != 500 {
+        t.Errorf("got %v, want it to match the failed record listing", err)
+    }
+    if errors.Is(err, context.Canceled) {
+        t.Errorf("%v matches an error it does not contain", err)
+    }
+}
+
+func TestUpdateWithMock(t *testing.T) {
+    updated := []string{}
+    m := &domainmock.Mock{
+        GetDNSZoneRecordsFunc: func(ctx context.Context, name string, qParams domain.GetDNSZoneRecordsQueryParams) (domain.DNSRecordListResponse, *http.Response, error) {
+            if qParams.Page != nil && *qParams.Page > 1 {
+                return domain.DNSRecordListResponse{}, nil, nil
+            }
+            return domain.DNSRecordListResponse{Data: []domain.DNSRecord{{Id: "r1", Name: "home", Type: "A", Data: "192.0.2.1"}}}, nil, nil
+        },
+        UpdateDNSRecordFunc: func(ctx context.Context, in domain.DNSRecordUpdateRequest, name string, id string) (domain.DNSRecordSingleResponse, *http.Response, error) {
+            updated = append(updated, id)
+            return domain.DNSRecordSingleResponse{Data: domain.DNSRecord{Id: id, Name: "home", Type: "A", Data: "192.0.2.2"}}, nil, nil
+        },
+    }
+    u := ddns.NewUpdater(m, ddns.Config{
+        Zone: "example.com",
+        Hostnames: []string{"home"},
+        IPv4: staticSource("192.0.2.2"),
+    })
+    if _, err := u.Update(context.Background()); err != nil {
+        t.Fatal(err)
+    }
+    if len(updated) != 1 || updated[0] != "r1" || len(m.CallsTo("CreateDNSZoneRecord")) != 0 {
+        t.Errorf("got updates %v and calls %+v", updated, m.Calls())
+    }
+}
+
+func TestUpdateDuplicates(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    s.Seed(records,
+        domain.DNSRecord{Id: "r1", Name: "home", Type: "A", Data: "192.0.2.1"},
+        domain.DNSRecord{Id: "r2", Name: "home", Type: "A", Data: "192.0.2.9"},
+        domain.DNSRecord{Id: "r3", Name: "home", Type: "A", Data: "192.0.2.2"},
+    )
+
+    u := ddns.NewUpdater(domain.NewClientWithUrl("token", s.ServiceUrl("domain")), ddns.Config{
+        Zone: "example.com",
+        Hostnames: []string{"home", "vpn"},
+        IPv4: staticSource("192.0.2.2"),
+    })
+    changed, err := u.Update(context.Background())
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(changed) != 3 {
+        t.Errorf("got %d changed records, want 2 updated and 1 created", len(changed))
+    }
+    for _, item := range s.Items(records) {
+        if item["data"] != "192.0.2.2" {
+            t.Errorf("record %v not updated", item)
+        }
+    }
+    if n := len(s.Items(records)); n != 4 {
+        t.Errorf("got %d records, want 4", n)
+    }
+
+    changed, err = u.Update(context.Background())
+    if err != nil || len(changed) != 0 {
+        t.Errorf("unchanged address updated again: %v, %v", changed, err)
+    }
+}
@@ -0,0 +1,104 @@
+package ddns
+
+import (
+    "context"
+    "fmt"
+    "io/ioutil"
+    "net"
+    "net/http"
+    "strings"
+    "time"
+)
+
+// Source detects the current public address of the host.
+type Source interface {
+    Detect(ctx context.Context) (net.IP, error)
+}
+
+// SourceFunc adapts a function to a Source.
+type SourceFunc func(ctx context.Context) (net.IP, error)
+
+func (f SourceFunc) Detect(ctx context.Context) (net.IP, error) {
+    return f(ctx)
+}
+
+const (
+    DefaultIPv4Url = "https://api.ipify.org"
+    DefaultIPv6Url = "https://api6.ipify.org"
+)
+
+// HTTPSource requests url and parses the response body as address. The connection is made
+// over the given network, "tcp4" or "tcp6", so that the service sees the right family.
+func HTTPSource(network string, url string) Source {
+    dialer := &net.Dialer{Timeout: time.Second * 10}
+    client := &http.Client{
+        Timeout: time.Second * 30,
+        Transport: &http.Transport{
+            DialContext: func(ctx context.Context, _ string, address string) (net.Conn, error) {
+                return dialer.DialContext(ctx, network, address)
+            },
+        },
+    }
+    return SourceFunc(func(ctx context.Context) (net.IP, error) {
+        req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+        if err != nil {
+            return nil, err
+        }
+        res, err := client.Do(req)
+        if err != nil {
+            return nil, err
+        }
+        defer res.Body.Close()
+        if res.StatusCode != http.StatusOK {
+            return nil, fmt.Errorf("%s returned status %d", url, res.StatusCode)
+        }
+        body, err := ioutil.ReadAll(http.MaxBytesReader(nil, res.Body, 1024))
+        if err != nil {
+            return nil, err
+        }
+        return parseAddress(network, strings.TrimSpace(string(body)))
+    })
+}
+
+// InterfaceSource returns the first global unicast address of the network interface.
+func InterfaceSource(network string, name string) Source {
+    return SourceFunc(func(ctx context.Context) (net.IP, error) {
+        iface, err := net.InterfaceByName(name)
+        if err != nil {
+            return nil, err
+        }
+        addrs, err := iface.Addrs()
+        if err != nil {
+            return nil, err
+        }
+        for _, addr := range addrs {
+            ipNet, ok := addr.(*net.IPNet)
+            if !ok || !ipNet.IP.IsGlobalUnicast() {
+                continue
+            }
+            if ip, err := parseAddress(network, ipNet.IP.String()); err == nil {
+                return ip, nil
+            }
+        }
+        return nil, fmt.Errorf("no %s address on interface %s", network, name)
+    })
+}
+
+// StaticSource always returns ip, which is useful for testing and one-off updates.
+func StaticSource(ip net.IP) Source {
+    return SourceFunc(func(ctx context.Context) (net.IP, error) {
+        return ip, nil
+    })
+}
+
+func parseAddress(network string, value string) (net.IP, error) {
+    ip := net.ParseIP(value)
+    if ip == nil {
+        return nil, fmt.Errorf("invalid address %q", value)
+    }
+    isV4 := ip.To4() != nil
+    if network == "tcp4" && !isV4 || network == "tcp6" && isV4 {
+        return nil, fmt.Errorf("address %s does not match network %s", value, network)
+    }
+    return ip, nil
+}
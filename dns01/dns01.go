@@ -0,0 +1,239 @@
+package dns01
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/base64"
+    "fmt"
+    "net"
+    "strings"
+    "sync"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+// Options configures a Provider. Zero values fall back to a TTL of 60 seconds, a
+// propagation timeout of 2 minutes and a poll interval of 5 seconds.
+type Options struct {
+    Ttl int
+    PropagationTimeout time.Duration
+    PollInterval time.Duration
+    // SkipPropagationCheck makes Present return as soon as the record was created.
+    SkipPropagationCheck bool
+    // Nameservers overrides the Ns1 and Ns2 servers of the zone for the propagation check.
+    Nameservers []string
+    // Lookup queries the TXT records of fqdn at the given nameserver. It defaults to a
+    // direct query on port 53.
+    Lookup func(ctx context.Context, nameserver string, fqdn string) ([]string, error)
+}
+
+func (o Options) withDefaults() Options {
+    if o.Ttl <= 0 {
+        o.Ttl = 60
+    }
+    if o.PropagationTimeout <= 0 {
+        o.PropagationTimeout = time.Minute * 2
+    }
+    if o.PollInterval <= 0 {
+        o.PollInterval = time.Second * 5
+    }
+    if o.Lookup == nil {
+        o.Lookup = lookupTXT
+    }
+    return o
+}
+
+type challengeRecord struct {
+    zone string
+    id string
+}
+
+// Provider solves ACME DNS-01 challenges with TXT records in LUMASERV DNS zones. Its
+// Present and CleanUp methods match the challenge provider interface of common ACME
+// libraries like lego.
+type Provider struct {
+    client domain.API
+    opts Options
+    mutex sync.Mutex
+    records map[string]challengeRecord
+}
+
+func NewProvider(client domain.API, opts Options) *Provider {
+    return &Provider{
+        client: client,
+        opts: opts.withDefaults(),
+        records: map[string]challengeRecord{},
+    }
+}
+
+// ChallengeRecord returns the name and value of the TXT record for a challenge.
+func ChallengeRecord(domainName string, keyAuth string) (string, string) {
+    hash := sha256.Sum256([]byte(keyAuth))
+    fqdn := "_acme-challenge." + strings.TrimSuffix(strings.TrimPrefix(domainName, "*."), ".") + "."
+    return fqdn, base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Timeout returns the propagation timeout and poll interval, so that ACME libraries wait
+// long enough for Present.
+func (p *Provider) Timeout() (time.Duration, time.Duration) {
+    return p.opts.PropagationTimeout, p.opts.PollInterval
+}
+
+func (p *Provider) Present(domainName string, token string, keyAuth string) error {
+    return p.PresentWithContext(context.Background(), domainName, token, keyAuth)
+}
+
+// PresentWithContext creates the challenge TXT record and waits until it is served by
+// the authoritative nameservers of the zone.
+func (p *Provider) PresentWithContext(ctx context.Context, domainName string, token string, keyAuth string) error {
+    fqdn, value := ChallengeRecord(domainName, keyAuth)
+    zone, err := p.FindZone(ctx, fqdn)
+    if err != nil {
+        return err
+    }
+
+    ttl := p.opts.Ttl
+    in := domain.DNSRecordCreateRequest{
+        Name: recordName(fqdn, zone.Name),
+        Type: "TXT",
+        Data: value,
+        Ttl: &ttl,
+    }
+    res, _, err := p.client.CreateDNSZoneRecordWithContext(ctx, in, zone.Name)
+    if err != nil {
+        return fmt.Errorf("creating TXT record %s: %w", fqdn, err)
+    }
+    p.mutex.Lock()
+    p.records[fqdn+" "+value] = challengeRecord{zone: zone.Name, id: res.Data.Id}
+    p.mutex.Unlock()
+
+    if p.opts.SkipPropagationCheck {
+        return nil
+    }
+    nameservers := p.opts.Nameservers
+    if len(nameservers) == 0 {
+        for _, ns := range []string{zone.Ns1, zone.Ns2} {
+            if len(ns) > 0 {
+                nameservers = append(nameservers, ns)
+            }
+        }
+    }
+    return p.waitForPropagation(ctx, fqdn, value, nameservers)
+}
+
+func (p *Provider) CleanUp(domainName string, token string, keyAuth string) error {
+    return p.CleanUpWithContext(context.Background(), domainName, token, keyAuth)
+}
+
+// CleanUpWithContext removes the challenge TXT record. Records not created by this
+// provider instance are looked up by name and value.
+func (p *Provider) CleanUpWithContext(ctx context.Context, domainName string, token string, keyAuth string) error {
+    fqdn, value := ChallengeRecord(domainName, keyAuth)
+    p.mutex.Lock()
+    record, ok := p.records[fqdn+" "+value]
+    delete(p.records, fqdn+" "+value)
+    p.mutex.Unlock()
+
+    if !ok {
+        zone, err := p.FindZone(ctx, fqdn)
+        if err != nil {
+            return err
+        }
+        name := recordName(fqdn, zone.Name)
+        it := p.client.ListAllDNSZoneRecords(ctx, zone.Name, domain.GetDNSZoneRecordsQueryParams{})
+        for it.Next() {
+            r := it.Value()
+            if strings.EqualFold(r.Type, "TXT") && strings.EqualFold(r.Name, name) && strings.Trim(r.Data, "\"") == value {
+                record = challengeRecord{zone: zone.Name, id: r.Id}
+                ok = true
+                break
+            }
+        }
+        if err := it.Err(); err != nil {
+            return err
+        }
+        if !ok {
+            return nil
+        }
+    }
+
+    _, _, err := p.client.DeleteDNSRecordWithContext(ctx, record.zone, record.id)
+    if err != nil && !core.IsNotFound(err) {
+        return fmt.Errorf("deleting TXT record %s: %w", fqdn, err)
+    }
+    return nil
+}
+
+// FindZone returns the closest DNS zone containing fqdn by trying its parent domains
+// from the most specific one.
+func (p *Provider) FindZone(ctx context.Context, fqdn string) (domain.DNSZone, error) {
+    labels := strings.Split(strings.TrimSuffix(fqdn, "."), ".")
+    for i := 0; i < len(labels)-1; i++ {
+        name := strings.Join(labels[i:], ".")
+        res, _, err := p.client.GetDNSZoneWithContext(ctx, name)
+        if err == nil {
+            return res.Data, nil
+        }
+        if !core.IsNotFound(err) {
+            return domain.DNSZone{}, err
+        }
+    }
+    return domain.DNSZone{}, fmt.Errorf("no dns zone found for %s", fqdn)
+}
+
+func recordName(fqdn string, zone string) string {
+    name := strings.TrimSuffix(fqdn, ".")
+    zone = strings.TrimSuffix(zone, ".")
+    if len(name) > len(zone) && strings.EqualFold(name[len(name)-len(zone):], zone) {
+        return name[:len(name)-len(zone)-1]
+    }
+    return ""
+}
+
+func (p *Provider) waitForPropagation(ctx context.Context, fqdn string, value string, nameservers []string) error {
+    ctx, cancel := context.WithTimeout(ctx, p.opts.PropagationTimeout)
+    defer cancel()
+
+    ticker := time.NewTicker(p.opts.PollInterval)
+    defer ticker.Stop()
+    pending := nameservers
+    for {
+        remaining := []string{}
+        for _, ns := range pending {
+            values, err := p.opts.Lookup(ctx, ns, fqdn)
+            if err != nil || !contains(values, value) {
+                remaining = append(remaining, ns)
+            }
+        }
+        pending = remaining
+        if len(pending) == 0 {
+            return nil
+        }
+        select {
+            case <-ctx.Done():
+                return fmt.Errorf("TXT record %s not propagated to %s: %w", fqdn, strings.Join(pending, ", "), ctx.Err())
+            case <-ticker.C:
+        }
+    }
+}
+
+func contains(values []string, value string) bool {
+    for _, v := range values {
+        if v == value {
+            return true
+        }
+    }
+    return false
+}
+
+func lookupTXT(ctx context.Context, nameserver string, fqdn string) ([]string, error) {
+    resolver := &net.Resolver{
+        PreferGo: true,
+        Dial: func(ctx context.Context, network string, address string) (net.Conn, error) {
+            d := net.Dialer{Timeout: time.Second * 5}
+            return d.DialContext(ctx, network, net.JoinHostPort(strings.TrimSuffix(nameserver, "."), "53"))
+        },
+    }
+    return resolver.LookupTXT(ctx, fqdn)
+}
@@ -0,0 +1,184 @@
+package dns01_test
+
+import (
+    "context"
+    "errors"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/dns01"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+const records = "/domain/dns/zones/example.com/records"
+
+func newServer() *lumaservtest.Server {
+    s := lumaservtest.NewServer()
+    s.Seed("/domain/dns/zones",
+        domain.DNSZone{Name: "example.com", Ns1: "ns1.example.net.", Ns2: "ns2.example.net."},
+        domain.DNSZone{Name: "sub.example.com", Ns1: "ns1.example.net."},
+    )
+    return s
+}
+
+// fakeDNS serves the TXT values set per nameserver and counts the lookups.
+type fakeDNS struct {
+    mutex sync.Mutex
+    values map[string][]string
+    lookups int
+}
+
+func (f *fakeDNS) set(nameserver string, values ...string) {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+    f.values[nameserver] = values
+}
+
+func (f *fakeDNS) lookup(ctx context.Context, nameserver string, fqdn string) ([]string, error) {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+    f.lookups++
+    if values, ok := f.values[nameserver]; ok {
+        return values, nil
+    }
+    return nil, errors.New("no such host")
+}
+
+func TestFindZone(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    p := dns01.NewProvider(s.DomainClient(), dns01.Options{})
+
+    tests := []struct {
+        fqdn string
+        zone string
+    }{
+        {"_acme-challenge.www.example.com.", "example.com"},
+        {"_acme-challenge.sub.example.com.", "sub.example.com"},
+        {"_acme-challenge.a.b.sub.example.com.", "sub.example.com"},
+        {"example.com.", "example.com"},
+    }
+    for _, tt := range tests {
+        zone, err := p.FindZone(context.Background(), tt.fqdn)
+        if err != nil || zone.Name != tt.zone {
+            t.Errorf("%s: got zone %q, %v, want %q", tt.fqdn, zone.Name, err, tt.zone)
+        }
+    }
+
+    if _, err := p.FindZone(context.Background(), "_acme-challenge.example.org."); err == nil {
+        t.Errorf("found a zone for example.org")
+    }
+}
+
+func TestPresentAndCleanUp(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    dns := &fakeDNS{values: map[string][]string{}}
+    p := dns01.NewProvider(s.DomainClient(), dns01.Options{Lookup: dns.lookup, PollInterval: time.Millisecond})
+
+    fqdn, value := dns01.ChallengeRecord("*.www.example.com", "key")
+    if fqdn != "_acme-challenge.www.example.com." {
+        t.Errorf("got fqdn %s", fqdn)
+    }
+    dns.set("ns1.example.net.", value)
+    dns.set("ns2.example.net.", "other", value)
+    if err := p.Present("*.www.example.com", "token", "key"); err != nil {
+        t.Fatal(err)
+    }
+    items := s.Items(records)
+    if len(items) != 1 || items[0]["name"] != "_acme-challenge.www" || items[0]["type"] != "TXT" || items[0]["data"] != value {
+        t.Fatalf("got records %+v", items)
+    }
+
+    if err := p.CleanUp("*.www.example.com", "token", "key"); err != nil {
+        t.Fatal(err)
+    }
+    if items := s.Items(records); len(items) != 0 {
+        t.Errorf("record not deleted: %+v", items)
+    }
+    if err := p.CleanUp("*.www.example.com", "token", "key"); err != nil {
+        t.Errorf("cleaning up twice: %v", err)
+    }
+}
+
+func TestCleanUpLooksUpRecord(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    fqdn, value := dns01.ChallengeRecord("www.example.com", "key")
+    s.Seed(records,
+        domain.DNSRecord{Id: "r1", Name: "_acme-challenge.www", Type: "TXT", Data: "other"},
+        domain.DNSRecord{Id: "r2", Name: "_acme-challenge.www", Type: "TXT", Data: "\"" + value + "\""},
+        domain.DNSRecord{Id: "r3", Name: "www", Type: "TXT", Data: value},
+    )
+    // A new provider has not created the record itself, e.g. after a restart.
+    p := dns01.NewProvider(s.DomainClient(), dns01.Options{})
+    if err := p.CleanUp("www.example.com", "token", "key"); err != nil {
+        t.Fatal(err)
+    }
+    ids := []string{}
+    for _, item := range s.Items(records) {
+        ids = append(ids, item["id"].(string))
+    }
+    if strings.Join(ids, ",") != "r1,r3" {
+        t.Errorf("%s: got remaining records %v, want r1,r3", fqdn, ids)
+    }
+}
+
+func TestPresentWaitsForPropagation(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    dns := &fakeDNS{values: map[string][]string{}}
+    _, value := dns01.ChallengeRecord("example.com", "key")
+    p := dns01.NewProvider(s.DomainClient(), dns01.Options{
+        Lookup: func(ctx context.Context, nameserver string, fqdn string) ([]string, error) {
+            if dns.lookups == 3 {
+                dns.set("ns1.example.net.", value)
+                dns.set("ns2.example.net.", value)
+            }
+            return dns.lookup(ctx, nameserver, fqdn)
+        },
+        PollInterval: time.Millisecond,
+    })
+    if err := p.Present("example.com", "token", "key"); err != nil {
+        t.Fatal(err)
+    }
+    if dns.lookups < 4 {
+        t.Errorf("returned after %d lookups, before the record propagated", dns.lookups)
+    }
+
+    // Nameservers overrides those of the zone.
+    dns = &fakeDNS{values: map[string][]string{"ns.example.org.": {value}}}
+    p = dns01.NewProvider(s.DomainClient(), dns01.Options{Lookup: dns.lookup, Nameservers: []string{"ns.example.org."}})
+    if err := p.Present("example.com", "token", "key"); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func TestPresentPropagationTimeout(t *testing.T) {
+    s := newServer()
+    defer s.Close()
+    dns := &fakeDNS{values: map[string][]string{}}
+    _, value := dns01.ChallengeRecord("example.com", "key")
+    dns.set("ns1.example.net.", value)
+    p := dns01.NewProvider(s.DomainClient(), dns01.Options{
+        Lookup: dns.lookup,
+        PropagationTimeout: time.Millisecond * 50,
+        PollInterval: time.Millisecond * 5,
+    })
+    err := p.Present("example.com", "token", "key")
+    if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "ns2.example.net.") || strings.Contains(err.Error(), "ns1.example.net.") {
+        t.Errorf("got %v, want a timeout naming only ns2", err)
+    }
+    // The record stays until CleanUp is called.
+    if len(s.Items(records)) != 1 {
+        t.Errorf("got records %+v", s.Items(records))
+    }
+
+    p = dns01.NewProvider(s.DomainClient(), dns01.Options{Lookup: dns.lookup, SkipPropagationCheck: true})
+    before := dns.lookups
+    if err := p.Present("example.com", "token", "key"); err != nil || dns.lookups != before {
+        t.Errorf("got %v after %d lookups, want no propagation check", err, dns.lookups-before)
+    }
+}
@@ -0,0 +1,79 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package domain
+
+//go:generate go run ../internal/cmd/apigen -client DomainClient
+
+import (
+    "context"
+    "net/http"
+)
+
+// API lists all methods of DomainClient, allowing it to be replaced by a mock.
+type API interface {
+    GetDomainHandle(code string) (DomainHandleSingleResponse, *http.Response, error)
+    GetDomainHandleWithContext(ctx context.Context, code string) (DomainHandleSingleResponse, *http.Response, error)
+    DeleteDomainHandle(code string) (EmptyResponse, *http.Response, error)
+    DeleteDomainHandleWithContext(ctx context.Context, code string) (EmptyResponse, *http.Response, error)
+    UpdateDomainHandle(in DomainHandleUpdateRequest, code string) (DomainHandleSingleResponse, *http.Response, error)
+    UpdateDomainHandleWithContext(ctx context.Context, in DomainHandleUpdateRequest, code string) (DomainHandleSingleResponse, *http.Response, error)
+    UnscheduleDomainDelete(name string) (DomainSingleResponse, *http.Response, error)
+    UnscheduleDomainDeleteWithContext(ctx context.Context, name string) (DomainSingleResponse, *http.Response, error)
+    CreateDNSZoneRecord(in DNSRecordCreateRequest, name string) (DNSRecordSingleResponse, *http.Response, error)
+    CreateDNSZoneRecordWithContext(ctx context.Context, in DNSRecordCreateRequest, name string) (DNSRecordSingleResponse, *http.Response, error)
+    GetDNSZoneRecords(name string, qParams GetDNSZoneRecordsQueryParams) (DNSRecordListResponse, *http.Response, error)
+    GetDNSZoneRecordsWithContext(ctx context.Context, name string, qParams GetDNSZoneRecordsQueryParams) (DNSRecordListResponse, *http.Response, error)
+    ListAllDNSZoneRecords(ctx context.Context, name string, qParams GetDNSZoneRecordsQueryParams) *DNSRecordIterator
+    UpdateDNSZoneRecords(in DNSRecordsUpdateRequest, name string) (DNSRecordListResponse, *http.Response, error)
+    UpdateDNSZoneRecordsWithContext(ctx context.Context, in DNSRecordsUpdateRequest, name string) (DNSRecordListResponse, *http.Response, error)
+    ScheduleDomainDelete(in DomainScheduleDeleteRequest, name string) (DomainSingleResponse, *http.Response, error)
+    ScheduleDomainDeleteWithContext(ctx context.Context, in DomainScheduleDeleteRequest, name string) (DomainSingleResponse, *http.Response, error)
+    Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error)
+    GetDomainPricingList(qParams GetDomainPricingListQueryParams) (DomainPriceListResponse, *http.Response, error)
+    GetDomainPricingListWithContext(ctx context.Context, qParams GetDomainPricingListQueryParams) (DomainPriceListResponse, *http.Response, error)
+    GetDomainAuthinfo(name string) (DomainAuthinfoResponse, *http.Response, error)
+    GetDomainAuthinfoWithContext(ctx context.Context, name string) (DomainAuthinfoResponse, *http.Response, error)
+    RemoveDomainAuthinfo(name string) (EmptyResponse, *http.Response, error)
+    RemoveDomainAuthinfoWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error)
+    RestoreDomain(name string) (EmptyResponse, *http.Response, error)
+    RestoreDomainWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error)
+    GetDNSZones(qParams GetDNSZonesQueryParams) (DNSZoneListResponse, *http.Response, error)
+    GetDNSZonesWithContext(ctx context.Context, qParams GetDNSZonesQueryParams) (DNSZoneListResponse, *http.Response, error)
+    ListAllDNSZones(ctx context.Context, qParams GetDNSZonesQueryParams) *DNSZoneIterator
+    DeleteDNSRecord(name string, id string) (EmptyResponse, *http.Response, error)
+    DeleteDNSRecordWithContext(ctx context.Context, name string, id string) (EmptyResponse, *http.Response, error)
+    UpdateDNSRecord(in DNSRecordUpdateRequest, name string, id string) (DNSRecordSingleResponse, *http.Response, error)
+    UpdateDNSRecordWithContext(ctx context.Context, in DNSRecordUpdateRequest, name string, id string) (DNSRecordSingleResponse, *http.Response, error)
+    SendDomainVerification(name string) (EmptyResponse, *http.Response, error)
+    SendDomainVerificationWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error)
+    CheckDomainVerification(name string) (DomainCheckVerificationResponse, *http.Response, error)
+    CheckDomainVerificationWithContext(ctx context.Context, name string) (DomainCheckVerificationResponse, *http.Response, error)
+    GetDNSZone(name string) (DNSZoneSingleResponse, *http.Response, error)
+    GetDNSZoneWithContext(ctx context.Context, name string) (DNSZoneSingleResponse, *http.Response, error)
+    UpdateDNSZone(in DNSZoneUpdateRequest, name string) (DNSZoneSingleResponse, *http.Response, error)
+    UpdateDNSZoneWithContext(ctx context.Context, in DNSZoneUpdateRequest, name string) (DNSZoneSingleResponse, *http.Response, error)
+    GetLabels(qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error)
+    GetLabelsWithContext(ctx context.Context, qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error)
+    ListAllLabels(ctx context.Context, qParams GetLabelsQueryParams) *LabelIterator
+    CreateDomainHandle(in DomainHandleCreateRequest) (DomainHandleSingleResponse, *http.Response, error)
+    CreateDomainHandleWithContext(ctx context.Context, in DomainHandleCreateRequest) (DomainHandleSingleResponse, *http.Response, error)
+    GetDomainHandles(qParams GetDomainHandlesQueryParams) (DomainHandleListResponse, *http.Response, error)
+    GetDomainHandlesWithContext(ctx context.Context, qParams GetDomainHandlesQueryParams) (DomainHandleListResponse, *http.Response, error)
+    ListAllDomainHandles(ctx context.Context, qParams GetDomainHandlesQueryParams) *DomainHandleIterator
+    CheckDomain(name string) (DomainCheckResponse, *http.Response, error)
+    CheckDomainWithContext(ctx context.Context, name string) (DomainCheckResponse, *http.Response, error)
+    CreateDomain(in DomainCreateRequest) (DomainSingleResponse, *http.Response, error)
+    CreateDomainWithContext(ctx context.Context, in DomainCreateRequest) (DomainSingleResponse, *http.Response, error)
+    GetDomains(qParams GetDomainsQueryParams) (DomainListResponse, *http.Response, error)
+    GetDomainsWithContext(ctx context.Context, qParams GetDomainsQueryParams) (DomainListResponse, *http.Response, error)
+    ListAllDomains(ctx context.Context, qParams GetDomainsQueryParams) *DomainIterator
+    GetDomain(name string) (DomainSingleResponse, *http.Response, error)
+    GetDomainWithContext(ctx context.Context, name string) (DomainSingleResponse, *http.Response, error)
+    DeleteDomain(name string) (EmptyResponse, *http.Response, error)
+    DeleteDomainWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error)
+    UpdateDomain(in DomainUpdateRequest, name string) (DomainSingleResponse, *http.Response, error)
+    UpdateDomainWithContext(ctx context.Context, in DomainUpdateRequest, name string) (DomainSingleResponse, *http.Response, error)
+}
+
+var _ API = DomainClient{}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+    "bufio"
+    "context"
+    "io"
+    "sort"
+    "strings"
+    "sync"
+)
+
+// DomainAvailability is the result of checking a single candidate name. Pricing is nil
+// if no price is known for the TLD of the name.
+type DomainAvailability struct {
+    Name string `json:"name"`
+    Tld string `json:"tld"`
+    Available bool `json:"available"`
+    Pricing *DomainPricing `json:"pricing"`
+    Err error `json:"-"`
+}
+
+type DomainAvailabilitySort string
+
+const (
+    SortByName DomainAvailabilitySort = "name"
+    SortByPrice DomainAvailabilitySort = "price"
+    SortByAvailability DomainAvailabilitySort = "availability"
+)
+
+// AvailabilityOptions configures CheckDomainAvailability. Zero values fall back to 8
+// workers and sorting by name.
+type AvailabilityOptions struct {
+    Workers int
+    Sort DomainAvailabilitySort
+}
+
+// DomainCandidates combines a label with each of the TLDs.
+func DomainCandidates(label string, tlds []string) []string {
+    names := []string{}
+    for _, tld := range tlds {
+        names = append(names, strings.ToLower(label)+"."+strings.ToLower(strings.TrimPrefix(tld, ".")))
+    }
+    return names
+}
+
+// ReadDomainCandidates reads one domain name per line, empty lines and lines starting
+// with # are ignored.
+func ReadDomainCandidates(r io.Reader) ([]string, error) {
+    names := []string{}
+    seen := map[string]bool{}
+    scanner := bufio.NewScanner(r)
+    for scanner.Scan() {
+        name := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(scanner.Text()), "."))
+        if len(name) == 0 || strings.HasPrefix(name, "#") || seen[name] {
+            continue
+        }
+        seen[name] = true
+        names = append(names, name)
+    }
+    return names, scanner.Err()
+}
+
+// CheckDomainAvailability checks the availability of all names with a bounded number of
+// concurrent requests and joins the results with the pricing of their TLD. Failed checks
+// are reported in the Err field of their result.
+func (c DomainClient) CheckDomainAvailability(ctx context.Context, names []string, opts AvailabilityOptions) ([]DomainAvailability, error) {
+    if opts.Workers <= 0 {
+        opts.Workers = 8
+    }
+    res, _, err := c.GetDomainPricingListWithContext(ctx, GetDomainPricingListQueryParams{})
+    if err != nil {
+        return nil, err
+    }
+    pricing := map[string]DomainPricing{}
+    for _, p := range res.Data {
+        pricing[strings.ToLower(strings.TrimPrefix(p.Tld, "."))] = p
+    }
+
+    results := make([]DomainAvailability, len(names))
+    jobs := make(chan int)
+    wg := sync.WaitGroup{}
+    for w := 0; w < opts.Workers && w < len(names); w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := range jobs {
+                results[i] = c.checkAvailability(ctx, names[i], pricing)
+            }
+        }()
+    }
+    for i := range names {
+        select {
+            case jobs <- i:
+            case <-ctx.Done():
+        }
+        if ctx.Err() != nil {
+            break
+        }
+    }
+    close(jobs)
+    wg.Wait()
+    if err := ctx.Err(); err != nil {
+        return nil, err
+    }
+
+    SortDomainAvailability(results, opts.Sort)
+    return results, nil
+}
+
+func (c DomainClient) checkAvailability(ctx context.Context, name string, pricing map[string]DomainPricing) DomainAvailability {
+    result := DomainAvailability{Name: name}
+    // Use the longest known suffix as TLD, so that e.g. co.uk is preferred over uk.
+    labels := strings.Split(name, ".")
+    for i := 1; i < len(labels); i++ {
+        tld := strings.Join(labels[i:], ".")
+        if p, ok := pricing[tld]; ok {
+            result.Tld = tld
+            result.Pricing = &p
+            break
+        }
+    }
+    if len(result.Tld) == 0 && len(labels) > 1 {
+        result.Tld = labels[len(labels)-1]
+    }
+
+    res, _, err := c.CheckDomainWithContext(ctx, name)
+    result.Available = err == nil && res.Data.Available
+    result.Err = err
+    return result
+}
+
+func createPrice(r DomainAvailability) (float32, bool) {
+    if r.Pricing == nil || r.Pricing.Create == nil {
+        return 0, false
+    }
+    return *r.Pricing.Create, true
+}
+
+// SortDomainAvailability sorts results by name, by create price with unknown prices last,
+// or by availability with available names first and ordered by price.
+func SortDomainAvailability(results []DomainAvailability, by DomainAvailabilitySort) {
+    byPrice := func(a DomainAvailability, b DomainAvailability) bool {
+        pa, okA := createPrice(a)
+        pb, okB := createPrice(b)
+        if okA != okB {
+            return okA
+        }
+        if pa != pb {
+            return pa < pb
+        }
+        return a.Name < b.Name
+    }
+    sort.SliceStable(results, func(i, j int) bool {
+        a, b := results[i], results[j]
+        switch by {
+            case SortByPrice:
+                return byPrice(a, b)
+            case SortByAvailability:
+                if a.Available != b.Available {
+                    return a.Available
+                }
+                return byPrice(a, b)
+        }
+        return a.Name < b.Name
+    })
+}
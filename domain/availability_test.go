@@ -0,0 +1,131 @@
+package domain_test
+
+import (
+    "context"
+    "reflect"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func price(v float32) *float32 {
+    return &v
+}
+
+func newAvailabilityServer() *lumaservtest.Server {
+    s := lumaservtest.NewServer()
+    s.Seed("/domain/pricing/domains",
+        domain.DomainPricing{Tld: "uk", Create: price(8)},
+        domain.DomainPricing{Tld: ".co.uk", Create: price(5)},
+        domain.DomainPricing{Tld: "com", Create: price(10)},
+        domain.DomainPricing{Tld: "net", Create: price(10)},
+    )
+    s.Seed("/domain/domains", domain.Domain{Name: "taken.com"}, domain.Domain{Name: "taken.co.uk"})
+    return s
+}
+
+func availabilityNames(results []domain.DomainAvailability) []string {
+    names := []string{}
+    for _, r := range results {
+        names = append(names, r.Name)
+    }
+    return names
+}
+
+func TestCheckDomainAvailability(t *testing.T) {
+    s := newAvailabilityServer()
+    defer s.Close()
+    // The first names answer last, so the workers finish out of order.
+    s.InjectFault(lumaservtest.Fault{Path: "/domain/domains/taken.com/check", Latency: time.Millisecond * 50})
+    s.InjectFault(lumaservtest.Fault{Path: "/domain/domains/free.com/check", Latency: time.Millisecond * 30})
+    s.InjectFault(lumaservtest.Fault{Path: "/domain/domains/broken.net/check", StatusCode: 500})
+
+    names := []string{"taken.com", "free.com", "taken.co.uk", "free.co.uk", "free.uk", "free.xyz", "broken.net"}
+    results, err := s.DomainClient().CheckDomainAvailability(context.Background(), names, domain.AvailabilityOptions{Workers: 3})
+    if err != nil {
+        t.Fatal(err)
+    }
+    want := []struct {
+        name string
+        tld string
+        available bool
+        price *float32
+        failed bool
+    }{
+        {"broken.net", "net", false, price(10), true},
+        {"free.co.uk", "co.uk", true, price(5), false},
+        {"free.com", "com", true, price(10), false},
+        {"free.uk", "uk", true, price(8), false},
+        {"free.xyz", "xyz", true, nil, false},
+        {"taken.co.uk", "co.uk", false, price(5), false},
+        {"taken.com", "com", false, price(10), false},
+    }
+    if len(results) != len(want) {
+        t.Fatalf("got %d results, want %d", len(results), len(want))
+    }
+    for i, w := range want {
+        r := results[i]
+        if r.Name != w.name || r.Tld != w.tld || r.Available != w.available || (r.Err != nil) != w.failed {
+            t.Errorf("result %d: got %s tld %s available %v err %v, want %+v", i, r.Name, r.Tld, r.Available, r.Err, w)
+        }
+        if (r.Pricing == nil) != (w.price == nil) || r.Pricing != nil && *r.Pricing.Create != *w.price {
+            t.Errorf("%s: got pricing %+v, want create price %v", r.Name, r.Pricing, w.price)
+        }
+    }
+}
+
+func TestCheckDomainAvailabilityCancel(t *testing.T) {
+    s := newAvailabilityServer()
+    defer s.Close()
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: "/domain/domains/", Latency: time.Minute})
+
+    names := domain.DomainCandidates("example", []string{"com", "net", "org", "de", "eu", "io", "dev", "app"})
+    ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+    defer cancel()
+    start := time.Now()
+    results, err := s.DomainClient().CheckDomainAvailability(ctx, names, domain.AvailabilityOptions{Workers: 2})
+    if err != context.DeadlineExceeded || results != nil {
+        t.Errorf("got %v, %v, want the context error", results, err)
+    }
+    if d := time.Since(start); d > time.Second {
+        t.Errorf("returned %v after the context was done", d)
+    }
+    checks := 0
+    for _, r := range s.Requests() {
+        if r.Path != "/domain/pricing/domains" {
+            checks++
+        }
+    }
+    if checks > 2 {
+        t.Errorf("started %d checks with 2 workers", checks)
+    }
+}
+
+func TestSortDomainAvailability(t *testing.T) {
+    results := func() []domain.DomainAvailability {
+        return []domain.DomainAvailability{
+            {Name: "d.example", Available: true},
+            {Name: "c.example", Available: false, Pricing: &domain.DomainPricing{Create: price(5)}},
+            {Name: "b.example", Available: true, Pricing: &domain.DomainPricing{Create: price(10)}},
+            {Name: "a.example", Available: true, Pricing: &domain.DomainPricing{Create: price(10)}},
+            {Name: "e.example", Available: false, Pricing: &domain.DomainPricing{}},
+        }
+    }
+    tests := []struct {
+        by domain.DomainAvailabilitySort
+        want []string
+    }{
+        {domain.SortByName, []string{"a.example", "b.example", "c.example", "d.example", "e.example"}},
+        {"", []string{"a.example", "b.example", "c.example", "d.example", "e.example"}},
+        {domain.SortByPrice, []string{"c.example", "a.example", "b.example", "d.example", "e.example"}},
+        {domain.SortByAvailability, []string{"a.example", "b.example", "d.example", "c.example", "e.example"}},
+    }
+    for _, tt := range tests {
+        r := results()
+        domain.SortDomainAvailability(r, tt.by)
+        if got := availabilityNames(r); !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("sort by %q: got %v, want %v", tt.by, got, tt.want)
+        }
+    }
+}
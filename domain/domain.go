@@ -1,23 +1,14 @@
 package domain
 
 import (
-    "bytes"
-    "io/ioutil"
+    "context"
     "net/http"
-    "time"
-    "encoding/json"
-    "io"
-    "errors"
-    "strconv"
-    "github.com/google/go-querystring/query"
     "reflect"
+    "github.com/lumaserv/lumaserv-api-go/core"
 )
 
 type DomainClient struct {
-    baseUrl string
-    apiKey  string
-    client  *http.Client
-    currentProject string
+    *core.Client
 }
 
 func NewClient (apiKey string) DomainClient {
@@ -30,92 +21,18 @@ func NewClientWithUrl (apiKey string, baseUrl string) DomainClient {
     }
 
     return DomainClient {
-        apiKey: apiKey,
-        baseUrl: baseUrl,
+        Client: core.NewClient(apiKey, baseUrl),
     }
 }
 
-func (c *DomainClient) SetCurrentProject (project string) {
-    c.currentProject = project
-}
-
-func (c *DomainClient) GetCurrentProject () string {
-    return c.currentProject
-}
-
-func (c *DomainClient) SetHttpClient(client *http.Client) {
-    c.client = client
-}
-
-func (c *DomainClient) SetAccessToken(token string) {
-    c.apiKey = token
-}
-
-func (c *DomainClient) Request(method string, path string, postBody io.Reader) (*http.Response, []byte, error) {
-    if c.client == nil {
-        c.client = &http.Client{
-            Timeout: time.Second * 5,
-        }
-    }
-
-    req, err := http.NewRequest(method, c.baseUrl+path, postBody)
-    if err != nil {
-        return nil, nil, err
-    }
-
-    req.Header.Add("Authorization", "Bearer "+c.apiKey)
-    req.Header.Add("User-Agent", "LUMASERV-go-client")
-    req.Header.Add("Accept", "application/json")
-    res, err := c.client.Do(req)
-    if err != nil {
-        return res, nil, err
-    }
-
-    if res.Body != nil {
-        defer res.Body.Close()
-    }
+type ResponseMessages = core.ResponseMessages
 
-    body, err := ioutil.ReadAll(res.Body)
+type ResponseMessage = core.ResponseMessage
 
-    return res, body, err
-}
+type ResponsePagination = core.ResponsePagination
 
-func (c DomainClient) toStr(in interface{}) string {
-    switch in.(type) {
-        case string:
-            return in.(string)
-        case int:
-            return strconv.Itoa(in.(int))
-    }
+type ResponseMetadata = core.ResponseMetadata
 
-    panic("Unhandled type in toStr")
-}
-
-func (c DomainClient) applyCurrentProject (v reflect.Value) {
-    if len(c.currentProject) > 0 {
-        if v.Kind() == reflect.Ptr {
-            x := v.Elem()
-            f := x.FieldByName("ProjectId")
-            if f.IsValid() && f.CanSet() {
-                if f.Kind() == reflect.String && len(f.String()) == 0 {
-                    f.SetString(c.currentProject)
-                } else if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String {
-                    f.Set(reflect.ValueOf(&c.currentProject))
-                }
-            }
-
-            for i := 0; i < x.NumField(); i++ {
-                field := x.Field(i)
-                if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct || field.Kind() == reflect.Struct {
-                    if field.IsNil() && field.CanSet() {
-                        field.Set(reflect.New(field.Type().Elem()))
-                    }
-                    c.applyCurrentProject(field)
-                }
-            }
-        }
-    }
-}
 type DomainVerificationStatus struct {
     Unverified bool `json:"unverified"`
 }
@@ -151,11 +68,6 @@ type Label struct {
     ObjectId string `json:"object_id"`
 }
 
-type ResponseMessage struct {
-    Message string `json:"message"`
-    Key string `json:"key"`
-}
-
 type SearchResults struct {
     Domains *[]Domain `json:"domains"`
     DomainHandles *[]DomainHandle `json:"domain_handles"`
@@ -203,27 +115,48 @@ type DomainHandle struct {
 
 type ObjectType string
 
+const (
+    ObjectTypeServer ObjectType = "SERVER"
+    ObjectTypeServerVolume ObjectType = "SERVER_VOLUME"
+    ObjectTypeServerMedia ObjectType = "SERVER_MEDIA"
+    ObjectTypeServerBackup ObjectType = "SERVER_BACKUP"
+    ObjectTypeServerFirewall ObjectType = "SERVER_FIREWALL"
+    ObjectTypeSSHKey ObjectType = "SSH_KEY"
+    ObjectTypeNetwork ObjectType = "NETWORK"
+    ObjectTypeSubnet ObjectType = "SUBNET"
+    ObjectTypeAddress ObjectType = "ADDRESS"
+    ObjectTypeS3Bucket ObjectType = "S3_BUCKET"
+    ObjectTypeS3AccessKey ObjectType = "S3_ACCESS_KEY"
+    ObjectTypeDNSZone ObjectType = "DNS_ZONE"
+    ObjectTypeDomain ObjectType = "DOMAIN"
+    ObjectTypeDomainHandle ObjectType = "DOMAIN_HANDLE"
+    ObjectTypeSSLCertificate ObjectType = "SSL_CERTIFICATE"
+    ObjectTypeSSLContact ObjectType = "SSL_CONTACT"
+    ObjectTypeSSLOrganisation ObjectType = "SSL_ORGANISATION"
+    ObjectTypePleskLicense ObjectType = "PLESK_LICENSE"
+)
+
+func (e ObjectType) String() string {
+    return string(e)
+}
+
+func (e ObjectType) IsValid() bool {
+    switch e {
+        case ObjectTypeServer, ObjectTypeServerVolume, ObjectTypeServerMedia, ObjectTypeServerBackup, ObjectTypeServerFirewall, ObjectTypeSSHKey, ObjectTypeNetwork, ObjectTypeSubnet, ObjectTypeAddress, ObjectTypeS3Bucket, ObjectTypeS3AccessKey, ObjectTypeDNSZone, ObjectTypeDomain, ObjectTypeDomainHandle, ObjectTypeSSLCertificate, ObjectTypeSSLContact, ObjectTypeSSLOrganisation, ObjectTypePleskLicense:
+            return true
+    }
+    return false
+}
+
 type DomainAuthinfo struct {
     ValidUntil *string `json:"valid_until"`
     Authinfo string `json:"authinfo"`
 }
 
-type ResponsePagination struct {
-    Total int `json:"total"`
-    Page int `json:"page"`
-    PageSize int `json:"page_size"`
-}
-
 type DomainCheckResult struct {
     Available bool `json:"available"`
 }
 
-type ResponseMessages struct {
-    Warnings []ResponseMessage `json:"warnings"`
-    Errors []ResponseMessage `json:"errors"`
-    Infos []ResponseMessage `json:"infos"`
-}
-
 type Domain struct {
     RegisteredAt *string `json:"registered_at"`
     AdminHandleCode string `json:"admin_handle_code"`
@@ -242,10 +175,28 @@ type Domain struct {
 
 type DomainStatus string
 
-type ResponseMetadata struct {
-    TransactionId string `json:"transaction_id"`
-    BuildCommit string `json:"build_commit"`
-    BuildTimestamp string `json:"build_timestamp"`
+const (
+    DomainStatusActive DomainStatus = "ACTIVE"
+    DomainStatusPending DomainStatus = "PENDING"
+    DomainStatusTransferPending DomainStatus = "TRANSFER_PENDING"
+    DomainStatusTransferOut DomainStatus = "TRANSFER_OUT"
+    DomainStatusSuspended DomainStatus = "SUSPENDED"
+    DomainStatusExpired DomainStatus = "EXPIRED"
+    DomainStatusRestorable DomainStatus = "RESTORABLE"
+    DomainStatusDeleted DomainStatus = "DELETED"
+    DomainStatusFailed DomainStatus = "FAILED"
+)
+
+func (e DomainStatus) String() string {
+    return string(e)
+}
+
+func (e DomainStatus) IsValid() bool {
+    switch e {
+        case DomainStatusActive, DomainStatusPending, DomainStatusTransferPending, DomainStatusTransferOut, DomainStatusSuspended, DomainStatusExpired, DomainStatusRestorable, DomainStatusDeleted, DomainStatusFailed:
+            return true
+    }
+    return false
 }
 
 type DomainHandleSingleResponse struct {
@@ -467,99 +418,57 @@ type DNSRecordCreateRequest struct {
     Ttl *int `json:"ttl"`
 }
 
-type DNSRecordsUpdateRequest []struct {
-        Data string `url:"data,omitempty"`
-        Name string `url:"name,omitempty"`
-        Type string `url:"type,omitempty"`
-        Ttl int `url:"ttl,omitempty"`
-    }
+type DNSRecordsUpdateRequest []DNSRecordCreateRequest
 
 func (c DomainClient) GetDomainHandle(code string) (DomainHandleSingleResponse, *http.Response, error) {
+    return c.GetDomainHandleWithContext(context.Background(), code)
+}
+
+func (c DomainClient) GetDomainHandleWithContext(ctx context.Context, code string) (DomainHandleSingleResponse, *http.Response, error) {
     body := DomainHandleSingleResponse{}
-    res, j, err := c.Request("GET", "/domain-handles/"+c.toStr(code), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domain-handles/"+core.ToStr(code), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) DeleteDomainHandle(code string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteDomainHandleWithContext(context.Background(), code)
+}
+
+func (c DomainClient) DeleteDomainHandleWithContext(ctx context.Context, code string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/domain-handles/"+c.toStr(code), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/domain-handles/"+core.ToStr(code), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) UpdateDomainHandle(in DomainHandleUpdateRequest, code string) (DomainHandleSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateDomainHandleWithContext(context.Background(), in, code)
+}
+
+func (c DomainClient) UpdateDomainHandleWithContext(ctx context.Context, in DomainHandleUpdateRequest, code string) (DomainHandleSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DomainHandleSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/domain-handles/"+c.toStr(code), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/domain-handles/"+core.ToStr(code), nil, in, &body)
     return body, res, err
 }
 
 func (c DomainClient) UnscheduleDomainDelete(name string) (DomainSingleResponse, *http.Response, error) {
+    return c.UnscheduleDomainDeleteWithContext(context.Background(), name)
+}
+
+func (c DomainClient) UnscheduleDomainDeleteWithContext(ctx context.Context, name string) (DomainSingleResponse, *http.Response, error) {
     body := DomainSingleResponse{}
-    res, j, err := c.Request("POST", "/domains/"+c.toStr(name)+"/unschedule-delete", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domains/"+core.ToStr(name)+"/unschedule-delete", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) CreateDNSZoneRecord(in DNSRecordCreateRequest, name string) (DNSRecordSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateDNSZoneRecordWithContext(context.Background(), in, name)
+}
+
+func (c DomainClient) CreateDNSZoneRecordWithContext(ctx context.Context, in DNSRecordCreateRequest, name string) (DNSRecordSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DNSRecordSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/dns/zones/"+c.toStr(name)+"/records", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/dns/zones/"+core.ToStr(name)+"/records", nil, in, &body)
     return body, res, err
 }
 
@@ -571,62 +480,34 @@ type GetDNSZoneRecordsQueryParams struct {
 }
 
 func (c DomainClient) GetDNSZoneRecords(name string, qParams GetDNSZoneRecordsQueryParams) (DNSRecordListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetDNSZoneRecordsWithContext(context.Background(), name, qParams)
+}
+
+func (c DomainClient) GetDNSZoneRecordsWithContext(ctx context.Context, name string, qParams GetDNSZoneRecordsQueryParams) (DNSRecordListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := DNSRecordListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/dns/zones/"+c.toStr(name)+"/records"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/dns/zones/"+core.ToStr(name)+"/records", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) UpdateDNSZoneRecords(in DNSRecordsUpdateRequest, name string) (DNSRecordListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateDNSZoneRecordsWithContext(context.Background(), in, name)
+}
+
+func (c DomainClient) UpdateDNSZoneRecordsWithContext(ctx context.Context, in DNSRecordsUpdateRequest, name string) (DNSRecordListResponse, *http.Response, error) {
     body := DNSRecordListResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/dns/zones/"+c.toStr(name)+"/records", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/dns/zones/"+core.ToStr(name)+"/records", nil, in, &body)
     return body, res, err
 }
 
 func (c DomainClient) ScheduleDomainDelete(in DomainScheduleDeleteRequest, name string) (DomainSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.ScheduleDomainDeleteWithContext(context.Background(), in, name)
+}
+
+func (c DomainClient) ScheduleDomainDeleteWithContext(ctx context.Context, in DomainScheduleDeleteRequest, name string) (DomainSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DomainSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/domains/"+c.toStr(name)+"/schedule-delete", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domains/"+core.ToStr(name)+"/schedule-delete", nil, in, &body)
     return body, res, err
 }
 
@@ -644,24 +525,13 @@ type SearchQueryParams struct {
 }
 
 func (c DomainClient) Search(qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.SearchWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) SearchWithContext(ctx context.Context, qParams SearchQueryParams) (SearchResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := SearchResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/search"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/search", qParams, nil, &body)
     return body, res, err
 }
 
@@ -670,75 +540,43 @@ type GetDomainPricingListQueryParams struct {
 }
 
 func (c DomainClient) GetDomainPricingList(qParams GetDomainPricingListQueryParams) (DomainPriceListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetDomainPricingListWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) GetDomainPricingListWithContext(ctx context.Context, qParams GetDomainPricingListQueryParams) (DomainPriceListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := DomainPriceListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/pricing/domains"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/pricing/domains", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) GetDomainAuthinfo(name string) (DomainAuthinfoResponse, *http.Response, error) {
+    return c.GetDomainAuthinfoWithContext(context.Background(), name)
+}
+
+func (c DomainClient) GetDomainAuthinfoWithContext(ctx context.Context, name string) (DomainAuthinfoResponse, *http.Response, error) {
     body := DomainAuthinfoResponse{}
-    res, j, err := c.Request("GET", "/domains/"+c.toStr(name)+"/authinfo", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domains/"+core.ToStr(name)+"/authinfo", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) RemoveDomainAuthinfo(name string) (EmptyResponse, *http.Response, error) {
+    return c.RemoveDomainAuthinfoWithContext(context.Background(), name)
+}
+
+func (c DomainClient) RemoveDomainAuthinfoWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/domains/"+c.toStr(name)+"/authinfo", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/domains/"+core.ToStr(name)+"/authinfo", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) RestoreDomain(name string) (EmptyResponse, *http.Response, error) {
+    return c.RestoreDomainWithContext(context.Background(), name)
+}
+
+func (c DomainClient) RestoreDomainWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/domains/"+c.toStr(name)+"/restore", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domains/"+core.ToStr(name)+"/restore", nil, nil, &body)
     return body, res, err
 }
 
@@ -758,130 +596,75 @@ type GetDNSZonesQueryParams struct {
 }
 
 func (c DomainClient) GetDNSZones(qParams GetDNSZonesQueryParams) (DNSZoneListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetDNSZonesWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) GetDNSZonesWithContext(ctx context.Context, qParams GetDNSZonesQueryParams) (DNSZoneListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := DNSZoneListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/dns/zones"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/dns/zones", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) DeleteDNSRecord(name string, id string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteDNSRecordWithContext(context.Background(), name, id)
+}
+
+func (c DomainClient) DeleteDNSRecordWithContext(ctx context.Context, name string, id string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/dns/zones/"+c.toStr(name)+"/records/"+c.toStr(id), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/dns/zones/"+core.ToStr(name)+"/records/"+core.ToStr(id), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) UpdateDNSRecord(in DNSRecordUpdateRequest, name string, id string) (DNSRecordSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateDNSRecordWithContext(context.Background(), in, name, id)
+}
+
+func (c DomainClient) UpdateDNSRecordWithContext(ctx context.Context, in DNSRecordUpdateRequest, name string, id string) (DNSRecordSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DNSRecordSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/dns/zones/"+c.toStr(name)+"/records/"+c.toStr(id), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/dns/zones/"+core.ToStr(name)+"/records/"+core.ToStr(id), nil, in, &body)
     return body, res, err
 }
 
 func (c DomainClient) SendDomainVerification(name string) (EmptyResponse, *http.Response, error) {
+    return c.SendDomainVerificationWithContext(context.Background(), name)
+}
+
+func (c DomainClient) SendDomainVerificationWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("POST", "/domains/"+c.toStr(name)+"/verification", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domains/"+core.ToStr(name)+"/verification", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) CheckDomainVerification(name string) (DomainCheckVerificationResponse, *http.Response, error) {
+    return c.CheckDomainVerificationWithContext(context.Background(), name)
+}
+
+func (c DomainClient) CheckDomainVerificationWithContext(ctx context.Context, name string) (DomainCheckVerificationResponse, *http.Response, error) {
     body := DomainCheckVerificationResponse{}
-    res, j, err := c.Request("GET", "/domains/"+c.toStr(name)+"/verification", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domains/"+core.ToStr(name)+"/verification", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) GetDNSZone(name string) (DNSZoneSingleResponse, *http.Response, error) {
+    return c.GetDNSZoneWithContext(context.Background(), name)
+}
+
+func (c DomainClient) GetDNSZoneWithContext(ctx context.Context, name string) (DNSZoneSingleResponse, *http.Response, error) {
     body := DNSZoneSingleResponse{}
-    res, j, err := c.Request("GET", "/dns/zones/"+c.toStr(name), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/dns/zones/"+core.ToStr(name), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) UpdateDNSZone(in DNSZoneUpdateRequest, name string) (DNSZoneSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateDNSZoneWithContext(context.Background(), in, name)
+}
+
+func (c DomainClient) UpdateDNSZoneWithContext(ctx context.Context, in DNSZoneUpdateRequest, name string) (DNSZoneSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DNSZoneSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/dns/zones/"+c.toStr(name), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/dns/zones/"+core.ToStr(name), nil, in, &body)
     return body, res, err
 }
 
@@ -900,43 +683,24 @@ type GetLabelsQueryParams struct {
 }
 
 func (c DomainClient) GetLabels(qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetLabelsWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) GetLabelsWithContext(ctx context.Context, qParams GetLabelsQueryParams) (LabelListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := LabelListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/labels"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/labels", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) CreateDomainHandle(in DomainHandleCreateRequest) (DomainHandleSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateDomainHandleWithContext(context.Background(), in)
+}
+
+func (c DomainClient) CreateDomainHandleWithContext(ctx context.Context, in DomainHandleCreateRequest) (DomainHandleSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DomainHandleSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/domain-handles", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domain-handles", nil, in, &body)
     return body, res, err
 }
 
@@ -956,60 +720,34 @@ type GetDomainHandlesQueryParams struct {
 }
 
 func (c DomainClient) GetDomainHandles(qParams GetDomainHandlesQueryParams) (DomainHandleListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetDomainHandlesWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) GetDomainHandlesWithContext(ctx context.Context, qParams GetDomainHandlesQueryParams) (DomainHandleListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := DomainHandleListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/domain-handles"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domain-handles", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) CheckDomain(name string) (DomainCheckResponse, *http.Response, error) {
+    return c.CheckDomainWithContext(context.Background(), name)
+}
+
+func (c DomainClient) CheckDomainWithContext(ctx context.Context, name string) (DomainCheckResponse, *http.Response, error) {
     body := DomainCheckResponse{}
-    res, j, err := c.Request("GET", "/domains/"+c.toStr(name)+"/check", nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domains/"+core.ToStr(name)+"/check", nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) CreateDomain(in DomainCreateRequest) (DomainSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.CreateDomainWithContext(context.Background(), in)
+}
+
+func (c DomainClient) CreateDomainWithContext(ctx context.Context, in DomainCreateRequest) (DomainSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DomainSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("POST", "/domains", bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "POST", "/domains", nil, in, &body)
     return body, res, err
 }
 
@@ -1034,77 +772,44 @@ type GetDomainsQueryParams struct {
 }
 
 func (c DomainClient) GetDomains(qParams GetDomainsQueryParams) (DomainListResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&qParams))
+    return c.GetDomainsWithContext(context.Background(), qParams)
+}
+
+func (c DomainClient) GetDomainsWithContext(ctx context.Context, qParams GetDomainsQueryParams) (DomainListResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&qParams))
     body := DomainListResponse{}
-    q, err := query.Values(qParams)
-    if err != nil {
-        return body, nil, err
-    }
-    res, j, err := c.Request("GET", "/domains"+"?"+q.Encode(), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domains", qParams, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) GetDomain(name string) (DomainSingleResponse, *http.Response, error) {
+    return c.GetDomainWithContext(context.Background(), name)
+}
+
+func (c DomainClient) GetDomainWithContext(ctx context.Context, name string) (DomainSingleResponse, *http.Response, error) {
     body := DomainSingleResponse{}
-    res, j, err := c.Request("GET", "/domains/"+c.toStr(name), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "GET", "/domains/"+core.ToStr(name), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) DeleteDomain(name string) (EmptyResponse, *http.Response, error) {
+    return c.DeleteDomainWithContext(context.Background(), name)
+}
+
+func (c DomainClient) DeleteDomainWithContext(ctx context.Context, name string) (EmptyResponse, *http.Response, error) {
     body := EmptyResponse{}
-    res, j, err := c.Request("DELETE", "/domains/"+c.toStr(name), nil)
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "DELETE", "/domains/"+core.ToStr(name), nil, nil, &body)
     return body, res, err
 }
 
 func (c DomainClient) UpdateDomain(in DomainUpdateRequest, name string) (DomainSingleResponse, *http.Response, error) {
-    c.applyCurrentProject(reflect.ValueOf(&in))
+    return c.UpdateDomainWithContext(context.Background(), in, name)
+}
+
+func (c DomainClient) UpdateDomainWithContext(ctx context.Context, in DomainUpdateRequest, name string) (DomainSingleResponse, *http.Response, error) {
+    c.ApplyCurrentProject(reflect.ValueOf(&in))
     body := DomainSingleResponse{}
-    inJson, err := json.Marshal(in)
-    res, j, err := c.Request("PUT", "/domains/"+c.toStr(name), bytes.NewBuffer(inJson))
-    if err != nil {
-        return body, res, err
-    }
-    err = json.Unmarshal(j, &body)
-    if err != nil {
-        return body, res, err
-    }
-    if !body.Success {
-        errMsg, _ := json.Marshal(body.Messages.Errors)
-        return body, res, errors.New(string(errMsg))
-    }
+    res, err := c.DoWithContext(ctx, "PUT", "/domains/"+core.ToStr(name), nil, in, &body)
     return body, res, err
 }
 
@@ -0,0 +1,179 @@
+package domain_test
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "reflect"
+    "sort"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestDNSZoneRecords(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/dns/zones", domain.DNSZone{Name: "example.com"})
+    c := domain.NewClientWithUrl("token", s.ServiceUrl("domain"))
+
+    ttl := 300
+    if _, _, err := c.CreateDNSZoneRecord(domain.DNSRecordCreateRequest{Name: "www", Type: "A", Data: "192.0.2.1", Ttl: &ttl}, "example.com"); err != nil {
+        t.Fatal(err)
+    }
+    records, _, err := c.GetDNSZoneRecords("example.com", domain.GetDNSZoneRecordsQueryParams{})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(records.Data) != 1 || records.Data[0].Data != "192.0.2.1" {
+        t.Errorf("got %+v", records.Data)
+    }
+
+    if _, _, err := c.CreateDNSZoneRecord(domain.DNSRecordCreateRequest{Name: "www", Type: "A", Data: "192.0.2.1"}, "example.org"); err == nil {
+        t.Errorf("created a record in a missing zone")
+    }
+
+    check, _, err := c.CheckDomain("example.net")
+    if err != nil || !check.Data.Available {
+        t.Errorf("got %+v, %v", check.Data, err)
+    }
+}
+
+func TestListAllDNSZoneRecords(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/dns/zones", domain.DNSZone{Name: "example.com"})
+    for _, name := range []string{"a", "b", "c", "d", "e"} {
+        s.Seed("/domain/dns/zones/example.com/records", domain.DNSRecord{Name: name, Type: "A", Data: "192.0.2.1"})
+    }
+    c := domain.NewClientWithUrl("token", s.ServiceUrl("domain"))
+
+    start, pageSize := 2, 2
+    tests := []struct {
+        page *int
+        names []string
+        requests int
+    }{
+        {nil, []string{"a", "b", "c", "d", "e"}, 3},
+        {&start, []string{"c", "d", "e"}, 2},
+    }
+    for _, tt := range tests {
+        before := len(s.Requests())
+        records, err := c.ListAllDNSZoneRecords(context.Background(), "example.com", domain.GetDNSZoneRecordsQueryParams{Page: tt.page, PageSize: &pageSize}).All()
+        if err != nil {
+            t.Fatal(err)
+        }
+        names := []string{}
+        for _, r := range records {
+            names = append(names, r.Name)
+        }
+        if !reflect.DeepEqual(names, tt.names) {
+            t.Errorf("got records %v, want %v", names, tt.names)
+        }
+        if n := len(s.Requests()) - before; n != tt.requests {
+            t.Errorf("got %d requests, want %d", n, tt.requests)
+        }
+    }
+
+    s.InjectFault(lumaservtest.Fault{Method: "GET", Path: "/domain/dns/zones/example.com/records", StatusCode: 500, Count: 1})
+    it := c.ListAllDNSZoneRecords(context.Background(), "example.com", domain.GetDNSZoneRecordsQueryParams{PageSize: &pageSize})
+    if it.Next() || it.Err() == nil {
+        t.Errorf("expected the failed request to stop the iteration")
+    }
+}
+
+func TestCreateValidDNSZoneRecord(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/dns/zones", domain.DNSZone{Name: "example.com"})
+    s.Seed("/domain/dns/zones/example.com/records", domain.DNSRecord{Name: "www", Type: "A", Data: "192.0.2.1"})
+    c := domain.NewClientWithUrl("token", s.ServiceUrl("domain"))
+
+    for _, r := range []domain.DNSRecordCreateRequest{
+        domain.NewCNAMERecord("www", "example.net."),
+        {Name: "mail", Type: "A", Data: "not an address"},
+    } {
+        validationErr := &domain.DNSValidationError{}
+        if _, err := c.CreateValidDNSZoneRecord(context.Background(), "example.com", r); !errors.As(err, &validationErr) {
+            t.Errorf("%s %q: got %v, want a validation error", r.Type, r.Data, err)
+        }
+    }
+    if n := len(s.Items("/domain/dns/zones/example.com/records")); n != 1 {
+        t.Errorf("invalid records were sent to the API")
+    }
+
+    record, err := c.CreateValidDNSZoneRecord(context.Background(), "example.com", domain.NewCNAMERecord("web", "www.example.com."))
+    if err != nil || record.Name != "web" {
+        t.Errorf("got %+v, %v", record, err)
+    }
+
+    // The generated methods send records as they are.
+    if _, _, err := c.CreateDNSZoneRecord(domain.DNSRecordCreateRequest{Name: "ftp", Type: "A", Data: "not an address"}, "example.com"); err != nil {
+        t.Errorf("generated method validated the record: %v", err)
+    }
+}
+
+func TestExportThenSync(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/dns/zones", domain.DNSZone{Name: "example.com", Ns1: "ns1.example.net", Hostmaster: "hostmaster@example.com"})
+    ttl := 300
+    s.Seed("/domain/dns/zones/example.com/records",
+        domain.DNSRecord{Name: "", Type: "MX", Data: "10 mail.example.com", Ttl: &ttl},
+        domain.DNSRecord{Name: "www", Type: "CNAME", Data: "mail.example.com", Ttl: &ttl},
+        domain.DNSRecord{Name: "", Type: "TXT", Data: "v=spf1 mx -all", Ttl: &ttl},
+        domain.DNSRecord{Name: "mail", Type: "A", Data: "192.0.2.1", Ttl: &ttl},
+    )
+    c := domain.NewClientWithUrl("token", s.ServiceUrl("domain"))
+
+    b := bytes.Buffer{}
+    if err := c.ExportZoneFile(context.Background(), "example.com", &b); err != nil {
+        t.Fatal(err)
+    }
+    desired, err := domain.ParseZoneFile(&b, "example.com")
+    if err != nil {
+        t.Fatal(err)
+    }
+    plan, err := c.SyncDNSZoneRecords(context.Background(), "example.com", desired.Records, domain.DNSSyncOptions{})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !plan.Empty() {
+        t.Errorf("got plan\n%s", plan)
+    }
+}
+
+func TestScanDomainsCoversEveryProject(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/domains",
+        domain.Domain{Name: "a.example", ProjectId: "p1", Status: domain.DomainStatusActive},
+        domain.Domain{Name: "b.example", ProjectId: "p2", Status: domain.DomainStatusActive},
+        domain.Domain{Name: "c.example", ProjectId: "p3", Status: domain.DomainStatusActive},
+    )
+    c := domain.NewClientWithUrl("token", s.ServiceUrl("domain"))
+    c.SetCurrentProject("p1")
+
+    tests := []struct {
+        projects []string
+        domains []string
+    }{
+        {nil, []string{"a.example"}},
+        {[]string{"p1", "p2"}, []string{"a.example", "b.example"}},
+        {[]string{"p2", "p3"}, []string{"b.example", "c.example"}},
+    }
+    for _, tt := range tests {
+        report, err := c.ScanDomains(context.Background(), domain.DomainMonitorOptions{ProjectIds: tt.projects})
+        if err != nil {
+            t.Fatal(err)
+        }
+        names := []string{}
+        for _, d := range report.Domains {
+            names = append(names, d.Name)
+        }
+        sort.Strings(names)
+        if !reflect.DeepEqual(names, tt.domains) {
+            t.Errorf("projects %v: got domains %v, want %v", tt.projects, names, tt.domains)
+        }
+    }
+}
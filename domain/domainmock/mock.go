@@ -0,0 +1,473 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package domainmock
+
+import (
+    "context"
+    "net/http"
+    "sync"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements domain.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+    GetDomainHandleFunc func(ctx context.Context, code string) (domain.DomainHandleSingleResponse, *http.Response, error)
+    DeleteDomainHandleFunc func(ctx context.Context, code string) (domain.EmptyResponse, *http.Response, error)
+    UpdateDomainHandleFunc func(ctx context.Context, in domain.DomainHandleUpdateRequest, code string) (domain.DomainHandleSingleResponse, *http.Response, error)
+    UnscheduleDomainDeleteFunc func(ctx context.Context, name string) (domain.DomainSingleResponse, *http.Response, error)
+    CreateDNSZoneRecordFunc func(ctx context.Context, in domain.DNSRecordCreateRequest, name string) (domain.DNSRecordSingleResponse, *http.Response, error)
+    GetDNSZoneRecordsFunc func(ctx context.Context, name string, qParams domain.GetDNSZoneRecordsQueryParams) (domain.DNSRecordListResponse, *http.Response, error)
+    UpdateDNSZoneRecordsFunc func(ctx context.Context, in domain.DNSRecordsUpdateRequest, name string) (domain.DNSRecordListResponse, *http.Response, error)
+    ScheduleDomainDeleteFunc func(ctx context.Context, in domain.DomainScheduleDeleteRequest, name string) (domain.DomainSingleResponse, *http.Response, error)
+    SearchFunc func(ctx context.Context, qParams domain.SearchQueryParams) (domain.SearchResponse, *http.Response, error)
+    GetDomainPricingListFunc func(ctx context.Context, qParams domain.GetDomainPricingListQueryParams) (domain.DomainPriceListResponse, *http.Response, error)
+    GetDomainAuthinfoFunc func(ctx context.Context, name string) (domain.DomainAuthinfoResponse, *http.Response, error)
+    RemoveDomainAuthinfoFunc func(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error)
+    RestoreDomainFunc func(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error)
+    GetDNSZonesFunc func(ctx context.Context, qParams domain.GetDNSZonesQueryParams) (domain.DNSZoneListResponse, *http.Response, error)
+    DeleteDNSRecordFunc func(ctx context.Context, name string, id string) (domain.EmptyResponse, *http.Response, error)
+    UpdateDNSRecordFunc func(ctx context.Context, in domain.DNSRecordUpdateRequest, name string, id string) (domain.DNSRecordSingleResponse, *http.Response, error)
+    SendDomainVerificationFunc func(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error)
+    CheckDomainVerificationFunc func(ctx context.Context, name string) (domain.DomainCheckVerificationResponse, *http.Response, error)
+    GetDNSZoneFunc func(ctx context.Context, name string) (domain.DNSZoneSingleResponse, *http.Response, error)
+    UpdateDNSZoneFunc func(ctx context.Context, in domain.DNSZoneUpdateRequest, name string) (domain.DNSZoneSingleResponse, *http.Response, error)
+    GetLabelsFunc func(ctx context.Context, qParams domain.GetLabelsQueryParams) (domain.LabelListResponse, *http.Response, error)
+    CreateDomainHandleFunc func(ctx context.Context, in domain.DomainHandleCreateRequest) (domain.DomainHandleSingleResponse, *http.Response, error)
+    GetDomainHandlesFunc func(ctx context.Context, qParams domain.GetDomainHandlesQueryParams) (domain.DomainHandleListResponse, *http.Response, error)
+    CheckDomainFunc func(ctx context.Context, name string) (domain.DomainCheckResponse, *http.Response, error)
+    CreateDomainFunc func(ctx context.Context, in domain.DomainCreateRequest) (domain.DomainSingleResponse, *http.Response, error)
+    GetDomainsFunc func(ctx context.Context, qParams domain.GetDomainsQueryParams) (domain.DomainListResponse, *http.Response, error)
+    GetDomainFunc func(ctx context.Context, name string) (domain.DomainSingleResponse, *http.Response, error)
+    DeleteDomainFunc func(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error)
+    UpdateDomainFunc func(ctx context.Context, in domain.DomainUpdateRequest, name string) (domain.DomainSingleResponse, *http.Response, error)
+}
+
+var _ domain.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+
+func (m *Mock) GetDomainHandle(code string) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    return m.GetDomainHandleWithContext(context.Background(), code)
+}
+
+func (m *Mock) GetDomainHandleWithContext(ctx context.Context, code string) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    m.record("GetDomainHandle", code)
+    if m.GetDomainHandleFunc != nil {
+        return m.GetDomainHandleFunc(ctx, code)
+    }
+    return domain.DomainHandleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteDomainHandle(code string) (domain.EmptyResponse, *http.Response, error) {
+    return m.DeleteDomainHandleWithContext(context.Background(), code)
+}
+
+func (m *Mock) DeleteDomainHandleWithContext(ctx context.Context, code string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("DeleteDomainHandle", code)
+    if m.DeleteDomainHandleFunc != nil {
+        return m.DeleteDomainHandleFunc(ctx, code)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateDomainHandle(in domain.DomainHandleUpdateRequest, code string) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    return m.UpdateDomainHandleWithContext(context.Background(), in, code)
+}
+
+func (m *Mock) UpdateDomainHandleWithContext(ctx context.Context, in domain.DomainHandleUpdateRequest, code string) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    m.record("UpdateDomainHandle", in, code)
+    if m.UpdateDomainHandleFunc != nil {
+        return m.UpdateDomainHandleFunc(ctx, in, code)
+    }
+    return domain.DomainHandleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UnscheduleDomainDelete(name string) (domain.DomainSingleResponse, *http.Response, error) {
+    return m.UnscheduleDomainDeleteWithContext(context.Background(), name)
+}
+
+func (m *Mock) UnscheduleDomainDeleteWithContext(ctx context.Context, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    m.record("UnscheduleDomainDelete", name)
+    if m.UnscheduleDomainDeleteFunc != nil {
+        return m.UnscheduleDomainDeleteFunc(ctx, name)
+    }
+    return domain.DomainSingleResponse{}, nil, nil
+}
+
+func (m *Mock) CreateDNSZoneRecord(in domain.DNSRecordCreateRequest, name string) (domain.DNSRecordSingleResponse, *http.Response, error) {
+    return m.CreateDNSZoneRecordWithContext(context.Background(), in, name)
+}
+
+func (m *Mock) CreateDNSZoneRecordWithContext(ctx context.Context, in domain.DNSRecordCreateRequest, name string) (domain.DNSRecordSingleResponse, *http.Response, error) {
+    m.record("CreateDNSZoneRecord", in, name)
+    if m.CreateDNSZoneRecordFunc != nil {
+        return m.CreateDNSZoneRecordFunc(ctx, in, name)
+    }
+    return domain.DNSRecordSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetDNSZoneRecords(name string, qParams domain.GetDNSZoneRecordsQueryParams) (domain.DNSRecordListResponse, *http.Response, error) {
+    return m.GetDNSZoneRecordsWithContext(context.Background(), name, qParams)
+}
+
+func (m *Mock) GetDNSZoneRecordsWithContext(ctx context.Context, name string, qParams domain.GetDNSZoneRecordsQueryParams) (domain.DNSRecordListResponse, *http.Response, error) {
+    m.record("GetDNSZoneRecords", name, qParams)
+    if m.GetDNSZoneRecordsFunc != nil {
+        return m.GetDNSZoneRecordsFunc(ctx, name, qParams)
+    }
+    return domain.DNSRecordListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDNSZoneRecords(ctx context.Context, name string, qParams domain.GetDNSZoneRecordsQueryParams) *domain.DNSRecordIterator {
+    return domain.NewDNSRecordIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]domain.DNSRecord, *domain.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDNSZoneRecordsWithContext(ctx, name, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) UpdateDNSZoneRecords(in domain.DNSRecordsUpdateRequest, name string) (domain.DNSRecordListResponse, *http.Response, error) {
+    return m.UpdateDNSZoneRecordsWithContext(context.Background(), in, name)
+}
+
+func (m *Mock) UpdateDNSZoneRecordsWithContext(ctx context.Context, in domain.DNSRecordsUpdateRequest, name string) (domain.DNSRecordListResponse, *http.Response, error) {
+    m.record("UpdateDNSZoneRecords", in, name)
+    if m.UpdateDNSZoneRecordsFunc != nil {
+        return m.UpdateDNSZoneRecordsFunc(ctx, in, name)
+    }
+    return domain.DNSRecordListResponse{}, nil, nil
+}
+
+func (m *Mock) ScheduleDomainDelete(in domain.DomainScheduleDeleteRequest, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    return m.ScheduleDomainDeleteWithContext(context.Background(), in, name)
+}
+
+func (m *Mock) ScheduleDomainDeleteWithContext(ctx context.Context, in domain.DomainScheduleDeleteRequest, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    m.record("ScheduleDomainDelete", in, name)
+    if m.ScheduleDomainDeleteFunc != nil {
+        return m.ScheduleDomainDeleteFunc(ctx, in, name)
+    }
+    return domain.DomainSingleResponse{}, nil, nil
+}
+
+func (m *Mock) Search(qParams domain.SearchQueryParams) (domain.SearchResponse, *http.Response, error) {
+    return m.SearchWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) SearchWithContext(ctx context.Context, qParams domain.SearchQueryParams) (domain.SearchResponse, *http.Response, error) {
+    m.record("Search", qParams)
+    if m.SearchFunc != nil {
+        return m.SearchFunc(ctx, qParams)
+    }
+    return domain.SearchResponse{}, nil, nil
+}
+
+func (m *Mock) GetDomainPricingList(qParams domain.GetDomainPricingListQueryParams) (domain.DomainPriceListResponse, *http.Response, error) {
+    return m.GetDomainPricingListWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDomainPricingListWithContext(ctx context.Context, qParams domain.GetDomainPricingListQueryParams) (domain.DomainPriceListResponse, *http.Response, error) {
+    m.record("GetDomainPricingList", qParams)
+    if m.GetDomainPricingListFunc != nil {
+        return m.GetDomainPricingListFunc(ctx, qParams)
+    }
+    return domain.DomainPriceListResponse{}, nil, nil
+}
+
+func (m *Mock) GetDomainAuthinfo(name string) (domain.DomainAuthinfoResponse, *http.Response, error) {
+    return m.GetDomainAuthinfoWithContext(context.Background(), name)
+}
+
+func (m *Mock) GetDomainAuthinfoWithContext(ctx context.Context, name string) (domain.DomainAuthinfoResponse, *http.Response, error) {
+    m.record("GetDomainAuthinfo", name)
+    if m.GetDomainAuthinfoFunc != nil {
+        return m.GetDomainAuthinfoFunc(ctx, name)
+    }
+    return domain.DomainAuthinfoResponse{}, nil, nil
+}
+
+func (m *Mock) RemoveDomainAuthinfo(name string) (domain.EmptyResponse, *http.Response, error) {
+    return m.RemoveDomainAuthinfoWithContext(context.Background(), name)
+}
+
+func (m *Mock) RemoveDomainAuthinfoWithContext(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("RemoveDomainAuthinfo", name)
+    if m.RemoveDomainAuthinfoFunc != nil {
+        return m.RemoveDomainAuthinfoFunc(ctx, name)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) RestoreDomain(name string) (domain.EmptyResponse, *http.Response, error) {
+    return m.RestoreDomainWithContext(context.Background(), name)
+}
+
+func (m *Mock) RestoreDomainWithContext(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("RestoreDomain", name)
+    if m.RestoreDomainFunc != nil {
+        return m.RestoreDomainFunc(ctx, name)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) GetDNSZones(qParams domain.GetDNSZonesQueryParams) (domain.DNSZoneListResponse, *http.Response, error) {
+    return m.GetDNSZonesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDNSZonesWithContext(ctx context.Context, qParams domain.GetDNSZonesQueryParams) (domain.DNSZoneListResponse, *http.Response, error) {
+    m.record("GetDNSZones", qParams)
+    if m.GetDNSZonesFunc != nil {
+        return m.GetDNSZonesFunc(ctx, qParams)
+    }
+    return domain.DNSZoneListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDNSZones(ctx context.Context, qParams domain.GetDNSZonesQueryParams) *domain.DNSZoneIterator {
+    return domain.NewDNSZoneIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]domain.DNSZone, *domain.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDNSZonesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) DeleteDNSRecord(name string, id string) (domain.EmptyResponse, *http.Response, error) {
+    return m.DeleteDNSRecordWithContext(context.Background(), name, id)
+}
+
+func (m *Mock) DeleteDNSRecordWithContext(ctx context.Context, name string, id string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("DeleteDNSRecord", name, id)
+    if m.DeleteDNSRecordFunc != nil {
+        return m.DeleteDNSRecordFunc(ctx, name, id)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateDNSRecord(in domain.DNSRecordUpdateRequest, name string, id string) (domain.DNSRecordSingleResponse, *http.Response, error) {
+    return m.UpdateDNSRecordWithContext(context.Background(), in, name, id)
+}
+
+func (m *Mock) UpdateDNSRecordWithContext(ctx context.Context, in domain.DNSRecordUpdateRequest, name string, id string) (domain.DNSRecordSingleResponse, *http.Response, error) {
+    m.record("UpdateDNSRecord", in, name, id)
+    if m.UpdateDNSRecordFunc != nil {
+        return m.UpdateDNSRecordFunc(ctx, in, name, id)
+    }
+    return domain.DNSRecordSingleResponse{}, nil, nil
+}
+
+func (m *Mock) SendDomainVerification(name string) (domain.EmptyResponse, *http.Response, error) {
+    return m.SendDomainVerificationWithContext(context.Background(), name)
+}
+
+func (m *Mock) SendDomainVerificationWithContext(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("SendDomainVerification", name)
+    if m.SendDomainVerificationFunc != nil {
+        return m.SendDomainVerificationFunc(ctx, name)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) CheckDomainVerification(name string) (domain.DomainCheckVerificationResponse, *http.Response, error) {
+    return m.CheckDomainVerificationWithContext(context.Background(), name)
+}
+
+func (m *Mock) CheckDomainVerificationWithContext(ctx context.Context, name string) (domain.DomainCheckVerificationResponse, *http.Response, error) {
+    m.record("CheckDomainVerification", name)
+    if m.CheckDomainVerificationFunc != nil {
+        return m.CheckDomainVerificationFunc(ctx, name)
+    }
+    return domain.DomainCheckVerificationResponse{}, nil, nil
+}
+
+func (m *Mock) GetDNSZone(name string) (domain.DNSZoneSingleResponse, *http.Response, error) {
+    return m.GetDNSZoneWithContext(context.Background(), name)
+}
+
+func (m *Mock) GetDNSZoneWithContext(ctx context.Context, name string) (domain.DNSZoneSingleResponse, *http.Response, error) {
+    m.record("GetDNSZone", name)
+    if m.GetDNSZoneFunc != nil {
+        return m.GetDNSZoneFunc(ctx, name)
+    }
+    return domain.DNSZoneSingleResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateDNSZone(in domain.DNSZoneUpdateRequest, name string) (domain.DNSZoneSingleResponse, *http.Response, error) {
+    return m.UpdateDNSZoneWithContext(context.Background(), in, name)
+}
+
+func (m *Mock) UpdateDNSZoneWithContext(ctx context.Context, in domain.DNSZoneUpdateRequest, name string) (domain.DNSZoneSingleResponse, *http.Response, error) {
+    m.record("UpdateDNSZone", in, name)
+    if m.UpdateDNSZoneFunc != nil {
+        return m.UpdateDNSZoneFunc(ctx, in, name)
+    }
+    return domain.DNSZoneSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetLabels(qParams domain.GetLabelsQueryParams) (domain.LabelListResponse, *http.Response, error) {
+    return m.GetLabelsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetLabelsWithContext(ctx context.Context, qParams domain.GetLabelsQueryParams) (domain.LabelListResponse, *http.Response, error) {
+    m.record("GetLabels", qParams)
+    if m.GetLabelsFunc != nil {
+        return m.GetLabelsFunc(ctx, qParams)
+    }
+    return domain.LabelListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllLabels(ctx context.Context, qParams domain.GetLabelsQueryParams) *domain.LabelIterator {
+    return domain.NewLabelIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]domain.Label, *domain.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetLabelsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CreateDomainHandle(in domain.DomainHandleCreateRequest) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    return m.CreateDomainHandleWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateDomainHandleWithContext(ctx context.Context, in domain.DomainHandleCreateRequest) (domain.DomainHandleSingleResponse, *http.Response, error) {
+    m.record("CreateDomainHandle", in)
+    if m.CreateDomainHandleFunc != nil {
+        return m.CreateDomainHandleFunc(ctx, in)
+    }
+    return domain.DomainHandleSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetDomainHandles(qParams domain.GetDomainHandlesQueryParams) (domain.DomainHandleListResponse, *http.Response, error) {
+    return m.GetDomainHandlesWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDomainHandlesWithContext(ctx context.Context, qParams domain.GetDomainHandlesQueryParams) (domain.DomainHandleListResponse, *http.Response, error) {
+    m.record("GetDomainHandles", qParams)
+    if m.GetDomainHandlesFunc != nil {
+        return m.GetDomainHandlesFunc(ctx, qParams)
+    }
+    return domain.DomainHandleListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDomainHandles(ctx context.Context, qParams domain.GetDomainHandlesQueryParams) *domain.DomainHandleIterator {
+    return domain.NewDomainHandleIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]domain.DomainHandle, *domain.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDomainHandlesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) CheckDomain(name string) (domain.DomainCheckResponse, *http.Response, error) {
+    return m.CheckDomainWithContext(context.Background(), name)
+}
+
+func (m *Mock) CheckDomainWithContext(ctx context.Context, name string) (domain.DomainCheckResponse, *http.Response, error) {
+    m.record("CheckDomain", name)
+    if m.CheckDomainFunc != nil {
+        return m.CheckDomainFunc(ctx, name)
+    }
+    return domain.DomainCheckResponse{}, nil, nil
+}
+
+func (m *Mock) CreateDomain(in domain.DomainCreateRequest) (domain.DomainSingleResponse, *http.Response, error) {
+    return m.CreateDomainWithContext(context.Background(), in)
+}
+
+func (m *Mock) CreateDomainWithContext(ctx context.Context, in domain.DomainCreateRequest) (domain.DomainSingleResponse, *http.Response, error) {
+    m.record("CreateDomain", in)
+    if m.CreateDomainFunc != nil {
+        return m.CreateDomainFunc(ctx, in)
+    }
+    return domain.DomainSingleResponse{}, nil, nil
+}
+
+func (m *Mock) GetDomains(qParams domain.GetDomainsQueryParams) (domain.DomainListResponse, *http.Response, error) {
+    return m.GetDomainsWithContext(context.Background(), qParams)
+}
+
+func (m *Mock) GetDomainsWithContext(ctx context.Context, qParams domain.GetDomainsQueryParams) (domain.DomainListResponse, *http.Response, error) {
+    m.record("GetDomains", qParams)
+    if m.GetDomainsFunc != nil {
+        return m.GetDomainsFunc(ctx, qParams)
+    }
+    return domain.DomainListResponse{}, nil, nil
+}
+
+func (m *Mock) ListAllDomains(ctx context.Context, qParams domain.GetDomainsQueryParams) *domain.DomainIterator {
+    return domain.NewDomainIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]domain.Domain, *domain.ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := m.GetDomainsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+func (m *Mock) GetDomain(name string) (domain.DomainSingleResponse, *http.Response, error) {
+    return m.GetDomainWithContext(context.Background(), name)
+}
+
+func (m *Mock) GetDomainWithContext(ctx context.Context, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    m.record("GetDomain", name)
+    if m.GetDomainFunc != nil {
+        return m.GetDomainFunc(ctx, name)
+    }
+    return domain.DomainSingleResponse{}, nil, nil
+}
+
+func (m *Mock) DeleteDomain(name string) (domain.EmptyResponse, *http.Response, error) {
+    return m.DeleteDomainWithContext(context.Background(), name)
+}
+
+func (m *Mock) DeleteDomainWithContext(ctx context.Context, name string) (domain.EmptyResponse, *http.Response, error) {
+    m.record("DeleteDomain", name)
+    if m.DeleteDomainFunc != nil {
+        return m.DeleteDomainFunc(ctx, name)
+    }
+    return domain.EmptyResponse{}, nil, nil
+}
+
+func (m *Mock) UpdateDomain(in domain.DomainUpdateRequest, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    return m.UpdateDomainWithContext(context.Background(), in, name)
+}
+
+func (m *Mock) UpdateDomainWithContext(ctx context.Context, in domain.DomainUpdateRequest, name string) (domain.DomainSingleResponse, *http.Response, error) {
+    m.record("UpdateDomain", in, name)
+    if m.UpdateDomainFunc != nil {
+        return m.UpdateDomainFunc(ctx, in, name)
+    }
+    return domain.DomainSingleResponse{}, nil, nil
+}
@@ -0,0 +1,278 @@
+package domain
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+)
+
+type DomainAlertKind string
+
+const (
+    DomainAlertExpiring DomainAlertKind = "expiring"
+    DomainAlertExpired DomainAlertKind = "expired"
+    DomainAlertSuspended DomainAlertKind = "suspended"
+    DomainAlertStatus DomainAlertKind = "status"
+)
+
+// DomainMonitorOptions configures ScanDomains. A zero ExpiryDays falls back to 30 days.
+type DomainMonitorOptions struct {
+    // ProjectIds limits the scan to the given projects. If empty, the current project of
+    // the client is scanned, or all accessible domains if none is set.
+    ProjectIds []string
+    ExpiryDays int
+    // Now overrides the current time, mainly for testing.
+    Now func() time.Time
+}
+
+func (o DomainMonitorOptions) withDefaults() DomainMonitorOptions {
+    if o.ExpiryDays <= 0 {
+        o.ExpiryDays = 30
+    }
+    if o.Now == nil {
+        o.Now = time.Now
+    }
+    return o
+}
+
+type DomainAlert struct {
+    Kind DomainAlertKind `json:"kind"`
+    Message string `json:"message"`
+}
+
+// DomainState is the monitoring state of a single domain.
+type DomainState struct {
+    Name string `json:"name"`
+    ProjectId string `json:"project_id"`
+    Status DomainStatus `json:"status"`
+    ExpireAt *time.Time `json:"expire_at"`
+    DaysLeft *int `json:"days_left"`
+    Suspended bool `json:"suspended"`
+    Alerts []DomainAlert `json:"alerts"`
+}
+
+// DomainReport is the result of ScanDomains.
+type DomainReport struct {
+    CheckedAt time.Time `json:"checked_at"`
+    Domains []DomainState `json:"domains"`
+}
+
+func parseDomainTime(value string) (time.Time, error) {
+    if t, err := time.Parse(time.RFC3339, value); err == nil {
+        return t, nil
+    }
+    return time.Parse("2006-01-02", value)
+}
+
+// EvaluateDomain evaluates a domain at the given time. Domains get alerts when they expire
+// within expiryDays or already expired, are suspended or are not in the ACTIVE status.
+func EvaluateDomain(d Domain, now time.Time, expiryDays int) DomainState {
+    state := DomainState{
+        Name: d.Name,
+        ProjectId: d.ProjectId,
+        Status: d.Status,
+        Alerts: []DomainAlert{},
+    }
+
+    if d.ExpireAt != nil {
+        if expireAt, err := parseDomainTime(*d.ExpireAt); err == nil {
+            daysLeft := int(expireAt.Sub(now).Hours() / 24)
+            state.ExpireAt = &expireAt
+            state.DaysLeft = &daysLeft
+            if !expireAt.After(now) {
+                state.Alerts = append(state.Alerts, DomainAlert{Kind: DomainAlertExpired, Message: "expired on " + expireAt.Format("2006-01-02")})
+            } else if daysLeft < expiryDays {
+                state.Alerts = append(state.Alerts, DomainAlert{Kind: DomainAlertExpiring, Message: fmt.Sprintf("expires in %d days on %s", daysLeft, expireAt.Format("2006-01-02"))})
+            }
+        }
+    }
+
+    state.Suspended = d.Status == DomainStatusSuspended
+    if d.SuspendedAt != nil {
+        state.Suspended = true
+        if d.SuspendedUntil != nil {
+            if until, err := parseDomainTime(*d.SuspendedUntil); err == nil && !until.After(now) {
+                state.Suspended = d.Status == DomainStatusSuspended
+            }
+        }
+    }
+    if state.Suspended {
+        message := "suspended"
+        if d.SuspendedUntil != nil {
+            message += " until " + *d.SuspendedUntil
+        }
+        state.Alerts = append(state.Alerts, DomainAlert{Kind: DomainAlertSuspended, Message: message})
+    }
+
+    if d.Status != DomainStatusActive && d.Status != DomainStatusSuspended && !(d.Status == DomainStatusExpired && state.hasAlert(DomainAlertExpired)) {
+        state.Alerts = append(state.Alerts, DomainAlert{Kind: DomainAlertStatus, Message: "status is " + string(d.Status)})
+    }
+    return state
+}
+
+func (s DomainState) hasAlert(kind DomainAlertKind) bool {
+    for _, a := range s.Alerts {
+        if a.Kind == kind {
+            return true
+        }
+    }
+    return false
+}
+
+// ScanDomains scans all domains of the selected projects. Domains are sorted by their
+// expiry date, domains without one come last.
+func (c DomainClient) ScanDomains(ctx context.Context, opts DomainMonitorOptions) (DomainReport, error) {
+    opts = opts.withDefaults()
+    report := DomainReport{CheckedAt: opts.Now(), Domains: []DomainState{}}
+
+    filters := []*GetDomainsQueryParamsFilter{nil}
+    if len(opts.ProjectIds) > 0 {
+        filters = []*GetDomainsQueryParamsFilter{}
+        for i := range opts.ProjectIds {
+            filters = append(filters, &GetDomainsQueryParamsFilter{ProjectId: &opts.ProjectIds[i]})
+        }
+    }
+    for _, filter := range filters {
+        it := c.ListAllDomains(ctx, GetDomainsQueryParams{Filter: filter})
+        for it.Next() {
+            report.Domains = append(report.Domains, EvaluateDomain(it.Value(), report.CheckedAt, opts.ExpiryDays))
+        }
+        if err := it.Err(); err != nil {
+            return report, err
+        }
+    }
+
+    sort.SliceStable(report.Domains, func(i, j int) bool {
+        a, b := report.Domains[i].ExpireAt, report.Domains[j].ExpireAt
+        if a == nil || b == nil {
+            return a != nil
+        }
+        return a.Before(*b)
+    })
+    return report, nil
+}
+
+// Alerting returns the domains with at least one alert.
+func (r DomainReport) Alerting() []DomainState {
+    alerting := []DomainState{}
+    for _, d := range r.Domains {
+        if len(d.Alerts) > 0 {
+            alerting = append(alerting, d)
+        }
+    }
+    return alerting
+}
+
+func (r DomainReport) WriteJSON(w io.Writer) error {
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(r)
+}
+
+func promLabel(value string) string {
+    return strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n").Replace(value)
+}
+
+// WritePrometheus writes the report as gauges in the Prometheus text format.
+func (r DomainReport) WritePrometheus(w io.Writer) error {
+    b := &bytes.Buffer{}
+    gauge := func(name string, help string, value func(d DomainState) (float64, bool), extra func(d DomainState) string) {
+        fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+        for _, d := range r.Domains {
+            v, ok := value(d)
+            if !ok {
+                continue
+            }
+            labels := fmt.Sprintf("domain=\"%s\",project_id=\"%s\"", promLabel(d.Name), promLabel(d.ProjectId))
+            if extra != nil {
+                labels += "," + extra(d)
+            }
+            fmt.Fprintf(b, "%s{%s} %s\n", name, labels, strconv.FormatFloat(v, 'f', -1, 64))
+        }
+    }
+    gauge("lumaserv_domain_expiry_timestamp_seconds", "Expiry date of the domain as unix timestamp.", func(d DomainState) (float64, bool) {
+        if d.ExpireAt == nil {
+            return 0, false
+        }
+        return float64(d.ExpireAt.Unix()), true
+    }, nil)
+    gauge("lumaserv_domain_suspended", "Whether the domain is suspended.", func(d DomainState) (float64, bool) {
+        if d.Suspended {
+            return 1, true
+        }
+        return 0, true
+    }, nil)
+    gauge("lumaserv_domain_status", "Current status of the domain.", func(d DomainState) (float64, bool) {
+        return 1, true
+    }, func(d DomainState) string {
+        return fmt.Sprintf("status=\"%s\"", promLabel(string(d.Status)))
+    })
+    gauge("lumaserv_domain_alerts", "Number of alerts of the domain.", func(d DomainState) (float64, bool) {
+        return float64(len(d.Alerts)), true
+    }, nil)
+    fmt.Fprintf(b, "# HELP lumaserv_domain_check_timestamp_seconds Time of the check as unix timestamp.\n# TYPE lumaserv_domain_check_timestamp_seconds gauge\nlumaserv_domain_check_timestamp_seconds %d\n", r.CheckedAt.Unix())
+    _, err := w.Write(b.Bytes())
+    return err
+}
+
+// DomainWebhookPayload is the body posted by SendWebhook. Text contains a summary, which
+// makes the payload usable with Slack and Mattermost compatible webhooks.
+type DomainWebhookPayload struct {
+    Text string `json:"text"`
+    CheckedAt time.Time `json:"checked_at"`
+    Domains []DomainState `json:"domains"`
+}
+
+func (r DomainReport) WebhookPayload() DomainWebhookPayload {
+    alerting := r.Alerting()
+    lines := []string{fmt.Sprintf("%d of %d domains need attention", len(alerting), len(r.Domains))}
+    for _, d := range alerting {
+        messages := []string{}
+        for _, a := range d.Alerts {
+            messages = append(messages, a.Message)
+        }
+        lines = append(lines, fmt.Sprintf("%s: %s", d.Name, strings.Join(messages, ", ")))
+    }
+    return DomainWebhookPayload{
+        Text: strings.Join(lines, "\n"),
+        CheckedAt: r.CheckedAt,
+        Domains: alerting,
+    }
+}
+
+// SendWebhook posts the webhook payload of the report to url. Nothing is sent if no domain
+// has an alert. A nil client uses http.DefaultClient.
+func (r DomainReport) SendWebhook(ctx context.Context, client *http.Client, url string) error {
+    if len(r.Alerting()) == 0 {
+        return nil
+    }
+    if client == nil {
+        client = http.DefaultClient
+    }
+    body, err := json.Marshal(r.WebhookPayload())
+    if err != nil {
+        return err
+    }
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    res, err := client.Do(req)
+    if err != nil {
+        return err
+    }
+    defer res.Body.Close()
+    io.Copy(io.Discard, res.Body)
+    if res.StatusCode >= 300 {
+        return fmt.Errorf("webhook returned status %d", res.StatusCode)
+    }
+    return nil
+}
@@ -0,0 +1,323 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package domain
+
+import (
+    "context"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// DNSRecordIterator iterates over the DNSRecord items of all pages of a list.
+type DNSRecordIterator struct {
+    pager *core.Pager
+    items []DNSRecord
+    current DNSRecord
+}
+
+// NewDNSRecordIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDNSRecordIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]DNSRecord, *ResponsePagination, error)) *DNSRecordIterator {
+    it := &DNSRecordIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DNSRecordIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DNSRecordIterator) Value() DNSRecord {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DNSRecordIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DNSRecordIterator) All() ([]DNSRecord, error) {
+    all := []DNSRecord{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// DNSZoneIterator iterates over the DNSZone items of all pages of a list.
+type DNSZoneIterator struct {
+    pager *core.Pager
+    items []DNSZone
+    current DNSZone
+}
+
+// NewDNSZoneIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDNSZoneIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]DNSZone, *ResponsePagination, error)) *DNSZoneIterator {
+    it := &DNSZoneIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DNSZoneIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DNSZoneIterator) Value() DNSZone {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DNSZoneIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DNSZoneIterator) All() ([]DNSZone, error) {
+    all := []DNSZone{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// LabelIterator iterates over the Label items of all pages of a list.
+type LabelIterator struct {
+    pager *core.Pager
+    items []Label
+    current Label
+}
+
+// NewLabelIterator returns an iterator over the items returned by fetch, starting at page.
+func NewLabelIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Label, *ResponsePagination, error)) *LabelIterator {
+    it := &LabelIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *LabelIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *LabelIterator) Value() Label {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *LabelIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *LabelIterator) All() ([]Label, error) {
+    all := []Label{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// DomainHandleIterator iterates over the DomainHandle items of all pages of a list.
+type DomainHandleIterator struct {
+    pager *core.Pager
+    items []DomainHandle
+    current DomainHandle
+}
+
+// NewDomainHandleIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDomainHandleIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]DomainHandle, *ResponsePagination, error)) *DomainHandleIterator {
+    it := &DomainHandleIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DomainHandleIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DomainHandleIterator) Value() DomainHandle {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DomainHandleIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DomainHandleIterator) All() ([]DomainHandle, error) {
+    all := []DomainHandle{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// DomainIterator iterates over the Domain items of all pages of a list.
+type DomainIterator struct {
+    pager *core.Pager
+    items []Domain
+    current Domain
+}
+
+// NewDomainIterator returns an iterator over the items returned by fetch, starting at page.
+func NewDomainIterator(ctx context.Context, page *int, pageSize *int, fetch func(ctx context.Context, page int, pageSize *int) ([]Domain, *ResponsePagination, error)) *DomainIterator {
+    it := &DomainIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *DomainIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *DomainIterator) Value() Domain {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DomainIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *DomainIterator) All() ([]Domain, error) {
+    all := []Domain{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+
+// ListAllDNSZoneRecords iterates over the items of all pages of GetDNSZoneRecords.
+func (c DomainClient) ListAllDNSZoneRecords(ctx context.Context, name string, qParams GetDNSZoneRecordsQueryParams) *DNSRecordIterator {
+    return NewDNSRecordIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]DNSRecord, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDNSZoneRecordsWithContext(ctx, name, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllDNSZones iterates over the items of all pages of GetDNSZones.
+func (c DomainClient) ListAllDNSZones(ctx context.Context, qParams GetDNSZonesQueryParams) *DNSZoneIterator {
+    return NewDNSZoneIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]DNSZone, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDNSZonesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllLabels iterates over the items of all pages of GetLabels.
+func (c DomainClient) ListAllLabels(ctx context.Context, qParams GetLabelsQueryParams) *LabelIterator {
+    return NewLabelIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Label, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetLabelsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllDomainHandles iterates over the items of all pages of GetDomainHandles.
+func (c DomainClient) ListAllDomainHandles(ctx context.Context, qParams GetDomainHandlesQueryParams) *DomainHandleIterator {
+    return NewDomainHandleIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]DomainHandle, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDomainHandlesWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
+
+// ListAllDomains iterates over the items of all pages of GetDomains.
+func (c DomainClient) ListAllDomains(ctx context.Context, qParams GetDomainsQueryParams) *DomainIterator {
+    return NewDomainIterator(ctx, qParams.Page, qParams.PageSize, func(ctx context.Context, page int, pageSize *int) ([]Domain, *ResponsePagination, error) {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := c.GetDomainsWithContext(ctx, qParams)
+        return res.Data, res.Pagination, err
+    })
+}
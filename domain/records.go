@@ -0,0 +1,88 @@
+package domain
+
+import (
+    "context"
+    "fmt"
+    "net"
+    "strconv"
+    "strings"
+)
+
+const (
+    DNSRecordTypeA = "A"
+    DNSRecordTypeAAAA = "AAAA"
+    DNSRecordTypeCAA = "CAA"
+    DNSRecordTypeCNAME = "CNAME"
+    DNSRecordTypeMX = "MX"
+    DNSRecordTypeNS = "NS"
+    DNSRecordTypePTR = "PTR"
+    DNSRecordTypeSRV = "SRV"
+    DNSRecordTypeTLSA = "TLSA"
+    DNSRecordTypeTXT = "TXT"
+)
+
+func NewARecord(name string, ip net.IP) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeA, Data: ip.String()}
+}
+
+func NewAAAARecord(name string, ip net.IP) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeAAAA, Data: ip.String()}
+}
+
+func NewCNAMERecord(name string, target string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeCNAME, Data: target}
+}
+
+func NewMXRecord(name string, priority int, host string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeMX, Data: strconv.Itoa(priority) + " " + host}
+}
+
+// NewTXTRecord creates a TXT record with unquoted text, texts longer than 255 bytes are
+// split into multiple strings, see SplitTXT.
+func NewTXTRecord(name string, text string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeTXT, Data: text}
+}
+
+// NewSRVRecord creates a SRV record, name has the form _service._proto[.name].
+func NewSRVRecord(name string, priority int, weight int, port int, target string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeSRV, Data: fmt.Sprintf("%d %d %d %s", priority, weight, port, target)}
+}
+
+func NewCAARecord(name string, flags int, tag string, value string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeCAA, Data: fmt.Sprintf("%d %s %s", flags, tag, strconv.Quote(value))}
+}
+
+func NewNSRecord(name string, host string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeNS, Data: host}
+}
+
+func NewPTRRecord(name string, host string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypePTR, Data: host}
+}
+
+// NewTLSARecord creates a TLSA record, data is the hex encoded certificate association data.
+func NewTLSARecord(name string, usage int, selector int, matchingType int, data string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeTLSA, Data: fmt.Sprintf("%d %d %d %s", usage, selector, matchingType, strings.ToLower(data))}
+}
+
+// ValidateDNSZoneRecord validates the record and checks it for conflicts with the current
+// records of the zone.
+func (c DomainClient) ValidateDNSZoneRecord(ctx context.Context, zone string, r DNSRecordCreateRequest) error {
+    if err := ValidateDNSRecord(r); err != nil {
+        return err
+    }
+    existing, err := c.ListAllDNSZoneRecords(ctx, zone, GetDNSZoneRecordsQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    return CheckDNSRecordConflicts(r, existing)
+}
+
+// CreateValidDNSZoneRecord creates the record after checking it with ValidateDNSZoneRecord.
+func (c DomainClient) CreateValidDNSZoneRecord(ctx context.Context, zone string, r DNSRecordCreateRequest) (DNSRecord, error) {
+    if err := c.ValidateDNSZoneRecord(ctx, zone, r); err != nil {
+        return DNSRecord{}, err
+    }
+    res, _, err := c.CreateDNSZoneRecordWithContext(ctx, r, zone)
+    return res.Data, err
+}
@@ -0,0 +1,308 @@
+package domain
+
+import (
+    "context"
+    "fmt"
+    "io"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+type DNSChangeAction string
+
+const (
+    DNSChangeCreate DNSChangeAction = "create"
+    DNSChangeUpdate DNSChangeAction = "update"
+    DNSChangeDelete DNSChangeAction = "delete"
+)
+
+// DNSChange is a single step of a DNSPlan. Current is set for updates and deletes, Desired
+// for creates and updates.
+type DNSChange struct {
+    Action DNSChangeAction
+    Current *DNSRecord
+    Desired *DNSRecordCreateRequest
+}
+
+// DNSPlan is the set of changes needed to bring the records of a zone to a desired state.
+type DNSPlan struct {
+    Zone string
+    Changes []DNSChange
+    // Protected lists NS and SOA records which are not part of the desired state but were
+    // kept, see DNSSyncOptions.DeleteProtected.
+    Protected []DNSRecord
+}
+
+type DNSSyncOptions struct {
+    // DryRun only computes the plan without applying it.
+    DryRun bool
+    // DeleteProtected allows the plan to delete NS and SOA records missing from the desired
+    // state. By default they are kept.
+    DeleteProtected bool
+}
+
+func dnsRecordKey(name string, recordType string, data string) string {
+    name = strings.ToLower(strings.TrimSuffix(name, "."))
+    if name == "@" {
+        name = ""
+    }
+    recordType = strings.ToUpper(recordType)
+    return name + "\x00" + recordType + "\x00" + normalizeRecordData(recordType, data)
+}
+
+// normalizeRecordData brings record data into a canonical form, so that the data of the
+// API and of zone files compare equal. Domain names lose the trailing dot and are lower
+// cased, TXT strings are unquoted and concatenated, and CAA values are unquoted.
+func normalizeRecordData(recordType string, data string) string {
+    data = strings.TrimSpace(data)
+    if recordType == "TXT" {
+        return unquoteTXT(data)
+    }
+    fields := strings.Fields(data)
+    for _, i := range zoneNameFields[recordType] {
+        if i < len(fields) && fields[i] != "." {
+            fields[i] = strings.ToLower(strings.TrimSuffix(fields[i], "."))
+        }
+    }
+    if recordType == "CAA" && len(fields) >= 3 {
+        value := strings.Join(fields[2:], " ")
+        if unquoted, err := strconv.Unquote(value); err == nil {
+            value = unquoted
+        }
+        fields = append(fields[:2], value)
+    }
+    return strings.Join(fields, " ")
+}
+
+// unquoteTXT concatenates the character strings of TXT data like "v=spf1 " "-all". Data
+// that is not a sequence of quoted strings is returned unchanged.
+func unquoteTXT(data string) string {
+    if !strings.HasPrefix(data, "\"") {
+        return data
+    }
+    b := strings.Builder{}
+    for rest := data; len(rest) > 0; rest = strings.TrimLeft(rest, " \t") {
+        if rest[0] != '"' {
+            return data
+        }
+        s, n, err := readQuoted(rest)
+        if err != nil {
+            return data
+        }
+        b.WriteString(s)
+        rest = rest[n:]
+    }
+    return b.String()
+}
+
+func isProtectedRecord(recordType string) bool {
+    t := strings.ToUpper(recordType)
+    return t == "NS" || t == "SOA"
+}
+
+func sameTtl(a *int, b *int) bool {
+    if a == nil || b == nil {
+        return a == b
+    }
+    return *a == *b
+}
+
+// PlanDNSRecords computes the changes from the current to the desired records of a zone.
+// Records are matched on name, type and data, so a record only gets updated when its TTL
+// differs, changed data results in a delete and a create.
+func PlanDNSRecords(zone string, current []DNSRecord, desired []DNSRecordCreateRequest, opts DNSSyncOptions) DNSPlan {
+    plan := DNSPlan{Zone: zone, Changes: []DNSChange{}, Protected: []DNSRecord{}}
+
+    existing := map[string][]int{}
+    for i, r := range current {
+        key := dnsRecordKey(r.Name, r.Type, r.Data)
+        existing[key] = append(existing[key], i)
+    }
+
+    matched := make([]bool, len(current))
+    seen := map[string]bool{}
+    creates := []DNSChange{}
+    updates := []DNSChange{}
+    for i := range desired {
+        d := desired[i]
+        key := dnsRecordKey(d.Name, d.Type, d.Data)
+        if seen[key] {
+            continue
+        }
+        seen[key] = true
+        if len(existing[key]) == 0 {
+            creates = append(creates, DNSChange{Action: DNSChangeCreate, Desired: &d})
+            continue
+        }
+        r := current[existing[key][0]]
+        matched[existing[key][0]] = true
+        if d.Ttl != nil && !sameTtl(r.Ttl, d.Ttl) {
+            updates = append(updates, DNSChange{Action: DNSChangeUpdate, Current: &r, Desired: &d})
+        }
+    }
+
+    deletes := []DNSChange{}
+    for i := range current {
+        if matched[i] {
+            continue
+        }
+        r := current[i]
+        if isProtectedRecord(r.Type) && !opts.DeleteProtected {
+            plan.Protected = append(plan.Protected, r)
+            continue
+        }
+        deletes = append(deletes, DNSChange{Action: DNSChangeDelete, Current: &r})
+    }
+
+    // Deletes go first, so that replaced records like CNAMEs do not conflict with the new ones.
+    plan.Changes = append(plan.Changes, deletes...)
+    plan.Changes = append(plan.Changes, updates...)
+    plan.Changes = append(plan.Changes, creates...)
+    return plan
+}
+
+func (p DNSPlan) Empty() bool {
+    return len(p.Changes) == 0
+}
+
+func (p DNSPlan) count(action DNSChangeAction) int {
+    n := 0
+    for _, c := range p.Changes {
+        if c.Action == action {
+            n++
+        }
+    }
+    return n
+}
+
+// Summary returns a one line summary like "2 to create, 1 to update, 0 to delete".
+func (p DNSPlan) Summary() string {
+    return fmt.Sprintf("%d to create, %d to update, %d to delete", p.count(DNSChangeCreate), p.count(DNSChangeUpdate), p.count(DNSChangeDelete))
+}
+
+func diffLine(prefix string, name string, ttl *int, recordType string, data string) string {
+    if len(name) == 0 {
+        name = "@"
+    }
+    ttlStr := "-"
+    if ttl != nil {
+        ttlStr = strconv.Itoa(*ttl)
+    }
+    return fmt.Sprintf("%s %s\t%s\t%s\t%s\n", prefix, name, ttlStr, strings.ToUpper(recordType), data)
+}
+
+// WriteDiff writes a human readable diff of the plan to w, using + for creates, - for
+// deletes and ~ for updates.
+func (p DNSPlan) WriteDiff(w io.Writer) error {
+    lines := []string{}
+    for _, c := range p.Changes {
+        switch c.Action {
+            case DNSChangeCreate:
+                lines = append(lines, diffLine("+", c.Desired.Name, c.Desired.Ttl, c.Desired.Type, c.Desired.Data))
+            case DNSChangeDelete:
+                lines = append(lines, diffLine("-", c.Current.Name, c.Current.Ttl, c.Current.Type, c.Current.Data))
+            case DNSChangeUpdate:
+                from := "-"
+                if c.Current.Ttl != nil {
+                    from = strconv.Itoa(*c.Current.Ttl)
+                }
+                lines = append(lines, diffLine("~", c.Desired.Name, c.Desired.Ttl, c.Desired.Type, c.Desired.Data+" (ttl "+from+")"))
+        }
+    }
+    sort.SliceStable(lines, func(i, j int) bool {
+        return lines[i][2:] < lines[j][2:]
+    })
+    b := &strings.Builder{}
+    fmt.Fprintf(b, "zone %s: %s\n", p.Zone, p.Summary())
+    for _, l := range lines {
+        b.WriteString(l)
+    }
+    for _, r := range p.Protected {
+        b.WriteString(diffLine("!", r.Name, r.Ttl, r.Type, r.Data+" (protected, kept)"))
+    }
+    _, err := io.WriteString(w, b.String())
+    return err
+}
+
+func (p DNSPlan) String() string {
+    b := &strings.Builder{}
+    p.WriteDiff(b)
+    return b.String()
+}
+
+// PlanDNSZoneRecords fetches the current records of the zone and plans the changes to
+// the desired records.
+func (c DomainClient) PlanDNSZoneRecords(ctx context.Context, zone string, desired []DNSRecordCreateRequest, opts DNSSyncOptions) (DNSPlan, error) {
+    current, err := c.ListAllDNSZoneRecords(ctx, zone, GetDNSZoneRecordsQueryParams{}).All()
+    if err != nil {
+        return DNSPlan{}, err
+    }
+    return PlanDNSRecords(zone, current, desired, opts), nil
+}
+
+// ApplyDNSPlan executes the changes of the plan in order and stops at the first error. The
+// returned slice contains the changes that were applied.
+func (c DomainClient) ApplyDNSPlan(ctx context.Context, plan DNSPlan) ([]DNSChange, error) {
+    applied := []DNSChange{}
+    for _, change := range plan.Changes {
+        var err error
+        switch change.Action {
+            case DNSChangeCreate:
+                _, _, err = c.CreateDNSZoneRecordWithContext(ctx, *change.Desired, plan.Zone)
+            case DNSChangeUpdate:
+                in := DNSRecordUpdateRequest{
+                    Data: change.Current.Data,
+                    Name: change.Current.Name,
+                    Type: change.Current.Type,
+                    Ttl: change.Desired.Ttl,
+                }
+                _, _, err = c.UpdateDNSRecordWithContext(ctx, in, plan.Zone, change.Current.Id)
+            case DNSChangeDelete:
+                _, _, err = c.DeleteDNSRecordWithContext(ctx, plan.Zone, change.Current.Id)
+        }
+        if err != nil {
+            return applied, fmt.Errorf("%s %s record %q: %w", change.Action, changeType(change), changeName(change), err)
+        }
+        applied = append(applied, change)
+    }
+    return applied, nil
+}
+
+func changeType(change DNSChange) string {
+    if change.Desired != nil {
+        return change.Desired.Type
+    }
+    return change.Current.Type
+}
+
+func changeName(change DNSChange) string {
+    if change.Desired != nil {
+        return change.Desired.Name
+    }
+    return change.Current.Name
+}
+
+// SyncDNSZoneRecords brings the records of the zone to the desired state. The plan is
+// returned in any case, it is only applied if opts.DryRun is not set.
+func (c DomainClient) SyncDNSZoneRecords(ctx context.Context, zone string, desired []DNSRecordCreateRequest, opts DNSSyncOptions) (DNSPlan, error) {
+    plan, err := c.PlanDNSZoneRecords(ctx, zone, desired, opts)
+    if err != nil || opts.DryRun {
+        return plan, err
+    }
+    _, err = c.ApplyDNSPlan(ctx, plan)
+    return plan, err
+}
+
+// ReplaceDNSZoneRecords validates the records, then atomically replaces all records of the
+// zone and returns the resulting records with their assigned ids.
+func (c DomainClient) ReplaceDNSZoneRecords(ctx context.Context, zone string, records []DNSRecordCreateRequest) ([]DNSRecord, error) {
+    if err := DNSRecordsUpdateRequest(records).Validate(); err != nil {
+        return nil, err
+    }
+    res, _, err := c.UpdateDNSZoneRecordsWithContext(ctx, DNSRecordsUpdateRequest(records), zone)
+    if err != nil {
+        return nil, err
+    }
+    return res.Data, nil
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+    "bytes"
+    "testing"
+)
+
+func TestDNSRecordKey(t *testing.T) {
+    tests := []struct {
+        recordType string
+        a string
+        b string
+    }{
+        {"CNAME", "mail.example.com", "Mail.Example.com."},
+        {"NS", "ns1.example.net.", "ns1.example.net"},
+        {"MX", "10 mail.example.com", "10  MAIL.example.com."},
+        {"SRV", "10 5 5060 sip.example.com", "10 5 5060 sip.example.com."},
+        {"TXT", "v=spf1 mx -all", "\"v=spf1 \" \"mx -all\""},
+        {"TXT", "\"quoted\"", "\"quoted\""},
+        {"CAA", "0 issue letsencrypt.org", "0 issue \"letsencrypt.org\""},
+        {"A", " 192.0.2.1", "192.0.2.1 "},
+    }
+    for _, tt := range tests {
+        if a, b := dnsRecordKey("www", tt.recordType, tt.a), dnsRecordKey("WWW.", tt.recordType, tt.b); a != b {
+            t.Errorf("%s: %q and %q differ: %q, %q", tt.recordType, tt.a, tt.b, a, b)
+        }
+    }
+
+    different := []struct {
+        recordType string
+        a string
+        b string
+    }{
+        {"TXT", "Case", "case"},
+        {"MX", "10 mail.example.com", "20 mail.example.com"},
+        {"A", "192.0.2.1", "192.0.2.2"},
+    }
+    for _, tt := range different {
+        if dnsRecordKey("www", tt.recordType, tt.a) == dnsRecordKey("www", tt.recordType, tt.b) {
+            t.Errorf("%s: %q and %q are equal", tt.recordType, tt.a, tt.b)
+        }
+    }
+}
+
+func TestPlanDNSRecords(t *testing.T) {
+    current := []DNSRecord{
+        {Id: "1", Name: "", Type: "NS", Data: "ns1.example.net", Ttl: intPtr(86400)},
+        {Id: "2", Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(300)},
+        {Id: "3", Name: "old", Type: "A", Data: "192.0.2.9", Ttl: intPtr(300)},
+        {Id: "4", Name: "mail", Type: "A", Data: "192.0.2.2", Ttl: intPtr(300)},
+    }
+    desired := []DNSRecordCreateRequest{
+        {Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(60)},
+        {Name: "mail", Type: "A", Data: "192.0.2.2"},
+        {Name: "new", Type: "A", Data: "192.0.2.3", Ttl: intPtr(300)},
+        {Name: "new", Type: "A", Data: "192.0.2.3", Ttl: intPtr(300)},
+    }
+
+    tests := []struct {
+        opts DNSSyncOptions
+        actions []DNSChangeAction
+        ids []string
+        protected int
+    }{
+        {DNSSyncOptions{}, []DNSChangeAction{DNSChangeDelete, DNSChangeUpdate, DNSChangeCreate}, []string{"3", "2", ""}, 1},
+        {DNSSyncOptions{DeleteProtected: true}, []DNSChangeAction{DNSChangeDelete, DNSChangeDelete, DNSChangeUpdate, DNSChangeCreate}, []string{"1", "3", "2", ""}, 0},
+    }
+    for _, tt := range tests {
+        plan := PlanDNSRecords("example.com", current, desired, tt.opts)
+        if len(plan.Changes) != len(tt.actions) || len(plan.Protected) != tt.protected {
+            t.Errorf("%+v: got plan\n%s", tt.opts, plan)
+            continue
+        }
+        for i, c := range plan.Changes {
+            id := ""
+            if c.Current != nil {
+                id = c.Current.Id
+            }
+            if c.Action != tt.actions[i] || id != tt.ids[i] {
+                t.Errorf("%+v: change %d is %s of %q, want %s of %q", tt.opts, i, c.Action, id, tt.actions[i], tt.ids[i])
+            }
+        }
+    }
+}
+
+func TestExportThenPlanIsEmpty(t *testing.T) {
+    zone := DNSZone{Name: "example.com", Ns1: "ns1.example.net", Hostmaster: "hostmaster@example.com"}
+    b := bytes.Buffer{}
+    if err := WriteZoneFile(&b, zone, apiRecords); err != nil {
+        t.Fatal(err)
+    }
+    desired, err := ParseZoneFile(&b, "example.com")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if plan := PlanDNSRecords("example.com", apiRecords, desired.Records, DNSSyncOptions{}); !plan.Empty() {
+        t.Errorf("got plan\n%s", plan)
+    }
+}
@@ -0,0 +1,339 @@
+package domain
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+type TransferDirection string
+
+const (
+    TransferIn TransferDirection = "in"
+    TransferOut TransferDirection = "out"
+)
+
+// TransferStep is the next step a transfer workflow executes. Transfer-in runs handles,
+// submit, verification and wait, transfer-out runs authinfo, wait and cleanup.
+type TransferStep string
+
+const (
+    TransferStepHandles TransferStep = "handles"
+    TransferStepSubmit TransferStep = "submit"
+    TransferStepVerification TransferStep = "verification"
+    TransferStepAuthinfo TransferStep = "authinfo"
+    TransferStepWait TransferStep = "wait"
+    TransferStepCleanup TransferStep = "cleanup"
+    TransferStepDone TransferStep = "done"
+)
+
+// TransferState is the resumable state of a transfer workflow. It can be stored as JSON
+// between runs, e.g. with SaveTransferState, and passed to RunTransfer again to continue
+// after an error or timeout.
+type TransferState struct {
+    Direction TransferDirection `json:"direction"`
+    Domain string `json:"domain"`
+    Step TransferStep `json:"step"`
+    Status DomainStatus `json:"status,omitempty"`
+    // Request is the create request submitted for transfer-in.
+    Request *DomainCreateRequest `json:"request,omitempty"`
+    // Authinfo is the code to hand to the gaining registrar for transfer-out.
+    Authinfo string `json:"authinfo,omitempty"`
+    AuthinfoValidUntil *time.Time `json:"authinfo_valid_until,omitempty"`
+    StartedAt time.Time `json:"started_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+    LastError string `json:"last_error,omitempty"`
+}
+
+// TransferError is returned by RunTransfer and names the step that failed.
+type TransferError struct {
+    Domain string
+    Step TransferStep
+    Err error
+}
+
+func (e *TransferError) Error() string {
+    return fmt.Sprintf("transfer of %s failed at step %s: %v", e.Domain, e.Step, e.Err)
+}
+
+func (e *TransferError) Unwrap() error {
+    return e.Err
+}
+
+// TransferOptions configures RunTransfer. Zero durations fall back to a timeout of 1 hour
+// for the wait step, a poll interval of 1 minute and a minimum authinfo validity of 1 day.
+type TransferOptions struct {
+    Timeout time.Duration
+    PollInterval time.Duration
+    // Handles are created for the handle codes of a transfer-in request that do not exist.
+    // The codes in the request are replaced with the codes of the created handles.
+    Handles map[string]DomainHandleCreateRequest
+    // MinAuthinfoValidity renews the authinfo of a transfer-out if it expires sooner.
+    MinAuthinfoValidity time.Duration
+    // SkipWait returns after submitting or fetching the authinfo, with the state at the wait
+    // step, instead of polling for completion.
+    SkipWait bool
+    // OnStep is called with the state after every completed step, an error aborts the
+    // workflow. It is usually used to persist the state.
+    OnStep func(state TransferState) error
+}
+
+func (o TransferOptions) withDefaults() TransferOptions {
+    if o.Timeout <= 0 {
+        o.Timeout = time.Hour
+    }
+    if o.PollInterval <= 0 {
+        o.PollInterval = time.Minute
+    }
+    if o.MinAuthinfoValidity <= 0 {
+        o.MinAuthinfoValidity = time.Hour * 24
+    }
+    return o
+}
+
+// NewTransferIn starts the state of a transfer-in of in.Name, in.Authinfo must be set to
+// the code received from the losing registrar.
+func NewTransferIn(in DomainCreateRequest) TransferState {
+    now := time.Now()
+    return TransferState{Direction: TransferIn, Domain: in.Name, Step: TransferStepHandles, Request: &in, StartedAt: now, UpdatedAt: now}
+}
+
+func NewTransferOut(name string) TransferState {
+    now := time.Now()
+    return TransferState{Direction: TransferOut, Domain: name, Step: TransferStepAuthinfo, StartedAt: now, UpdatedAt: now}
+}
+
+func LoadTransferState(path string) (TransferState, error) {
+    state := TransferState{}
+    b, err := ioutil.ReadFile(path)
+    if err != nil {
+        return state, err
+    }
+    return state, json.Unmarshal(b, &state)
+}
+
+func SaveTransferState(path string, state TransferState) error {
+    b, err := json.MarshalIndent(state, "", "  ")
+    if err != nil {
+        return err
+    }
+    return ioutil.WriteFile(path, b, 0600)
+}
+
+// RunTransfer executes the remaining steps of the transfer workflow and updates state
+// after every step. On error the state stays at the failed step, so that it can be
+// resumed by calling RunTransfer again.
+func (c DomainClient) RunTransfer(ctx context.Context, state *TransferState, opts TransferOptions) error {
+    opts = opts.withDefaults()
+    for state.Step != TransferStepDone {
+        if opts.SkipWait && state.Step == TransferStepWait {
+            return nil
+        }
+        next, err := c.transferStep(ctx, state, opts)
+        state.UpdatedAt = time.Now()
+        if err != nil {
+            state.LastError = err.Error()
+            return &TransferError{Domain: state.Domain, Step: state.Step, Err: err}
+        }
+        state.LastError = ""
+        state.Step = next
+        if opts.OnStep != nil {
+            if err := opts.OnStep(*state); err != nil {
+                return &TransferError{Domain: state.Domain, Step: state.Step, Err: err}
+            }
+        }
+    }
+    return nil
+}
+
+func (c DomainClient) transferStep(ctx context.Context, state *TransferState, opts TransferOptions) (TransferStep, error) {
+    switch state.Direction {
+        case TransferIn:
+            switch state.Step {
+                case TransferStepHandles:
+                    return TransferStepSubmit, c.ensureTransferHandles(ctx, state, opts)
+                case TransferStepSubmit:
+                    return TransferStepVerification, c.submitTransferIn(ctx, state)
+                case TransferStepVerification:
+                    return TransferStepWait, c.ensureDomainVerification(ctx, state)
+                case TransferStepWait:
+                    return TransferStepDone, c.waitForTransfer(ctx, state, opts)
+            }
+        case TransferOut:
+            switch state.Step {
+                case TransferStepAuthinfo:
+                    return TransferStepWait, c.fetchTransferAuthinfo(ctx, state, opts)
+                case TransferStepWait:
+                    return TransferStepCleanup, c.waitForTransfer(ctx, state, opts)
+                case TransferStepCleanup:
+                    return TransferStepDone, c.cleanupTransferOut(ctx, state)
+            }
+    }
+    return state.Step, fmt.Errorf("invalid step %q for direction %q", state.Step, state.Direction)
+}
+
+func (c DomainClient) ensureTransferHandles(ctx context.Context, state *TransferState, opts TransferOptions) error {
+    if state.Request == nil {
+        return fmt.Errorf("missing create request")
+    }
+    if state.Request.Authinfo == nil || len(*state.Request.Authinfo) == 0 {
+        return fmt.Errorf("missing authinfo of the losing registrar")
+    }
+    created := map[string]string{}
+    for _, code := range []*string{&state.Request.OwnerHandleCode, &state.Request.AdminHandleCode, &state.Request.TechHandleCode, &state.Request.ZoneHandleCode} {
+        if len(*code) == 0 {
+            continue
+        }
+        if newCode, ok := created[*code]; ok {
+            *code = newCode
+            continue
+        }
+        _, _, err := c.GetDomainHandleWithContext(ctx, *code)
+        if err == nil {
+            continue
+        }
+        in, ok := opts.Handles[*code]
+        if !core.IsNotFound(err) || !ok {
+            return fmt.Errorf("handle %s: %w", *code, err)
+        }
+        res, _, err := c.CreateDomainHandleWithContext(ctx, in)
+        if err != nil {
+            return fmt.Errorf("creating handle %s: %w", *code, err)
+        }
+        created[*code] = res.Data.Code
+        *code = res.Data.Code
+    }
+    return nil
+}
+
+func (c DomainClient) submitTransferIn(ctx context.Context, state *TransferState) error {
+    // A previous run may have submitted the transfer before failing, don't submit twice.
+    if res, _, err := c.GetDomainWithContext(ctx, state.Domain); err == nil {
+        state.Status = res.Data.Status
+        return nil
+    } else if !core.IsNotFound(err) {
+        return err
+    }
+    res, _, err := c.CreateDomainWithContext(ctx, *state.Request)
+    if err != nil {
+        return err
+    }
+    state.Status = res.Data.Status
+    return nil
+}
+
+func (c DomainClient) ensureDomainVerification(ctx context.Context, state *TransferState) error {
+    res, _, err := c.CheckDomainVerificationWithContext(ctx, state.Domain)
+    if err != nil {
+        return err
+    }
+    if !res.Data.Unverified {
+        return nil
+    }
+    _, _, err = c.SendDomainVerificationWithContext(ctx, state.Domain)
+    return err
+}
+
+func (c DomainClient) fetchTransferAuthinfo(ctx context.Context, state *TransferState, opts TransferOptions) error {
+    res, _, err := c.GetDomainAuthinfoWithContext(ctx, state.Domain)
+    if err != nil {
+        return err
+    }
+    validUntil, expiring := authinfoExpiring(res.Data, opts.MinAuthinfoValidity)
+    if expiring {
+        // Removing the authinfo invalidates it, the next request generates a new one.
+        if _, _, err := c.RemoveDomainAuthinfoWithContext(ctx, state.Domain); err != nil {
+            return fmt.Errorf("renewing expiring authinfo: %w", err)
+        }
+        if res, _, err = c.GetDomainAuthinfoWithContext(ctx, state.Domain); err != nil {
+            return err
+        }
+        if validUntil, expiring = authinfoExpiring(res.Data, opts.MinAuthinfoValidity); expiring {
+            return fmt.Errorf("authinfo is only valid until %s", validUntil.Format(time.RFC3339))
+        }
+    }
+    if len(res.Data.Authinfo) == 0 {
+        return fmt.Errorf("no authinfo returned")
+    }
+    state.Authinfo = res.Data.Authinfo
+    state.AuthinfoValidUntil = validUntil
+    return nil
+}
+
+func authinfoExpiring(authinfo DomainAuthinfo, minValidity time.Duration) (*time.Time, bool) {
+    if authinfo.ValidUntil == nil {
+        return nil, false
+    }
+    validUntil, err := parseDomainTime(*authinfo.ValidUntil)
+    if err != nil {
+        return nil, false
+    }
+    return &validUntil, time.Until(validUntil) < minValidity
+}
+
+// waitForTransfer polls the domain until a transfer-in is active or a transfer-out left
+// the account.
+func (c DomainClient) waitForTransfer(ctx context.Context, state *TransferState, opts TransferOptions) error {
+    ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+    defer cancel()
+
+    ticker := time.NewTicker(opts.PollInterval)
+    defer ticker.Stop()
+    for {
+        res, _, err := c.GetDomainWithContext(ctx, state.Domain)
+        switch {
+            case err != nil && state.Direction == TransferOut && core.IsNotFound(err):
+                return nil
+            case err != nil:
+                return err
+        }
+        state.Status = res.Data.Status
+        switch res.Data.Status {
+            case DomainStatusActive:
+                if state.Direction == TransferIn {
+                    return nil
+                }
+            case DomainStatusDeleted:
+                if state.Direction == TransferOut {
+                    return nil
+                }
+                return fmt.Errorf("domain was deleted")
+            case DomainStatusFailed:
+                return fmt.Errorf("domain status is %s", res.Data.Status)
+        }
+        if state.Direction == TransferOut && state.AuthinfoValidUntil != nil && time.Now().After(*state.AuthinfoValidUntil) {
+            // Fetch a new authinfo when the transfer is resumed.
+            state.Step = TransferStepAuthinfo
+            return fmt.Errorf("authinfo expired on %s before the transfer completed", state.AuthinfoValidUntil.Format(time.RFC3339))
+        }
+        select {
+            case <-ctx.Done():
+                return fmt.Errorf("domain status is still %s: %w", state.Status, ctx.Err())
+            case <-ticker.C:
+        }
+    }
+}
+
+func (c DomainClient) cleanupTransferOut(ctx context.Context, state *TransferState) error {
+    _, _, err := c.RemoveDomainAuthinfoWithContext(ctx, state.Domain)
+    if err != nil && !core.IsNotFound(err) {
+        return err
+    }
+    state.Authinfo = ""
+    state.AuthinfoValidUntil = nil
+    return nil
+}
+
+// CancelTransferOut invalidates the authinfo of a transfer-out, so that the domain can no
+// longer be transferred with it.
+func (c DomainClient) CancelTransferOut(ctx context.Context, state *TransferState) error {
+    if err := c.cleanupTransferOut(ctx, state); err != nil {
+        return &TransferError{Domain: state.Domain, Step: TransferStepCleanup, Err: err}
+    }
+    state.Step = TransferStepDone
+    state.UpdatedAt = time.Now()
+    return nil
+}
@@ -0,0 +1,246 @@
+package domain_test
+
+import (
+    "context"
+    "errors"
+    "path/filepath"
+    "reflect"
+    "testing"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+var fastTransfer = domain.TransferOptions{Timeout: time.Second, PollInterval: time.Millisecond * 10}
+
+// setDomainStatus changes the status of a domain on the fake server, like the registry
+// does while a transfer progresses.
+func setDomainStatus(t *testing.T, s *lumaservtest.Server, name string, status domain.DomainStatus) {
+    t.Helper()
+    c := core.NewClient(s.Token, s.ServiceUrl("domain"))
+    if _, err := c.Do("PUT", "/domains/"+name, nil, map[string]interface{}{"status": status}, nil); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func countRequests(s *lumaservtest.Server, method string, path string) int {
+    n := 0
+    for _, r := range s.Requests() {
+        if r.Method == method && r.Path == path {
+            n++
+        }
+    }
+    return n
+}
+
+func transferRequest() domain.DomainCreateRequest {
+    authinfo := "secret"
+    return domain.DomainCreateRequest{
+        Name: "example.com",
+        OwnerHandleCode: "OWNER-1",
+        AdminHandleCode: "new-admin",
+        TechHandleCode: "new-admin",
+        Authinfo: &authinfo,
+    }
+}
+
+func TestTransferInCreatesHandles(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/domain-handles", domain.DomainHandle{Code: "OWNER-1"})
+    c := s.DomainClient()
+
+    // Without a create request for the missing handle the first step fails.
+    state := domain.NewTransferIn(transferRequest())
+    err := c.RunTransfer(context.Background(), &state, fastTransfer)
+    transferErr := &domain.TransferError{}
+    if !errors.As(err, &transferErr) || transferErr.Step != domain.TransferStepHandles || !core.IsNotFound(err) {
+        t.Fatalf("got %v, want a missing handle", err)
+    }
+    if state.Step != domain.TransferStepHandles || len(state.LastError) == 0 {
+        t.Errorf("got state %+v", state)
+    }
+
+    opts := fastTransfer
+    opts.Handles = map[string]domain.DomainHandleCreateRequest{"new-admin": {City: "Berlin"}}
+    opts.SkipWait = true
+    if err := c.RunTransfer(context.Background(), &state, opts); err != nil {
+        t.Fatal(err)
+    }
+    code := state.Request.AdminHandleCode
+    if code == "new-admin" || len(code) == 0 || state.Request.TechHandleCode != code || state.Request.OwnerHandleCode != "OWNER-1" {
+        t.Errorf("got handle codes %+v", state.Request)
+    }
+    if n := countRequests(s, "POST", "/domain/domain-handles"); n != 1 {
+        t.Errorf("created %d handles, want 1", n)
+    }
+    domains := s.Items("/domain/domains")
+    if len(domains) != 1 || domains[0]["admin_handle_code"] != code {
+        t.Errorf("got domains %+v", domains)
+    }
+    if state.Step != domain.TransferStepWait || state.Status != domain.DomainStatusTransferPending || len(state.LastError) > 0 {
+        t.Errorf("got state %+v", state)
+    }
+
+    setDomainStatus(t, s, "example.com", domain.DomainStatusActive)
+    if err := c.RunTransfer(context.Background(), &state, fastTransfer); err != nil {
+        t.Fatal(err)
+    }
+    if state.Step != domain.TransferStepDone || state.Status != domain.DomainStatusActive {
+        t.Errorf("got state %+v", state)
+    }
+    if n := countRequests(s, "POST", "/domain/domains"); n != 1 {
+        t.Errorf("submitted %d times, want once", n)
+    }
+}
+
+func TestTransferInResumes(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/domain-handles", domain.DomainHandle{Code: "OWNER-1"}, domain.DomainHandle{Code: "new-admin"})
+    c := s.DomainClient()
+    file := filepath.Join(t.TempDir(), "transfer.json")
+
+    opts := fastTransfer
+    opts.SkipWait = true
+    opts.OnStep = func(state domain.TransferState) error {
+        return domain.SaveTransferState(file, state)
+    }
+    s.InjectFault(lumaservtest.Fault{Method: "POST", Path: "/domain/domains", StatusCode: 500, Count: 1})
+    state := domain.NewTransferIn(transferRequest())
+    err := c.RunTransfer(context.Background(), &state, opts)
+    transferErr := &domain.TransferError{}
+    if !errors.As(err, &transferErr) || transferErr.Step != domain.TransferStepSubmit {
+        t.Fatalf("got %v, want a failed submit", err)
+    }
+
+    saved, err := domain.LoadTransferState(file)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if saved.Step != domain.TransferStepSubmit || saved.Domain != "example.com" || saved.Request == nil {
+        t.Fatalf("got saved state %+v", saved)
+    }
+    if err := c.RunTransfer(context.Background(), &saved, opts); err != nil {
+        t.Fatal(err)
+    }
+    if saved.Step != domain.TransferStepWait || countRequests(s, "GET", "/domain/domain-handles/OWNER-1") != 1 {
+        t.Errorf("got state %+v, want the handles step not to run again", saved)
+    }
+
+    // A transfer submitted by a run that failed afterwards is not submitted again.
+    saved.Step = domain.TransferStepSubmit
+    if err := c.RunTransfer(context.Background(), &saved, opts); err != nil {
+        t.Fatal(err)
+    }
+    if n := len(s.Items("/domain/domains")); n != 1 {
+        t.Errorf("got %d domains, want 1", n)
+    }
+}
+
+func TestTransferOutRenewsAuthinfo(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    expiring := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+    valid := time.Now().UTC().Add(time.Hour * 24 * 10).Format(time.RFC3339)
+    s.Seed("/domain/domains",
+        map[string]interface{}{"name": "example.com", "status": "ACTIVE", "authinfo": map[string]interface{}{"authinfo": "old", "valid_until": expiring}},
+        map[string]interface{}{"name": "example.org", "status": "ACTIVE", "authinfo": map[string]interface{}{"authinfo": "kept", "valid_until": valid}},
+    )
+    c := s.DomainClient()
+    opts := fastTransfer
+    opts.SkipWait = true
+
+    state := domain.NewTransferOut("example.com")
+    if err := c.RunTransfer(context.Background(), &state, opts); err != nil {
+        t.Fatal(err)
+    }
+    if state.Authinfo != "fake-authinfo-example.com" || state.AuthinfoValidUntil == nil || time.Until(*state.AuthinfoValidUntil) < time.Hour*24*29 {
+        t.Errorf("authinfo not renewed: %+v", state)
+    }
+    if n := countRequests(s, "DELETE", "/domain/domains/example.com/authinfo"); n != 1 {
+        t.Errorf("got %d authinfo removals, want 1", n)
+    }
+
+    other := domain.NewTransferOut("example.org")
+    if err := c.RunTransfer(context.Background(), &other, opts); err != nil {
+        t.Fatal(err)
+    }
+    if other.Authinfo != "kept" || countRequests(s, "DELETE", "/domain/domains/example.org/authinfo") != 0 {
+        t.Errorf("renewed a valid authinfo: %+v", other)
+    }
+
+    // The transfer completes once the domain left the account, the cleanup tolerates that.
+    if _, _, err := c.DeleteDomain("example.com"); err != nil {
+        t.Fatal(err)
+    }
+    if err := c.RunTransfer(context.Background(), &state, fastTransfer); err != nil {
+        t.Fatal(err)
+    }
+    if state.Step != domain.TransferStepDone || len(state.Authinfo) > 0 || state.AuthinfoValidUntil != nil {
+        t.Errorf("got state %+v", state)
+    }
+}
+
+func TestTransferOutExpiredAuthinfo(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/domain/domains", domain.Domain{Name: "example.com", Status: domain.DomainStatusTransferOut})
+    c := s.DomainClient()
+
+    expired := time.Now().Add(-time.Minute)
+    state := domain.NewTransferOut("example.com")
+    state.Step = domain.TransferStepWait
+    state.Authinfo = "old"
+    state.AuthinfoValidUntil = &expired
+    err := c.RunTransfer(context.Background(), &state, fastTransfer)
+    transferErr := &domain.TransferError{}
+    if !errors.As(err, &transferErr) || transferErr.Step != domain.TransferStepAuthinfo {
+        t.Fatalf("got %v, want an expired authinfo", err)
+    }
+    if state.Step != domain.TransferStepAuthinfo {
+        t.Errorf("got step %s, want the authinfo step to run again", state.Step)
+    }
+
+    opts := fastTransfer
+    opts.SkipWait = true
+    if err := c.RunTransfer(context.Background(), &state, opts); err != nil {
+        t.Fatal(err)
+    }
+    if state.Step != domain.TransferStepWait || state.Authinfo != "fake-authinfo-example.com" || !state.AuthinfoValidUntil.After(time.Now()) {
+        t.Errorf("got state %+v", state)
+    }
+}
+
+func TestTransferStateFile(t *testing.T) {
+    started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+    validUntil := started.Add(time.Hour * 24 * 30)
+    authinfo := "secret"
+    state := domain.TransferState{
+        Direction: domain.TransferIn,
+        Domain: "example.com",
+        Step: domain.TransferStepVerification,
+        Status: domain.DomainStatusTransferPending,
+        Request: &domain.DomainCreateRequest{Name: "example.com", OwnerHandleCode: "OWNER-1", Authinfo: &authinfo},
+        AuthinfoValidUntil: &validUntil,
+        StartedAt: started,
+        UpdatedAt: started.Add(time.Minute),
+        LastError: "boom",
+    }
+    file := filepath.Join(t.TempDir(), "transfer.json")
+    if err := domain.SaveTransferState(file, state); err != nil {
+        t.Fatal(err)
+    }
+    loaded, err := domain.LoadTransferState(file)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !reflect.DeepEqual(loaded, state) {
+        t.Errorf("got %+v, want %+v", loaded, state)
+    }
+
+    if _, err := domain.LoadTransferState(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+        t.Errorf("loaded a missing file")
+    }
+}
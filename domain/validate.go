@@ -0,0 +1,352 @@
+package domain
+
+import (
+    "encoding/hex"
+    "fmt"
+    "net"
+    "strconv"
+    "strings"
+)
+
+// TTL bounds enforced by ValidateDNSRecord, as defined by RFC 2181. The API may reject
+// lower TTLs depending on the zone, which the client can not know in advance.
+const (
+    MinDNSRecordTtl = 0
+    MaxDNSRecordTtl = 2147483647
+)
+
+// DNSValidationError is returned for DNS records that are rejected before being sent to
+// the API.
+type DNSValidationError struct {
+    Name string
+    Type string
+    Field string
+    Msg string
+}
+
+func (e *DNSValidationError) Error() string {
+    name := e.Name
+    if len(name) == 0 {
+        name = "@"
+    }
+    return fmt.Sprintf("invalid %s record %q: %s: %s", e.Type, name, e.Field, e.Msg)
+}
+
+// dataValidators check the data of the record types known to the client. Records of
+// other types only get the generic checks.
+var dataValidators = map[string]func(name string, fields []string, data string) error{
+    DNSRecordTypeA: validateA,
+    DNSRecordTypeAAAA: validateAAAA,
+    DNSRecordTypeCNAME: validateTarget,
+    DNSRecordTypeNS: validateTarget,
+    DNSRecordTypePTR: validateTarget,
+    DNSRecordTypeMX: validateMX,
+    DNSRecordTypeTXT: validateTXT,
+    DNSRecordTypeSRV: validateSRV,
+    DNSRecordTypeCAA: validateCAA,
+    DNSRecordTypeTLSA: validateTLSA,
+}
+
+// ValidateDNSRecord checks the name, TTL and the data syntax of a record for its type.
+func ValidateDNSRecord(r DNSRecordCreateRequest) error {
+    fail := func(field string, format string, args ...interface{}) error {
+        return &DNSValidationError{Name: r.Name, Type: r.Type, Field: field, Msg: fmt.Sprintf(format, args...)}
+    }
+    if len(r.Type) == 0 {
+        return fail("type", "must not be empty")
+    }
+    for _, c := range r.Type {
+        if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9') {
+            return fail("type", "invalid character %q", c)
+        }
+    }
+    if err := validateRecordName(r.Name); err != nil {
+        return fail("name", "%v", err)
+    }
+    if len(strings.TrimSpace(r.Data)) == 0 {
+        return fail("data", "must not be empty")
+    }
+    if r.Ttl != nil && (*r.Ttl < MinDNSRecordTtl || *r.Ttl > MaxDNSRecordTtl) {
+        return fail("ttl", "must be between %d and %d", MinDNSRecordTtl, MaxDNSRecordTtl)
+    }
+
+    recordType := strings.ToUpper(r.Type)
+    if recordType == DNSRecordTypeCNAME && isApex(r.Name) {
+        return fail("name", "CNAME records are not allowed at the zone apex")
+    }
+    if validate, ok := dataValidators[recordType]; ok {
+        if err := validate(r.Name, strings.Fields(r.Data), r.Data); err != nil {
+            return fail("data", "%v", err)
+        }
+    }
+    return nil
+}
+
+// Validate checks the record like ValidateDNSRecord.
+func (r DNSRecordCreateRequest) Validate() error {
+    return ValidateDNSRecord(r)
+}
+
+// Validate checks the record like ValidateDNSRecord.
+func (r DNSRecordUpdateRequest) Validate() error {
+    return ValidateDNSRecord(DNSRecordCreateRequest(r))
+}
+
+// Validate checks all records of a bulk update and rejects duplicates and conflicting
+// CNAME records.
+func (in DNSRecordsUpdateRequest) Validate() error {
+    seen := map[string]bool{}
+    records := []DNSRecord{}
+    for i, r := range in {
+        if err := ValidateDNSRecord(r); err != nil {
+            return fmt.Errorf("record %d: %w", i, err)
+        }
+        key := dnsRecordKey(r.Name, r.Type, r.Data)
+        if seen[key] {
+            return fmt.Errorf("record %d: %w", i, &DNSValidationError{Name: r.Name, Type: r.Type, Field: "data", Msg: "duplicate record"})
+        }
+        seen[key] = true
+        if err := CheckDNSRecordConflicts(r, records); err != nil {
+            return fmt.Errorf("record %d: %w", i, err)
+        }
+        records = append(records, DNSRecord{Name: r.Name, Type: r.Type, Data: r.Data, Ttl: r.Ttl})
+    }
+    return nil
+}
+
+// CheckDNSRecordConflicts checks a new record against the existing records of the zone.
+// A CNAME record can not coexist with any other record of the same name.
+func CheckDNSRecordConflicts(r DNSRecordCreateRequest, existing []DNSRecord) error {
+    isCNAME := strings.EqualFold(r.Type, DNSRecordTypeCNAME)
+    for _, e := range existing {
+        if !sameRecordName(e.Name, r.Name) {
+            continue
+        }
+        if isCNAME && !(strings.EqualFold(e.Type, DNSRecordTypeCNAME) && e.Data == r.Data) {
+            return &DNSValidationError{Name: r.Name, Type: r.Type, Field: "name", Msg: fmt.Sprintf("conflicts with existing %s record", strings.ToUpper(e.Type))}
+        }
+        if !isCNAME && strings.EqualFold(e.Type, DNSRecordTypeCNAME) {
+            return &DNSValidationError{Name: r.Name, Type: r.Type, Field: "name", Msg: "conflicts with existing CNAME record"}
+        }
+    }
+    return nil
+}
+
+func isApex(name string) bool {
+    return len(name) == 0 || name == "@"
+}
+
+func sameRecordName(a string, b string) bool {
+    if isApex(a) || isApex(b) {
+        return isApex(a) && isApex(b)
+    }
+    return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+func validateRecordName(name string) error {
+    if isApex(name) {
+        return nil
+    }
+    if strings.HasSuffix(name, ".") {
+        return fmt.Errorf("must be relative to the zone")
+    }
+    if len(name) > 253 {
+        return fmt.Errorf("longer than 253 characters")
+    }
+    for _, label := range strings.Split(name, ".") {
+        if len(label) == 0 || len(label) > 63 {
+            return fmt.Errorf("label %q must have 1 to 63 characters", label)
+        }
+        for _, c := range label {
+            if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '*') {
+                return fmt.Errorf("invalid character %q in label %q", c, label)
+            }
+        }
+    }
+    return nil
+}
+
+func validateHostname(host string) error {
+    if host == "." {
+        return nil
+    }
+    name := strings.TrimSuffix(host, ".")
+    if len(name) == 0 || len(name) > 253 {
+        return fmt.Errorf("invalid hostname %q", host)
+    }
+    for _, label := range strings.Split(name, ".") {
+        if len(label) == 0 || len(label) > 63 {
+            return fmt.Errorf("invalid hostname %q", host)
+        }
+        for _, c := range label {
+            if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+                return fmt.Errorf("invalid hostname %q", host)
+            }
+        }
+    }
+    return nil
+}
+
+func parseUint(value string, max int, field string) (int, error) {
+    v, err := strconv.Atoi(value)
+    if err != nil || v < 0 || v > max {
+        return 0, fmt.Errorf("%s must be a number between 0 and %d", field, max)
+    }
+    return v, nil
+}
+
+func expectFields(fields []string, n int, format string) error {
+    if len(fields) != n {
+        return fmt.Errorf("expected %q", format)
+    }
+    return nil
+}
+
+func validateA(name string, fields []string, data string) error {
+    ip := net.ParseIP(strings.TrimSpace(data))
+    if ip == nil || ip.To4() == nil || strings.Contains(data, ":") {
+        return fmt.Errorf("%q is not an IPv4 address", data)
+    }
+    return nil
+}
+
+func validateAAAA(name string, fields []string, data string) error {
+    ip := net.ParseIP(strings.TrimSpace(data))
+    if ip == nil || !strings.Contains(data, ":") {
+        return fmt.Errorf("%q is not an IPv6 address", data)
+    }
+    return nil
+}
+
+func validateTarget(name string, fields []string, data string) error {
+    if err := expectFields(fields, 1, "<hostname>"); err != nil {
+        return err
+    }
+    return validateHostname(fields[0])
+}
+
+func validateMX(name string, fields []string, data string) error {
+    if err := expectFields(fields, 2, "<priority> <hostname>"); err != nil {
+        return err
+    }
+    if _, err := parseUint(fields[0], 65535, "priority"); err != nil {
+        return err
+    }
+    return validateHostname(fields[1])
+}
+
+// validateTXT accepts plain text, which is split into strings of 255 bytes as needed, or
+// a list of quoted strings of at most 255 bytes each.
+func validateTXT(name string, fields []string, data string) error {
+    chunks := SplitTXT(data)
+    if strings.HasPrefix(strings.TrimSpace(data), "\"") {
+        var err error
+        if chunks, err = parseQuotedStrings(strings.TrimSpace(data)); err != nil {
+            return err
+        }
+        for _, chunk := range chunks {
+            if len(chunk) > 255 {
+                return fmt.Errorf("quoted string longer than 255 bytes")
+            }
+        }
+    }
+    size := 0
+    for _, chunk := range chunks {
+        size += len(chunk) + 1
+    }
+    if size > 65535 {
+        return fmt.Errorf("longer than 65535 bytes")
+    }
+    return nil
+}
+
+func parseQuotedStrings(data string) ([]string, error) {
+    chunks := []string{}
+    for len(data) > 0 {
+        if data[0] != '"' {
+            return nil, fmt.Errorf("expected quoted string at %q", data)
+        }
+        s, n, err := readQuoted(data)
+        if err != nil {
+            return nil, err
+        }
+        chunks = append(chunks, s)
+        data = strings.TrimLeft(data[n:], " \t")
+    }
+    return chunks, nil
+}
+
+func validateSRV(name string, fields []string, data string) error {
+    labels := strings.Split(name, ".")
+    if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+        return fmt.Errorf("name must start with _service._proto")
+    }
+    if err := expectFields(fields, 4, "<priority> <weight> <port> <target>"); err != nil {
+        return err
+    }
+    for i, field := range []string{"priority", "weight", "port"} {
+        if _, err := parseUint(fields[i], 65535, field); err != nil {
+            return err
+        }
+    }
+    return validateHostname(fields[3])
+}
+
+func validateCAA(name string, fields []string, data string) error {
+    if len(fields) < 3 {
+        return fmt.Errorf("expected %q", "<flags> <tag> <value>")
+    }
+    if _, err := parseUint(fields[0], 255, "flags"); err != nil {
+        return err
+    }
+    tag := fields[1]
+    for _, c := range tag {
+        if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+            return fmt.Errorf("invalid tag %q", tag)
+        }
+    }
+    value := skipFields(data, 2)
+    if strings.HasPrefix(value, "\"") {
+        if _, n, err := readQuoted(value); err != nil || n != len(value) {
+            return fmt.Errorf("invalid quoted value %s", value)
+        }
+    }
+    return nil
+}
+
+// skipFields returns data without its first n whitespace separated fields.
+func skipFields(data string, n int) string {
+    data = strings.TrimLeft(data, " \t")
+    for i := 0; i < n; i++ {
+        if end := strings.IndexAny(data, " \t"); end >= 0 {
+            data = strings.TrimLeft(data[end:], " \t")
+        } else {
+            data = ""
+        }
+    }
+    return strings.TrimSpace(data)
+}
+
+func validateTLSA(name string, fields []string, data string) error {
+    if err := expectFields(fields, 4, "<usage> <selector> <matching type> <hex data>"); err != nil {
+        return err
+    }
+    if _, err := parseUint(fields[0], 3, "usage"); err != nil {
+        return err
+    }
+    if _, err := parseUint(fields[1], 1, "selector"); err != nil {
+        return err
+    }
+    matchingType, err := parseUint(fields[2], 2, "matching type")
+    if err != nil {
+        return err
+    }
+    b, err := hex.DecodeString(fields[3])
+    if err != nil {
+        return fmt.Errorf("certificate data must be hex encoded")
+    }
+    if matchingType == 1 && len(b) != 32 || matchingType == 2 && len(b) != 64 {
+        return fmt.Errorf("certificate data has the wrong length for matching type %d", matchingType)
+    }
+    return nil
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+    "errors"
+    "strings"
+    "testing"
+)
+
+func TestValidateDNSRecord(t *testing.T) {
+    tests := []struct {
+        record DNSRecordCreateRequest
+        field string
+    }{
+        {aRecord("www", "192.0.2.1"), ""},
+        {aRecord("www", "2001:db8::1"), "data"},
+        {DNSRecordCreateRequest{Name: "www", Type: "AAAA", Data: "2001:db8::1"}, ""},
+        {DNSRecordCreateRequest{Name: "www", Type: "AAAA", Data: "192.0.2.1"}, "data"},
+        {DNSRecordCreateRequest{Name: "www.", Type: "A", Data: "192.0.2.1"}, "name"},
+        {DNSRecordCreateRequest{Name: "www", Type: "A", Data: " "}, "data"},
+        {DNSRecordCreateRequest{Name: "www", Type: "", Data: "192.0.2.1"}, "type"},
+        {DNSRecordCreateRequest{Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(0)}, ""},
+        {DNSRecordCreateRequest{Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(-1)}, "ttl"},
+        {NewCNAMERecord("", "example.net."), "name"},
+        {NewCNAMERecord("www", "example.net."), ""},
+        {NewMXRecord("", 10, "mail.example.com."), ""},
+        {DNSRecordCreateRequest{Name: "", Type: "MX", Data: "mail.example.com."}, "data"},
+        {DNSRecordCreateRequest{Name: "", Type: "MX", Data: "70000 mail.example.com."}, "data"},
+        {NewTXTRecord("", strings.Repeat("a", 300)), ""},
+        {DNSRecordCreateRequest{Name: "", Type: "TXT", Data: `"` + strings.Repeat("a", 300) + `"`}, "data"},
+        {DNSRecordCreateRequest{Name: "", Type: "TXT", Data: `"v=spf1" "-all"`}, ""},
+        {NewSRVRecord("_sip._tcp", 10, 5, 5060, "sip.example.com."), ""},
+        {NewSRVRecord("sip", 10, 5, 5060, "sip.example.com."), "data"},
+        {NewCAARecord("", 0, "issue", "letsencrypt.org"), ""},
+        {DNSRecordCreateRequest{Name: "", Type: "CAA", Data: `0 issue "letsencrypt.org`}, "data"},
+        {DNSRecordCreateRequest{Name: "", Type: "CAA", Data: `0 iss-ue "letsencrypt.org"`}, "data"},
+        {DNSRecordCreateRequest{Name: "", Type: "CAA", Data: `0 issue`}, "data"},
+        {NewTLSARecord("_443._tcp", 3, 1, 1, strings.Repeat("ab", 32)), ""},
+        {NewTLSARecord("_443._tcp", 3, 1, 1, "abcd"), "data"},
+        {DNSRecordCreateRequest{Name: "", Type: "HINFO", Data: "PC Linux"}, ""},
+    }
+    for _, tt := range tests {
+        err := ValidateDNSRecord(tt.record)
+        if len(tt.field) == 0 {
+            if err != nil {
+                t.Errorf("%s %q: unexpected error %v", tt.record.Type, tt.record.Data, err)
+            }
+            continue
+        }
+        validationErr := &DNSValidationError{}
+        if !errors.As(err, &validationErr) || validationErr.Field != tt.field {
+            t.Errorf("%s %q: got %v, want an error for %s", tt.record.Type, tt.record.Data, err, tt.field)
+        }
+    }
+}
+
+// TestValidateCAATagInFlags checks that the value of a CAA record is found by position even
+// if the tag also appears in the flags field.
+func TestValidateCAATagInFlags(t *testing.T) {
+    tests := []struct {
+        data string
+        valid bool
+    }{
+        {`1 1 "x"`, true},
+        {`128 1 "unterminated`, false},
+        {`12 2 "a" b`, false},
+        {"0\tissue\t\"ca.example.net\"", true},
+    }
+    for _, tt := range tests {
+        err := validateCAA("", strings.Fields(tt.data), tt.data)
+        if (err == nil) != tt.valid {
+            t.Errorf("%q: got %v, want valid %v", tt.data, err, tt.valid)
+        }
+    }
+}
+
+func TestValidateDNSRecordsUpdateRequest(t *testing.T) {
+    tests := []struct {
+        records DNSRecordsUpdateRequest
+        valid bool
+    }{
+        {DNSRecordsUpdateRequest{NewCNAMERecord("www", "example.net."), aRecord("mail", "192.0.2.1")}, true},
+        {DNSRecordsUpdateRequest{aRecord("www", "192.0.2.1"), aRecord("WWW", "192.0.2.1")}, false},
+        {DNSRecordsUpdateRequest{aRecord("www", "192.0.2.1"), NewCNAMERecord("www", "example.net.")}, false},
+        {DNSRecordsUpdateRequest{NewCNAMERecord("", "example.net.")}, false},
+    }
+    for i, tt := range tests {
+        if err := tt.records.Validate(); (err == nil) != tt.valid {
+            t.Errorf("%d: got %v, want valid %v", i, err, tt.valid)
+        }
+    }
+}
+
+func aRecord(name string, ip string) DNSRecordCreateRequest {
+    return DNSRecordCreateRequest{Name: name, Type: DNSRecordTypeA, Data: ip}
+}
@@ -0,0 +1,505 @@
+package domain
+
+import (
+    "bufio"
+    "context"
+    "fmt"
+    "io"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+    "unicode"
+)
+
+// ZoneFileError is returned by ParseZoneFile for malformed input.
+type ZoneFileError struct {
+    Line int
+    Msg string
+}
+
+func (e *ZoneFileError) Error() string {
+    return fmt.Sprintf("zone file line %d: %s", e.Line, e.Msg)
+}
+
+// zoneRecordTypes are the record types accepted in zone files. SOA records are parsed but
+// not returned, as the SOA of a zone is managed by the API. Records of other types are
+// reported in ZoneFile.Skipped.
+var zoneRecordTypes = map[string]bool{
+    "A": true, "AAAA": true, "CAA": true, "CNAME": true, "DNAME": true, "DS": true, "MX": true,
+    "NS": true, "PTR": true, "SOA": true, "SRV": true, "SSHFP": true, "TLSA": true, "TXT": true,
+}
+
+// zoneNameFields lists the positions of domain names in the data of a record type, which
+// are qualified with the origin when relative.
+var zoneNameFields = map[string][]int{
+    "CNAME": {0},
+    "DNAME": {0},
+    "NS": {0},
+    "PTR": {0},
+    "MX": {1},
+    "SRV": {3},
+    "SOA": {0, 1},
+}
+
+// zoneClasses are the classes allowed before or after the TTL of a record.
+var zoneClasses = map[string]bool{
+    "IN": true, "CH": true, "CS": true, "HS": true,
+}
+
+// defaultZoneTtl is written as $TTL by WriteZoneFile.
+const defaultZoneTtl = 3600
+
+// ZoneFile is the result of ParseZoneFile.
+type ZoneFile struct {
+    Records []DNSRecordCreateRequest
+    // Skipped lists the records of types not supported by the API, which are not part of
+    // Records.
+    Skipped []*ZoneFileError
+}
+
+type zoneToken struct {
+    text string
+    quoted bool
+}
+
+type zoneLine struct {
+    number int
+    indented bool
+    tokens []zoneToken
+}
+
+// ParseZoneFile parses an RFC 1035 master file for the given zone into record create
+// requests. $ORIGIN, $TTL, relative owner names and multi-string TXT records are
+// supported. Record names are returned relative to the zone, with the apex as an empty
+// name, and domain names in record data are fully qualified. A record without TTL gets
+// the $TTL in effect or, before any $TTL, the TTL of the previous record (RFC 2308 and
+// RFC 1035). Records of unsupported types are skipped and reported.
+func ParseZoneFile(r io.Reader, zone string) (ZoneFile, error) {
+    zone = canonicalName(zone)
+    result := ZoneFile{Records: []DNSRecordCreateRequest{}, Skipped: []*ZoneFileError{}}
+    lines, err := readZoneLines(r)
+    if err != nil {
+        return result, err
+    }
+
+    origin := zone
+    var defaultTtl *int
+    var lastTtl *int
+    lastOwner := ""
+    for _, line := range lines {
+        tokens := line.tokens
+        fail := func(format string, args ...interface{}) error {
+            return &ZoneFileError{Line: line.number, Msg: fmt.Sprintf(format, args...)}
+        }
+
+        if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+            switch strings.ToUpper(tokens[0].text) {
+                case "$ORIGIN":
+                    if len(tokens) < 2 {
+                        return result, fail("$ORIGIN without a name")
+                    }
+                    origin = qualifyName(tokens[1].text, origin)
+                case "$TTL":
+                    if len(tokens) < 2 {
+                        return result, fail("$TTL without a value")
+                    }
+                    ttl, err := parseTtl(tokens[1].text)
+                    if err != nil {
+                        return result, fail("invalid $TTL: %v", err)
+                    }
+                    defaultTtl = &ttl
+                default:
+                    return result, fail("unsupported directive %s", tokens[0].text)
+            }
+            continue
+        }
+
+        owner := lastOwner
+        if !line.indented {
+            owner = qualifyName(tokens[0].text, origin)
+            tokens = tokens[1:]
+        } else if len(owner) == 0 {
+            return result, fail("record without owner name")
+        }
+        lastOwner = owner
+
+        var ttl *int
+        if defaultTtl != nil {
+            ttl = defaultTtl
+        } else if lastTtl != nil {
+            ttl = lastTtl
+        }
+        for len(tokens) > 0 && !zoneRecordTypes[strings.ToUpper(tokens[0].text)] {
+            t := tokens[0].text
+            if zoneClasses[strings.ToUpper(t)] {
+                tokens = tokens[1:]
+                continue
+            }
+            v, err := parseTtl(t)
+            if err != nil {
+                break
+            }
+            ttl = &v
+            tokens = tokens[1:]
+        }
+        if len(tokens) == 0 {
+            return result, fail("missing record type")
+        }
+        lastTtl = ttl
+        recordType := strings.ToUpper(tokens[0].text)
+        data := tokens[1:]
+        if !zoneRecordTypes[recordType] {
+            result.Skipped = append(result.Skipped, &ZoneFileError{Line: line.number, Msg: "unsupported record type " + recordType})
+            continue
+        }
+        if len(data) == 0 {
+            return result, fail("missing data for %s record", recordType)
+        }
+        if recordType == "SOA" {
+            continue
+        }
+
+        name, ok := relativeName(owner, zone)
+        if !ok {
+            return result, fail("owner %s is outside of zone %s", owner, zone)
+        }
+
+        if ttl != nil {
+            v := *ttl
+            ttl = &v
+        }
+        record := DNSRecordCreateRequest{
+            Name: name,
+            Type: recordType,
+            Ttl: ttl,
+        }
+        if recordType == "TXT" {
+            parts := make([]string, len(data))
+            for i, t := range data {
+                parts[i] = t.text
+            }
+            record.Data = strings.Join(parts, "")
+        } else {
+            fields := make([]string, len(data))
+            for i, t := range data {
+                fields[i] = t.text
+            }
+            for _, i := range zoneNameFields[recordType] {
+                if i < len(fields) {
+                    fields[i] = qualifyName(fields[i], origin)
+                }
+            }
+            if recordType == "CAA" && len(data) == 3 {
+                fields[2] = strconv.Quote(data[2].text)
+            }
+            record.Data = strings.Join(fields, " ")
+        }
+        result.Records = append(result.Records, record)
+    }
+    return result, nil
+}
+
+// readZoneLines splits the input into logical lines, joining parenthesized continuations
+// and removing comments.
+func readZoneLines(r io.Reader) ([]zoneLine, error) {
+    scanner := bufio.NewScanner(r)
+    scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+    lines := []zoneLine{}
+    var current *zoneLine
+    depth := 0
+    number := 0
+    for scanner.Scan() {
+        number++
+        text := scanner.Text()
+        if depth == 0 {
+            current = &zoneLine{number: number, indented: len(text) > 0 && (text[0] == ' ' || text[0] == '\t')}
+        }
+
+        for i := 0; i < len(text); {
+            c := text[i]
+            switch {
+                case c == ';':
+                    i = len(text)
+                case c == '(':
+                    depth++
+                    i++
+                case c == ')':
+                    if depth == 0 {
+                        return nil, &ZoneFileError{Line: number, Msg: "unbalanced parenthesis"}
+                    }
+                    depth--
+                    i++
+                case c == '"':
+                    s, n, err := readQuoted(text[i:])
+                    if err != nil {
+                        return nil, &ZoneFileError{Line: number, Msg: err.Error()}
+                    }
+                    current.tokens = append(current.tokens, zoneToken{text: s, quoted: true})
+                    i += n
+                case unicode.IsSpace(rune(c)):
+                    i++
+                default:
+                    start := i
+                    for i < len(text) && !unicode.IsSpace(rune(text[i])) && !strings.ContainsRune(";()\"", rune(text[i])) {
+                        if text[i] == '\\' {
+                            i++
+                        }
+                        i++
+                    }
+                    if i > len(text) {
+                        i = len(text)
+                    }
+                    current.tokens = append(current.tokens, zoneToken{text: text[start:i]})
+            }
+        }
+
+        if depth == 0 && len(current.tokens) > 0 {
+            lines = append(lines, *current)
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    if depth != 0 {
+        return nil, &ZoneFileError{Line: number, Msg: "unbalanced parenthesis"}
+    }
+    return lines, nil
+}
+
+// readQuoted reads a quoted string at the start of s, resolving \X and \DDD escapes, and
+// returns it along with the number of bytes consumed.
+func readQuoted(s string) (string, int, error) {
+    b := strings.Builder{}
+    for i := 1; i < len(s); i++ {
+        switch s[i] {
+            case '"':
+                return b.String(), i + 1, nil
+            case '\\':
+                if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+                    v, _ := strconv.Atoi(s[i+1 : i+4])
+                    if v > 255 {
+                        return "", 0, fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+                    }
+                    b.WriteByte(byte(v))
+                    i += 3
+                } else if i+1 < len(s) {
+                    b.WriteByte(s[i+1])
+                    i++
+                }
+            default:
+                b.WriteByte(s[i])
+        }
+    }
+    return "", 0, fmt.Errorf("unterminated string")
+}
+
+func isDigits(s string) bool {
+    for _, c := range s {
+        if c < '0' || c > '9' {
+            return false
+        }
+    }
+    return true
+}
+
+// parseTtl parses a TTL in seconds or with BIND style unit suffixes like 1h30m.
+func parseTtl(s string) (int, error) {
+    if v, err := strconv.Atoi(s); err == nil {
+        if v < 0 {
+            return 0, fmt.Errorf("negative ttl %s", s)
+        }
+        return v, nil
+    }
+    units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+    total := 0
+    num := ""
+    for i := 0; i < len(s); i++ {
+        c := s[i]
+        if c >= '0' && c <= '9' {
+            num += string(c)
+            continue
+        }
+        unit, ok := units[byte(unicode.ToLower(rune(c)))]
+        if !ok || len(num) == 0 {
+            return 0, fmt.Errorf("invalid ttl %s", s)
+        }
+        v, _ := strconv.Atoi(num)
+        total += v * unit
+        num = ""
+    }
+    if len(num) > 0 {
+        return 0, fmt.Errorf("invalid ttl %s", s)
+    }
+    return total, nil
+}
+
+func canonicalName(name string) string {
+    return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// qualifyName makes a possibly relative name absolute, including the trailing dot.
+func qualifyName(name string, origin string) string {
+    if name == "@" {
+        return origin
+    }
+    if strings.HasSuffix(name, ".") {
+        return name
+    }
+    return name + "." + origin
+}
+
+// relativeName returns the name of an absolute owner relative to the zone.
+func relativeName(owner string, zone string) (string, bool) {
+    owner = strings.ToLower(owner)
+    if owner == zone {
+        return "", true
+    }
+    if strings.HasSuffix(owner, "."+zone) {
+        return strings.TrimSuffix(owner, "."+zone), true
+    }
+    return "", false
+}
+
+// WriteZoneFile renders the zone and its records as an RFC 1035 master file. The output
+// is sorted, so that it can be kept under version control. The SOA record of the zone is
+// written first. If records does not contain it, one is generated from the zone with the
+// current date as serial. Domain names in record data are written fully qualified.
+func WriteZoneFile(w io.Writer, zone DNSZone, records []DNSRecord) error {
+    origin := canonicalName(zone.Name)
+    hostmaster := strings.Replace(strings.TrimSuffix(zone.Hostmaster, "."), "@", ".", 1)
+
+    b := &strings.Builder{}
+    fmt.Fprintf(b, "$ORIGIN %s\n", origin)
+    fmt.Fprintf(b, "$TTL %d\n", defaultZoneTtl)
+    hasSoa := false
+    for _, r := range records {
+        if strings.EqualFold(r.Type, "SOA") {
+            hasSoa = true
+        }
+    }
+    if !hasSoa && len(zone.Ns1) > 0 && len(hostmaster) > 0 {
+        serial := time.Now().UTC().Format("20060102") + "00"
+        fmt.Fprintf(b, "@\tIN\tSOA\t%s %s. (%s 86400 7200 3600000 3600)\n", strings.TrimSuffix(zone.Ns1, ".")+".", hostmaster, serial)
+    }
+
+    sorted := append([]DNSRecord{}, records...)
+    sort.SliceStable(sorted, func(i, j int) bool {
+        a, b := sorted[i], sorted[j]
+        if soaA, soaB := strings.EqualFold(a.Type, "SOA"), strings.EqualFold(b.Type, "SOA"); soaA != soaB {
+            return soaA
+        }
+        if a.Name != b.Name {
+            if a.Name == "" || a.Name == "@" {
+                return true
+            }
+            if b.Name == "" || b.Name == "@" {
+                return false
+            }
+            return a.Name < b.Name
+        }
+        if a.Type != b.Type {
+            return a.Type < b.Type
+        }
+        return a.Data < b.Data
+    })
+
+    for _, r := range sorted {
+        name := r.Name
+        if len(name) == 0 {
+            name = "@"
+        }
+        ttl := ""
+        if r.Ttl != nil {
+            ttl = strconv.Itoa(*r.Ttl)
+        }
+        fmt.Fprintf(b, "%s\t%s\tIN\t%s\t%s\n", name, ttl, strings.ToUpper(r.Type), zoneData(r.Type, r.Data))
+    }
+
+    _, err := io.WriteString(w, b.String())
+    return err
+}
+
+// zoneData formats record data for a zone file, quoting TXT data in strings of at most
+// 255 bytes. Domain names are qualified with a trailing dot, as the API returns them
+// without one.
+func zoneData(recordType string, data string) string {
+    recordType = strings.ToUpper(recordType)
+    if positions, ok := zoneNameFields[recordType]; ok {
+        fields := strings.Fields(data)
+        for _, i := range positions {
+            if i < len(fields) && fields[i] != "." {
+                fields[i] = strings.TrimSuffix(fields[i], ".") + "."
+            }
+        }
+        return strings.Join(fields, " ")
+    }
+    if recordType != "TXT" || (len(data) > 1 && strings.HasPrefix(data, "\"") && strings.HasSuffix(data, "\"")) {
+        return data
+    }
+    parts := []string{}
+    for _, chunk := range SplitTXT(data) {
+        parts = append(parts, quoteZoneString(chunk))
+    }
+    return strings.Join(parts, " ")
+}
+
+// SplitTXT splits TXT record data into character strings of at most 255 bytes.
+func SplitTXT(data string) []string {
+    chunks := []string{}
+    for len(data) > 255 {
+        chunks = append(chunks, data[:255])
+        data = data[255:]
+    }
+    return append(chunks, data)
+}
+
+func quoteZoneString(s string) string {
+    b := strings.Builder{}
+    b.WriteByte('"')
+    for i := 0; i < len(s); i++ {
+        c := s[i]
+        switch {
+            case c == '"' || c == '\\':
+                b.WriteByte('\\')
+                b.WriteByte(c)
+            case c < 32 || c > 126:
+                fmt.Fprintf(&b, "\\%03d", c)
+            default:
+                b.WriteByte(c)
+        }
+    }
+    b.WriteByte('"')
+    return b.String()
+}
+
+// ImportZoneFile parses a zone file and creates its records in the zone. It returns the
+// records created before an error occurred and the skipped records of the zone file.
+func (c DomainClient) ImportZoneFile(ctx context.Context, zone string, r io.Reader) ([]DNSRecord, []*ZoneFileError, error) {
+    in, err := ParseZoneFile(r, zone)
+    if err != nil {
+        return nil, nil, err
+    }
+    created := []DNSRecord{}
+    for _, record := range in.Records {
+        res, _, err := c.CreateDNSZoneRecordWithContext(ctx, record, zone)
+        if err != nil {
+            return created, in.Skipped, fmt.Errorf("creating %s record %q: %w", record.Type, record.Name, err)
+        }
+        created = append(created, res.Data)
+    }
+    return created, in.Skipped, nil
+}
+
+// ExportZoneFile writes the zone and all of its records as a zone file to w.
+func (c DomainClient) ExportZoneFile(ctx context.Context, zone string, w io.Writer) error {
+    res, _, err := c.GetDNSZoneWithContext(ctx, zone)
+    if err != nil {
+        return err
+    }
+    records, err := c.ListAllDNSZoneRecords(ctx, zone, GetDNSZoneRecordsQueryParams{}).All()
+    if err != nil {
+        return err
+    }
+    return WriteZoneFile(w, res.Data, records)
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+    "bytes"
+    "reflect"
+    "strconv"
+    "strings"
+    "testing"
+    "time"
+)
+
+func intPtr(v int) *int {
+    return &v
+}
+
+func TestParseZoneFile(t *testing.T) {
+    tests := []struct {
+        name string
+        input string
+        want []DNSRecordCreateRequest
+        skipped []int
+    }{
+        {
+            name: "ttl of the previous record",
+            input: "www 300 IN A 192.0.2.1\n    IN A 192.0.2.2\nmail IN A 192.0.2.3\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(300)},
+                {Name: "www", Type: "A", Data: "192.0.2.2", Ttl: intPtr(300)},
+                {Name: "mail", Type: "A", Data: "192.0.2.3", Ttl: intPtr(300)},
+            },
+        },
+        {
+            name: "$TTL takes precedence",
+            input: "$TTL 1h\nwww 300 IN A 192.0.2.1\nmail A 192.0.2.3\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "www", Type: "A", Data: "192.0.2.1", Ttl: intPtr(300)},
+                {Name: "mail", Type: "A", Data: "192.0.2.3", Ttl: intPtr(3600)},
+            },
+        },
+        {
+            name: "no ttl at all",
+            input: "@ IN NS ns1\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "", Type: "NS", Data: "ns1.example.com."},
+            },
+        },
+        {
+            name: "class before ttl",
+            input: "www IN 60 CNAME web.example.net.\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "www", Type: "CNAME", Data: "web.example.net.", Ttl: intPtr(60)},
+            },
+        },
+        {
+            name: "unsupported types are skipped",
+            input: "@ 300 IN HINFO \"PC\" \"Linux\"\n@ IN SPF \"v=spf1 -all\"\nsip IN NAPTR 100 10 \"u\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" .\n@ IN MX 10 mail\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "", Type: "MX", Data: "10 mail.example.com.", Ttl: intPtr(300)},
+            },
+            skipped: []int{1, 2, 3},
+        },
+        {
+            name: "origin and multi-line records",
+            input: "$ORIGIN sub.example.com.\n_sip._tcp IN SRV ( 10 5 5060\n    sip )\ntxt IN TXT \"a\" \"b\" ; comment\n",
+            want: []DNSRecordCreateRequest{
+                {Name: "_sip._tcp.sub", Type: "SRV", Data: "10 5 5060 sip.sub.example.com."},
+                {Name: "txt.sub", Type: "TXT", Data: "ab"},
+            },
+        },
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := ParseZoneFile(strings.NewReader(tt.input), "example.com")
+            if err != nil {
+                t.Fatal(err)
+            }
+            if !reflect.DeepEqual(got.Records, tt.want) {
+                t.Errorf("got %s, want %s", formatRequests(got.Records), formatRequests(tt.want))
+            }
+            lines := []int{}
+            for _, e := range got.Skipped {
+                lines = append(lines, e.Line)
+            }
+            if len(lines) != len(tt.skipped) || (len(lines) > 0 && !reflect.DeepEqual(lines, tt.skipped)) {
+                t.Errorf("got skipped lines %v, want %v", lines, tt.skipped)
+            }
+        })
+    }
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+    tests := []struct {
+        input string
+        line int
+    }{
+        {"www IN A (192.0.2.1\n", 1},
+        {"www IN A 192.0.2.1 )\n", 1},
+        {"    IN A 192.0.2.1\n", 1},
+        {"www.example.org. IN A 192.0.2.1\n", 1},
+        {"www 300 IN\n", 1},
+        {"$INCLUDE other.zone\n", 1},
+        {"www IN TXT \"unterminated\n", 1},
+    }
+    for _, tt := range tests {
+        _, err := ParseZoneFile(strings.NewReader(tt.input), "example.com")
+        zfErr, ok := err.(*ZoneFileError)
+        if !ok || zfErr.Line != tt.line {
+            t.Errorf("%q: got %v, want an error in line %d", tt.input, err, tt.line)
+        }
+    }
+}
+
+func formatRequests(records []DNSRecordCreateRequest) string {
+    parts := []string{}
+    for _, r := range records {
+        ttl := "-"
+        if r.Ttl != nil {
+            ttl = strconv.Itoa(*r.Ttl)
+        }
+        parts = append(parts, r.Name+" "+ttl+" "+r.Type+" "+r.Data)
+    }
+    return "[" + strings.Join(parts, "; ") + "]"
+}
+
+// apiRecords are records as returned by the API, with domain names in the data lacking
+// the trailing dot.
+var apiRecords = []DNSRecord{
+    {Name: "", Type: "NS", Data: "ns1.example.net", Ttl: intPtr(86400)},
+    {Name: "", Type: "MX", Data: "10 mail.example.com", Ttl: intPtr(300)},
+    {Name: "", Type: "TXT", Data: "v=spf1 mx -all", Ttl: intPtr(300)},
+    {Name: "", Type: "CAA", Data: "0 issue \"letsencrypt.org\"", Ttl: intPtr(300)},
+    {Name: "_sip._tcp", Type: "SRV", Data: "10 5 5060 sip.example.com", Ttl: intPtr(300)},
+    {Name: "long", Type: "TXT", Data: strings.Repeat("k", 300), Ttl: intPtr(300)},
+    {Name: "mail", Type: "A", Data: "192.0.2.1", Ttl: intPtr(60)},
+    {Name: "www", Type: "CNAME", Data: "mail.example.com", Ttl: intPtr(300)},
+}
+
+func TestZoneFileRoundTrip(t *testing.T) {
+    zone := DNSZone{Name: "example.com", Ns1: "ns1.example.net", Hostmaster: "hostmaster@example.com"}
+    first := bytes.Buffer{}
+    if err := WriteZoneFile(&first, zone, apiRecords); err != nil {
+        t.Fatal(err)
+    }
+    if strings.Contains(first.String(), "(1 ") || !strings.Contains(first.String(), "("+time.Now().UTC().Format("20060102")+"00 ") {
+        t.Errorf("SOA without a date serial:\n%s", first.String())
+    }
+
+    parsed, err := ParseZoneFile(bytes.NewReader(first.Bytes()), "example.com")
+    if err != nil {
+        t.Fatalf("%v in\n%s", err, first.String())
+    }
+    if len(parsed.Records) != len(apiRecords) || len(parsed.Skipped) != 0 {
+        t.Fatalf("got %s", formatRequests(parsed.Records))
+    }
+    want := map[string]string{
+        "www CNAME": "mail.example.com.",
+        " MX": "10 mail.example.com.",
+        "_sip._tcp SRV": "10 5 5060 sip.example.com.",
+        "long TXT": strings.Repeat("k", 300),
+        " CAA": "0 issue \"letsencrypt.org\"",
+    }
+    for _, r := range parsed.Records {
+        if data, ok := want[r.Name+" "+r.Type]; ok && r.Data != data {
+            t.Errorf("%s %s: got %q, want %q", r.Name, r.Type, r.Data, data)
+        }
+    }
+
+    // Writing the parsed records again has to give the same file.
+    records := []DNSRecord{}
+    for _, r := range parsed.Records {
+        records = append(records, DNSRecord{Name: r.Name, Type: r.Type, Data: r.Data, Ttl: r.Ttl})
+    }
+    second := bytes.Buffer{}
+    if err := WriteZoneFile(&second, zone, records); err != nil {
+        t.Fatal(err)
+    }
+    if first.String() != second.String() {
+        t.Errorf("round trip changed the zone file:\n%s\n---\n%s", first.String(), second.String())
+    }
+}
+
+func TestWriteZoneFileKeepsSOA(t *testing.T) {
+    zone := DNSZone{Name: "example.com", Ns1: "ns1.example.net", Hostmaster: "hostmaster@example.com"}
+    records := append([]DNSRecord{{Name: "", Type: "SOA", Data: "ns1.example.net hostmaster.example.com 2026101801 86400 7200 3600000 3600"}}, apiRecords...)
+    b := bytes.Buffer{}
+    if err := WriteZoneFile(&b, zone, records); err != nil {
+        t.Fatal(err)
+    }
+    lines := strings.Split(b.String(), "\n")
+    if strings.Count(b.String(), "SOA") != 1 || !strings.Contains(lines[2], "SOA\tns1.example.net. hostmaster.example.com. 2026101801 ") {
+        t.Errorf("SOA not kept as first record:\n%s", b.String())
+    }
+}
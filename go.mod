@@ -4,4 +4,5 @@ go 1.16
 
 require (
 	github.com/google/go-querystring v1.1.0
-)
\ No newline at end of file
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,540 @@
+// Command apigen generates the API interface of a service package and the mock
+// implementing it. It is run by go generate in the service package directory:
+//
+//     //go:generate go run ../internal/cmd/apigen -client ComputeClient
+//
+// Every method of the client named XWithContext(ctx context.Context, ...) returning
+// (T, *http.Response, error) is added to the API interface together with X. The mock is
+// written to <pkg>mock/mock.go.
+//
+// Methods named GetX or SearchX taking a qParams struct with Page and PageSize fields and
+// returning a list response with Data and Pagination fields are paginated. For those
+// apigen writes an iterator over the list items and a ListAllX or SearchAllX method
+// fetching all pages to pagination.go.
+package main
+
+import (
+    "bytes"
+    "flag"
+    "fmt"
+    "go/ast"
+    "go/parser"
+    "go/token"
+    "io/ioutil"
+    "log"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+)
+
+const modulePath = "github.com/lumaserv/lumaserv-api-go"
+
+type param struct {
+    name string
+    typ ast.Expr
+}
+
+type method struct {
+    name string
+    params []param
+    result string
+    // elem is the item type of a paginated method, empty otherwise.
+    elem string
+    // dataPtr is set if the Data field of the list response is a pointer to the slice.
+    dataPtr bool
+}
+
+// listPrefixes maps the name prefixes of paginated methods to those of their iterating
+// counterparts.
+var listPrefixes = map[string]string{
+    "Get": "ListAll",
+    "Search": "SearchAll",
+}
+
+func main() {
+    client := flag.String("client", "", "name of the client type, e.g. ComputeClient")
+    dir := flag.String("dir", ".", "directory of the service package")
+    flag.Parse()
+    if len(*client) == 0 {
+        log.Fatal("apigen: -client is required")
+    }
+
+    pkg, methods, imports, err := parsePackage(*dir, *client)
+    if err != nil {
+        log.Fatalf("apigen: %v", err)
+    }
+    if err := writeFile(filepath.Join(*dir, "api.go"), apiFile(pkg, *client, methods, imports)); err != nil {
+        log.Fatalf("apigen: %v", err)
+    }
+    if err := writeFile(filepath.Join(*dir, pkg+"mock", "mock.go"), mockFile(pkg, methods, imports)); err != nil {
+        log.Fatalf("apigen: %v", err)
+    }
+    if err := writeFile(filepath.Join(*dir, "pagination.go"), paginationFile(pkg, *client, methods)); err != nil {
+        log.Fatalf("apigen: %v", err)
+    }
+}
+
+// parsePackage collects the client methods in source order, starting with <pkg>.go, and
+// the packages other than context and net/http their parameters refer to.
+func parsePackage(dir string, client string) (string, []method, []string, error) {
+    files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+    if err != nil {
+        return "", nil, nil, err
+    }
+    pkg := filepath.Base(mustAbs(dir))
+    main := filepath.Join(dir, pkg+".go")
+    sort.Slice(files, func(i, j int) bool {
+        if (files[i] == main) != (files[j] == main) {
+            return files[i] == main
+        }
+        return files[i] < files[j]
+    })
+
+    fset := token.NewFileSet()
+    methods := []method{}
+    imports := map[string]bool{}
+    structs := map[string]*ast.StructType{}
+    for _, file := range files {
+        if filepath.Base(file) == "api.go" || filepath.Base(file) == "pagination.go" || strings.HasSuffix(file, "_test.go") {
+            continue
+        }
+        f, err := parser.ParseFile(fset, file, nil, 0)
+        if err != nil {
+            return "", nil, nil, err
+        }
+        for _, decl := range f.Decls {
+            collectStructs(decl, structs)
+            m, ok := clientMethod(decl, client)
+            if !ok {
+                continue
+            }
+            for _, p := range m.params {
+                for _, name := range packageRefs(p.typ) {
+                    imports[name] = true
+                }
+            }
+            methods = append(methods, m)
+        }
+    }
+    if len(methods) == 0 {
+        return "", nil, nil, fmt.Errorf("no methods of %s found in %s", client, dir)
+    }
+    for i := range methods {
+        methods[i].elem, methods[i].dataPtr = pageElem(methods[i], structs)
+    }
+    names := []string{}
+    for name := range imports {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return pkg, methods, names, nil
+}
+
+func clientMethod(decl ast.Decl, client string) (method, bool) {
+    fn, ok := decl.(*ast.FuncDecl)
+    if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || !strings.HasSuffix(fn.Name.Name, "WithContext") {
+        return method{}, false
+    }
+    if recv, ok := fn.Recv.List[0].Type.(*ast.Ident); !ok || recv.Name != client {
+        return method{}, false
+    }
+    params := []param{}
+    for _, field := range fn.Type.Params.List {
+        for _, name := range field.Names {
+            params = append(params, param{name: name.Name, typ: field.Type})
+        }
+    }
+    if len(params) == 0 || typeString(params[0].typ, "") != "context.Context" {
+        return method{}, false
+    }
+    results := fn.Type.Results
+    if results == nil || len(results.List) != 3 {
+        return method{}, false
+    }
+    result, ok := results.List[0].Type.(*ast.Ident)
+    if !ok || typeString(results.List[1].Type, "") != "*http.Response" || typeString(results.List[2].Type, "") != "error" {
+        return method{}, false
+    }
+    return method{
+        name: strings.TrimSuffix(fn.Name.Name, "WithContext"),
+        params: params[1:],
+        result: result.Name,
+    }, true
+}
+
+func collectStructs(decl ast.Decl, structs map[string]*ast.StructType) {
+    gen, ok := decl.(*ast.GenDecl)
+    if !ok || gen.Tok != token.TYPE {
+        return
+    }
+    for _, spec := range gen.Specs {
+        ts := spec.(*ast.TypeSpec)
+        if st, ok := ts.Type.(*ast.StructType); ok {
+            structs[ts.Name.Name] = st
+        }
+    }
+}
+
+// structField returns the type of the named field of st, or nil if there is none.
+func structField(st *ast.StructType, name string) ast.Expr {
+    for _, field := range st.Fields.List {
+        for _, n := range field.Names {
+            if n.Name == name {
+                return field.Type
+            }
+        }
+    }
+    return nil
+}
+
+// pageElem returns the item type of a paginated method and whether Data is a pointer, or
+// an empty string if m is not paginated.
+func pageElem(m method, structs map[string]*ast.StructType) (string, bool) {
+    if len(m.listName()) == 0 || len(m.params) == 0 {
+        return "", false
+    }
+    last := m.params[len(m.params)-1]
+    qType, ok := last.typ.(*ast.Ident)
+    if last.name != "qParams" || !ok || structs[qType.Name] == nil {
+        return "", false
+    }
+    q := structs[qType.Name]
+    if typeString(structField(q, "Page"), "") != "*int" || typeString(structField(q, "PageSize"), "") != "*int" {
+        return "", false
+    }
+    res := structs[m.result]
+    if res == nil || structField(res, "Pagination") == nil {
+        return "", false
+    }
+    data := structField(res, "Data")
+    ptr, dataPtr := data.(*ast.StarExpr)
+    if dataPtr {
+        data = ptr.X
+    }
+    slice, ok := data.(*ast.ArrayType)
+    if !ok {
+        return "", false
+    }
+    elem, ok := slice.Elt.(*ast.Ident)
+    if !ok {
+        return "", false
+    }
+    return elem.Name, dataPtr
+}
+
+var builtins = map[string]bool{
+    "bool": true, "byte": true, "error": true, "float32": true, "float64": true, "int": true,
+    "int64": true, "interface{}": true, "rune": true, "string": true,
+}
+
+// typeString formats a type expression, qualifying the types of the package with qualifier.
+func typeString(e ast.Expr, qualifier string) string {
+    switch t := e.(type) {
+        case nil:
+            return ""
+        case *ast.Ident:
+            if len(qualifier) > 0 && !builtins[t.Name] {
+                return qualifier + "." + t.Name
+            }
+            return t.Name
+        case *ast.SelectorExpr:
+            return typeString(t.X, "") + "." + t.Sel.Name
+        case *ast.StarExpr:
+            return "*" + typeString(t.X, qualifier)
+        case *ast.ArrayType:
+            return "[]" + typeString(t.Elt, qualifier)
+        case *ast.MapType:
+            return "map[" + typeString(t.Key, qualifier) + "]" + typeString(t.Value, qualifier)
+        case *ast.InterfaceType:
+            return "interface{}"
+    }
+    panic(fmt.Sprintf("apigen: unsupported type %T", e))
+}
+
+func packageRefs(e ast.Expr) []string {
+    refs := []string{}
+    ast.Inspect(e, func(n ast.Node) bool {
+        if sel, ok := n.(*ast.SelectorExpr); ok {
+            if id, ok := sel.X.(*ast.Ident); ok && id.Name != "context" && id.Name != "http" {
+                refs = append(refs, id.Name)
+            }
+        }
+        return true
+    })
+    return refs
+}
+
+func (m method) signature(qualifier string, withContext bool) string {
+    params := []string{}
+    if withContext {
+        params = append(params, "ctx context.Context")
+    }
+    for _, p := range m.params {
+        params = append(params, p.name+" "+typeString(p.typ, qualifier))
+    }
+    result := m.result
+    if len(qualifier) > 0 {
+        result = qualifier + "." + result
+    }
+    return "(" + strings.Join(params, ", ") + ") (" + result + ", *http.Response, error)"
+}
+
+// listName is the name of the iterating counterpart of m, or an empty string if the name
+// of m has none of the listPrefixes.
+func (m method) listName() string {
+    for prefix, list := range listPrefixes {
+        if strings.HasPrefix(m.name, prefix) {
+            return list + strings.TrimPrefix(m.name, prefix)
+        }
+    }
+    return ""
+}
+
+// listSignature is the signature of the ListAll method of a paginated method.
+func (m method) listSignature(qualifier string) string {
+    params := []string{"ctx context.Context"}
+    for _, p := range m.params {
+        params = append(params, p.name+" "+typeString(p.typ, qualifier))
+    }
+    iterator := m.elem + "Iterator"
+    if len(qualifier) > 0 {
+        iterator = qualifier + "." + iterator
+    }
+    return "(" + strings.Join(params, ", ") + ") *" + iterator
+}
+
+// fetchType is the type of the fetch function passed to the iterator constructor.
+func fetchType(elem string, qualifier string) string {
+    pagination := "ResponsePagination"
+    if len(qualifier) > 0 {
+        elem = qualifier + "." + elem
+        pagination = qualifier + "." + pagination
+    }
+    return "func(ctx context.Context, page int, pageSize *int) ([]" + elem + ", *" + pagination + ", error)"
+}
+
+// listBody is the body of a ListAll method calling the GetXWithContext method of recv.
+func (m method) listBody(recv string, qualifier string) string {
+    constructor := "New" + m.elem + "Iterator"
+    if len(qualifier) > 0 {
+        constructor = qualifier + "." + constructor
+    }
+    data := "res.Data"
+    if m.dataPtr {
+        data = "*res.Data"
+    }
+    b := &bytes.Buffer{}
+    fmt.Fprintf(b, `    return %s(ctx, qParams.Page, qParams.PageSize, %s {
+        qParams.Page = &page
+        qParams.PageSize = pageSize
+        res, _, err := %s.%sWithContext(ctx%s)
+`, constructor, fetchType(m.elem, qualifier), recv, m.name, m.args())
+    if m.dataPtr {
+        b.WriteString("        if res.Data == nil {\n            return nil, res.Pagination, err\n        }\n")
+    }
+    fmt.Fprintf(b, "        return %s, res.Pagination, err\n    })\n", data)
+    return b.String()
+}
+
+func (m method) args() string {
+    args := ""
+    for _, p := range m.params {
+        args += ", " + p.name
+    }
+    return args
+}
+
+func header(b *bytes.Buffer) {
+    b.WriteString("// Code generated by apigen. DO NOT EDIT.\n\n")
+}
+
+func apiFile(pkg string, client string, methods []method, imports []string) []byte {
+    b := &bytes.Buffer{}
+    header(b)
+    fmt.Fprintf(b, "package %s\n\n", pkg)
+    fmt.Fprintf(b, "//go:generate go run ../internal/cmd/apigen -client %s\n\n", client)
+    b.WriteString("import (\n    \"context\"\n")
+    for _, name := range imports {
+        fmt.Fprintf(b, "    %q\n", name)
+    }
+    b.WriteString("    \"net/http\"\n)\n\n")
+    fmt.Fprintf(b, "// API lists all methods of %s, allowing it to be replaced by a mock.\n", client)
+    b.WriteString("type API interface {\n")
+    for _, m := range methods {
+        fmt.Fprintf(b, "    %s%s\n", m.name, m.signature("", false))
+        fmt.Fprintf(b, "    %sWithContext%s\n", m.name, m.signature("", true))
+        if len(m.elem) > 0 {
+            fmt.Fprintf(b, "    %s%s\n", m.listName(), m.listSignature(""))
+        }
+    }
+    fmt.Fprintf(b, "}\n\nvar _ API = %s{}\n", client)
+    return b.Bytes()
+}
+
+func mockFile(pkg string, methods []method, imports []string) []byte {
+    b := &bytes.Buffer{}
+    header(b)
+    fmt.Fprintf(b, "package %smock\n\n", pkg)
+    b.WriteString("import (\n    \"context\"\n")
+    for _, name := range imports {
+        fmt.Fprintf(b, "    %q\n", name)
+    }
+    fmt.Fprintf(b, "    \"net/http\"\n    \"sync\"\n    \"%s/%s\"\n)\n\n", modulePath, pkg)
+    fmt.Fprintf(b, `// Call is a call recorded by Mock.
+type Call struct {
+    Method string
+    Args []interface{}
+}
+
+// Mock implements %s.API. Every call is recorded and answered by the matching Func
+// field if set, otherwise with an empty response and no error.
+type Mock struct {
+    mu sync.Mutex
+    calls []Call
+`, pkg)
+    for _, m := range methods {
+        fmt.Fprintf(b, "    %sFunc func%s\n", m.name, m.signature(pkg, true))
+    }
+    fmt.Fprintf(b, `}
+
+var _ %s.API = (*Mock)(nil)
+
+func (m *Mock) record(method string, args ...interface{}) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all calls recorded so far.
+func (m *Mock) Calls() []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method.
+func (m *Mock) CallsTo(method string) []Call {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    calls := []Call{}
+    for _, c := range m.calls {
+        if c.Method == method {
+            calls = append(calls, c)
+        }
+    }
+    return calls
+}
+`, pkg)
+    for _, m := range methods {
+        result := pkg + "." + m.result
+        fmt.Fprintf(b, `
+func (m *Mock) %[1]s%[2]s {
+    return m.%[1]sWithContext(context.Background()%[3]s)
+}
+
+func (m *Mock) %[1]sWithContext%[4]s {
+    m.record("%[1]s"%[3]s)
+    if m.%[1]sFunc != nil {
+        return m.%[1]sFunc(ctx%[3]s)
+    }
+    return %[5]s{}, nil, nil
+}
+`, m.name, m.signature(pkg, false), m.args(), m.signature(pkg, true), result)
+        if len(m.elem) > 0 {
+            fmt.Fprintf(b, "\nfunc (m *Mock) %s%s {\n%s}\n", m.listName(), m.listSignature(pkg), m.listBody("m", pkg))
+        }
+    }
+    return b.Bytes()
+}
+
+func paginationFile(pkg string, client string, methods []method) []byte {
+    b := &bytes.Buffer{}
+    header(b)
+    fmt.Fprintf(b, "package %s\n\n", pkg)
+    fmt.Fprintf(b, "import (\n    \"context\"\n    \"%s/core\"\n)\n", modulePath)
+    elems := map[string]bool{}
+    for _, m := range methods {
+        if len(m.elem) == 0 || elems[m.elem] {
+            continue
+        }
+        elems[m.elem] = true
+        fmt.Fprintf(b, `
+// %[1]sIterator iterates over the %[1]s items of all pages of a list.
+type %[1]sIterator struct {
+    pager *core.Pager
+    items []%[1]s
+    current %[1]s
+}
+
+// New%[1]sIterator returns an iterator over the items returned by fetch, starting at page.
+func New%[1]sIterator(ctx context.Context, page *int, pageSize *int, fetch %[2]s) *%[1]sIterator {
+    it := &%[1]sIterator{}
+    it.pager = core.NewPager(ctx, page, pageSize, func(ctx context.Context, page int, pageSize *int) (int, *core.ResponsePagination, error) {
+        items, pagination, err := fetch(ctx, page, pageSize)
+        if err != nil {
+            return 0, nil, err
+        }
+        it.items = append(it.items, items...)
+        return len(items), pagination, nil
+    })
+    return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It returns false
+// when all items have been returned or an error occurred.
+func (it *%[1]sIterator) Next() bool {
+    for len(it.items) == 0 {
+        if !it.pager.NextPage() {
+            return false
+        }
+    }
+    it.current = it.items[0]
+    it.items = it.items[1:]
+    return true
+}
+
+// Value returns the current item.
+func (it *%[1]sIterator) Value() %[1]s {
+    return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *%[1]sIterator) Err() error {
+    return it.pager.Err()
+}
+
+// All returns the remaining items.
+func (it *%[1]sIterator) All() ([]%[1]s, error) {
+    all := []%[1]s{}
+    for it.Next() {
+        all = append(all, it.Value())
+    }
+    return all, it.Err()
+}
+`, m.elem, fetchType(m.elem, ""))
+    }
+    for _, m := range methods {
+        if len(m.elem) == 0 {
+            continue
+        }
+        fmt.Fprintf(b, "\n// %s iterates over the items of all pages of %s.\n", m.listName(), m.name)
+        fmt.Fprintf(b, "func (c %s) %s%s {\n%s}\n", client, m.listName(), m.listSignature(""), m.listBody("c", ""))
+    }
+    return b.Bytes()
+}
+
+func writeFile(name string, data []byte) error {
+    if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+        return err
+    }
+    return ioutil.WriteFile(name, data, 0644)
+}
+
+func mustAbs(dir string) string {
+    abs, err := filepath.Abs(dir)
+    if err != nil {
+        log.Fatalf("apigen: %v", err)
+    }
+    return abs
+}
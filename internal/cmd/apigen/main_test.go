@@ -0,0 +1,40 @@
+package main
+
+import (
+    "bytes"
+    "io/ioutil"
+    "path/filepath"
+    "testing"
+)
+
+// TestGeneratedFilesUpToDate fails if a client changed without running go generate.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+    clients := map[string]string{
+        "addon": "AddonClient",
+        "auth": "AuthClient",
+        "billing": "BillingClient",
+        "compute": "ComputeClient",
+        "domain": "DomainClient",
+    }
+    for pkg, client := range clients {
+        dir := filepath.Join("..", "..", "..", pkg)
+        _, methods, imports, err := parsePackage(dir, client)
+        if err != nil {
+            t.Fatal(err)
+        }
+        files := map[string][]byte{
+            filepath.Join(dir, "api.go"): apiFile(pkg, client, methods, imports),
+            filepath.Join(dir, pkg+"mock", "mock.go"): mockFile(pkg, methods, imports),
+            filepath.Join(dir, "pagination.go"): paginationFile(pkg, client, methods),
+        }
+        for name, want := range files {
+            got, err := ioutil.ReadFile(name)
+            if err != nil {
+                t.Fatal(err)
+            }
+            if !bytes.Equal(got, want) {
+                t.Errorf("%s is out of date, run go generate ./...", name)
+            }
+        }
+    }
+}
@@ -0,0 +1,363 @@
+package labels
+
+import (
+    "context"
+    "fmt"
+    "net/http"
+    "strconv"
+    "sync"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/core"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+type OperationKind string
+
+const (
+    OperationAdd OperationKind = "add"
+    OperationRemove OperationKind = "remove"
+    OperationRename OperationKind = "rename"
+)
+
+// Operation changes a single label key. Add sets Key to Value, replacing an existing
+// value. Rename moves the value of Key to NewKey and fails if NewKey already exists.
+type Operation struct {
+    Kind OperationKind `json:"kind"`
+    Key string `json:"key"`
+    Value *string `json:"value,omitempty"`
+    NewKey string `json:"new_key,omitempty"`
+}
+
+func AddLabel(key string, value *string) Operation {
+    return Operation{Kind: OperationAdd, Key: key, Value: value}
+}
+
+func RemoveLabel(key string) Operation {
+    return Operation{Kind: OperationRemove, Key: key}
+}
+
+func RenameLabel(key string, newKey string) Operation {
+    return Operation{Kind: OperationRename, Key: key, NewKey: newKey}
+}
+
+func (o Operation) String() string {
+    switch o.Kind {
+        case OperationAdd:
+            if o.Value == nil {
+                return "add " + o.Key
+            }
+            return "add " + o.Key + "=" + *o.Value
+        case OperationRename:
+            return "rename " + o.Key + " to " + o.NewKey
+    }
+    return string(o.Kind) + " " + o.Key
+}
+
+// ApplyOperations returns a copy of labels with the operations applied in order and
+// whether anything changed.
+func ApplyOperations(labels map[string]*string, ops ...Operation) (map[string]*string, bool, error) {
+    result := map[string]*string{}
+    for k, v := range labels {
+        result[k] = v
+    }
+    changed := false
+    for _, o := range ops {
+        if err := validateKey(o.Key); err != nil || len(o.Key) == 0 {
+            return nil, false, fmt.Errorf("%s: invalid label key %q", o, o.Key)
+        }
+        current, exists := result[o.Key]
+        switch o.Kind {
+            case OperationAdd:
+                if !exists || !sameValue(current, o.Value) {
+                    result[o.Key] = o.Value
+                    changed = true
+                }
+            case OperationRemove:
+                if exists {
+                    delete(result, o.Key)
+                    changed = true
+                }
+            case OperationRename:
+                if err := validateKey(o.NewKey); err != nil || len(o.NewKey) == 0 {
+                    return nil, false, fmt.Errorf("%s: invalid label key %q", o, o.NewKey)
+                }
+                if !exists || o.Key == o.NewKey {
+                    continue
+                }
+                if _, ok := result[o.NewKey]; ok {
+                    return nil, false, fmt.Errorf("%s: label %s already exists", o, o.NewKey)
+                }
+                result[o.NewKey] = current
+                delete(result, o.Key)
+                changed = true
+            default:
+                return nil, false, fmt.Errorf("unknown operation %q", o.Kind)
+        }
+    }
+    return result, changed, nil
+}
+
+func sameValue(a *string, b *string) bool {
+    if a == nil || b == nil {
+        return a == b
+    }
+    return *a == *b
+}
+
+// BulkOptions configures Relabel. Zero values fall back to 4 workers, no request rate
+// limit and 5 retries of rate limited updates.
+type BulkOptions struct {
+    Workers int
+    // RequestsPerSecond limits the update requests of all workers together.
+    RequestsPerSecond float64
+    // MaxRateLimitRetries is how often an update is retried after the API responded with
+    // 429, in addition to the retries of the client's retry policy. All workers pause for
+    // the Retry-After delay before continuing.
+    MaxRateLimitRetries int
+    // DryRun computes the new labels without updating any object.
+    DryRun bool
+    // OnResult is called for every object once it is done, from the worker goroutines.
+    OnResult func(result BulkResult)
+}
+
+type BulkStatus string
+
+const (
+    BulkStatusUpdated BulkStatus = "updated"
+    BulkStatusUnchanged BulkStatus = "unchanged"
+    BulkStatusPlanned BulkStatus = "planned"
+    BulkStatusFailed BulkStatus = "failed"
+)
+
+// BulkResult is the outcome for one object. Labels are the labels after the operations,
+// also for planned and failed updates.
+type BulkResult struct {
+    Object Object `json:"object"`
+    Status BulkStatus `json:"status"`
+    Labels map[string]*string `json:"labels"`
+    Err error `json:"-"`
+    Error string `json:"error,omitempty"`
+}
+
+// BulkReport lists the results in the order of the objects passed to Relabel.
+type BulkReport struct {
+    Results []BulkResult `json:"results"`
+}
+
+// Count returns the number of results with the status.
+func (r BulkReport) Count(status BulkStatus) int {
+    n := 0
+    for _, result := range r.Results {
+        if result.Status == status {
+            n++
+        }
+    }
+    return n
+}
+
+func (r BulkReport) Failed() []BulkResult {
+    failed := []BulkResult{}
+    for _, result := range r.Results {
+        if result.Status == BulkStatusFailed {
+            failed = append(failed, result)
+        }
+    }
+    return failed
+}
+
+// Err returns an error summarizing the failed objects, or nil if none failed.
+func (r BulkReport) Err() error {
+    failed := r.Failed()
+    if len(failed) == 0 {
+        return nil
+    }
+    return fmt.Errorf("labels: %d of %d objects failed, first %s %s: %v", len(failed), len(r.Results), failed[0].Object.Type, failed[0].Object.Id, failed[0].Err)
+}
+
+// Relabel applies the operations to all objects concurrently and reports the result per
+// object. Objects that would not change are not updated. The returned error is only set
+// if ctx was canceled, failed objects are reported in the results. The report has a result
+// for every object, objects not processed before ctx was canceled fail with ctx.Err().
+func (c Clients) Relabel(ctx context.Context, objects []Object, opts BulkOptions, ops ...Operation) (BulkReport, error) {
+    if opts.Workers <= 0 {
+        opts.Workers = 4
+    }
+    if opts.MaxRateLimitRetries <= 0 {
+        opts.MaxRateLimitRetries = 5
+    }
+    gate := &rateGate{}
+    if opts.RequestsPerSecond > 0 {
+        gate.interval = time.Duration(float64(time.Second) / opts.RequestsPerSecond)
+    }
+
+    report := BulkReport{Results: make([]BulkResult, len(objects))}
+    jobs := make(chan int)
+    wg := sync.WaitGroup{}
+    for w := 0; w < opts.Workers && w < len(objects); w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := range jobs {
+                result := c.relabel(ctx, gate, objects[i], opts, ops)
+                if result.Err != nil {
+                    result.Error = result.Err.Error()
+                }
+                report.Results[i] = result
+                if opts.OnResult != nil {
+                    opts.OnResult(result)
+                }
+            }
+        }()
+    }
+    dispatched := 0
+    for ; dispatched < len(objects); dispatched++ {
+        select {
+            case jobs <- dispatched:
+                continue
+            case <-ctx.Done():
+        }
+        break
+    }
+    close(jobs)
+    wg.Wait()
+    // Objects not handed to a worker before ctx was canceled are reported as failed.
+    for i := dispatched; i < len(objects); i++ {
+        err := ctx.Err()
+        result := BulkResult{Object: objects[i], Status: BulkStatusFailed, Labels: objects[i].Labels, Err: err, Error: err.Error()}
+        report.Results[i] = result
+        if opts.OnResult != nil {
+            opts.OnResult(result)
+        }
+    }
+    return report, ctx.Err()
+}
+
+// RelabelSelected applies the operations to all objects of the types matching the selector.
+func (c Clients) RelabelSelected(ctx context.Context, sel Selector, types []ObjectType, opts BulkOptions, ops ...Operation) (BulkReport, error) {
+    objects, err := c.List(ctx, sel, types...)
+    if err != nil {
+        return BulkReport{}, err
+    }
+    return c.Relabel(ctx, objects, opts, ops...)
+}
+
+func (c Clients) relabel(ctx context.Context, gate *rateGate, o Object, opts BulkOptions, ops []Operation) BulkResult {
+    result := BulkResult{Object: o, Status: BulkStatusUnchanged, Labels: o.Labels}
+    labels, changed, err := ApplyOperations(o.Labels, ops...)
+    if err != nil {
+        result.Status = BulkStatusFailed
+        result.Err = err
+        return result
+    }
+    result.Labels = labels
+    if !changed {
+        return result
+    }
+    if opts.DryRun {
+        result.Status = BulkStatusPlanned
+        return result
+    }
+    for attempt := 0; ; attempt++ {
+        if err := gate.wait(ctx); err != nil {
+            result.Status = BulkStatusFailed
+            result.Err = err
+            return result
+        }
+        res, err := c.UpdateLabels(ctx, o.Type, o.Id, labels)
+        if err == nil {
+            result.Status = BulkStatusUpdated
+            return result
+        }
+        if !core.IsRateLimited(err) || attempt >= opts.MaxRateLimitRetries {
+            result.Status = BulkStatusFailed
+            result.Err = err
+            return result
+        }
+        gate.pause(retryAfter(res, attempt))
+    }
+}
+
+// UpdateLabels replaces the labels of an object. S3 buckets and SSL certificates can not
+// be updated.
+func (c Clients) UpdateLabels(ctx context.Context, t ObjectType, id string, labels map[string]*string) (*http.Response, error) {
+    var res *http.Response
+    var err error
+    switch t {
+        case ObjectTypeServer:
+            _, res, err = c.Compute.UpdateServerWithContext(ctx, compute.ServerUpdateRequest{Labels: labels}, id)
+        case ObjectTypeServerVolume:
+            _, res, err = c.Compute.UpdateServerVolumeWithContext(ctx, compute.ServerVolumeUpdateRequest{Labels: labels}, id)
+        case ObjectTypeNetwork:
+            _, res, err = c.Compute.UpdateNetworkWithContext(ctx, compute.NetworkUpdateRequest{Labels: labels}, id)
+        case ObjectTypeDNSZone:
+            _, res, err = c.Domain.UpdateDNSZoneWithContext(ctx, domain.DNSZoneUpdateRequest{Labels: labels}, id)
+        case ObjectTypeDomain:
+            _, res, err = c.Domain.UpdateDomainWithContext(ctx, domain.DomainUpdateRequest{Labels: labels}, id)
+        case ObjectTypePleskLicense:
+            _, res, err = c.Addon.UpdatePleskLicenseWithContext(ctx, addon.PleskLicenseUpdateRequest{Labels: labels}, id)
+        default:
+            return nil, fmt.Errorf("labels of object type %q can not be updated", t)
+    }
+    return res, err
+}
+
+// retryAfter returns the delay requested by the Retry-After header, falling back to an
+// exponential backoff from one second up to one minute.
+func retryAfter(res *http.Response, attempt int) time.Duration {
+    if res != nil {
+        value := res.Header.Get("Retry-After")
+        if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+            return time.Duration(seconds) * time.Second
+        }
+        if at, err := http.ParseTime(value); err == nil {
+            return time.Until(at)
+        }
+    }
+    d := time.Second << uint(attempt)
+    if d > time.Minute || d <= 0 {
+        d = time.Minute
+    }
+    return d
+}
+
+// rateGate spaces requests of all workers by interval and lets a rate limited worker
+// pause the others.
+type rateGate struct {
+    mu sync.Mutex
+    next time.Time
+    interval time.Duration
+}
+
+func (g *rateGate) wait(ctx context.Context) error {
+    g.mu.Lock()
+    now := time.Now()
+    at := g.next
+    if at.Before(now) {
+        at = now
+    }
+    g.next = at.Add(g.interval)
+    g.mu.Unlock()
+
+    d := time.Until(at)
+    if d <= 0 {
+        return ctx.Err()
+    }
+    timer := time.NewTimer(d)
+    defer timer.Stop()
+    select {
+        case <-timer.C:
+            return nil
+        case <-ctx.Done():
+            return ctx.Err()
+    }
+}
+
+func (g *rateGate) pause(d time.Duration) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if until := time.Now().Add(d); until.After(g.next) {
+        g.next = until
+    }
+}
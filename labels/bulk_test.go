@@ -0,0 +1,119 @@
+package labels_test
+
+import (
+    "context"
+    "errors"
+    "net/http"
+    "reflect"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/labels"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func TestApplyOperations(t *testing.T) {
+    current := map[string]*string{"env": strPtr("prod"), "team": strPtr("a")}
+    tests := []struct {
+        ops []labels.Operation
+        want map[string]*string
+        changed bool
+        fails bool
+    }{
+        {[]labels.Operation{labels.AddLabel("env", strPtr("prod"))}, current, false, false},
+        {[]labels.Operation{labels.AddLabel("env", strPtr("dev"))}, map[string]*string{"env": strPtr("dev"), "team": strPtr("a")}, true, false},
+        {[]labels.Operation{labels.AddLabel("flag", nil)}, map[string]*string{"env": strPtr("prod"), "team": strPtr("a"), "flag": nil}, true, false},
+        {[]labels.Operation{labels.RemoveLabel("missing")}, current, false, false},
+        {[]labels.Operation{labels.RemoveLabel("team")}, map[string]*string{"env": strPtr("prod")}, true, false},
+        {[]labels.Operation{labels.RenameLabel("team", "owner")}, map[string]*string{"env": strPtr("prod"), "owner": strPtr("a")}, true, false},
+        {[]labels.Operation{labels.RenameLabel("missing", "owner")}, current, false, false},
+        {[]labels.Operation{labels.RenameLabel("team", "env")}, nil, false, true},
+        {[]labels.Operation{labels.RemoveLabel("env"), labels.RenameLabel("team", "env")}, map[string]*string{"env": strPtr("a")}, true, false},
+        {[]labels.Operation{labels.AddLabel("in valid", nil)}, nil, false, true},
+        {[]labels.Operation{{Kind: "copy", Key: "env"}}, nil, false, true},
+    }
+    for _, tt := range tests {
+        got, changed, err := labels.ApplyOperations(current, tt.ops...)
+        if tt.fails {
+            if err == nil {
+                t.Errorf("%v: no error", tt.ops)
+            }
+            continue
+        }
+        if err != nil || changed != tt.changed || !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("%v: got %v, %v, %v, want %v, %v", tt.ops, got, changed, err, tt.want, tt.changed)
+        }
+    }
+    if *current["env"] != "prod" || len(current) != 2 {
+        t.Errorf("labels passed to ApplyOperations were modified: %v", current)
+    }
+}
+
+func seedServers(s *lumaservtest.Server, n int) []labels.Object {
+    objects := []labels.Object{}
+    for i := 0; i < n; i++ {
+        id := "s" + string(rune('a'+i))
+        s.Seed("/compute/servers", map[string]interface{}{"id": id, "name": id, "labels": map[string]string{"env": "dev"}})
+        objects = append(objects, labels.Object{Type: labels.ObjectTypeServer, Id: id, Name: id, Labels: map[string]*string{"env": strPtr("dev")}})
+    }
+    return objects
+}
+
+func TestRelabel(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    objects := seedServers(s, 5)
+    objects[4].Labels = map[string]*string{"env": strPtr("prod")}
+    c := labels.Clients{Compute: s.ComputeClient(), Domain: s.DomainClient(), Addon: s.AddonClient()}
+    s.InjectFault(lumaservtest.Fault{Method: "PUT", Path: "/compute/servers/sa", StatusCode: 429, Header: http.Header{"Retry-After": {"0"}}, Count: 1})
+
+    report, err := c.Relabel(context.Background(), objects, labels.BulkOptions{Workers: 2, DryRun: true}, labels.AddLabel("env", strPtr("prod")))
+    if err != nil || report.Count(labels.BulkStatusPlanned) != 4 || report.Count(labels.BulkStatusUnchanged) != 1 {
+        t.Fatalf("dry run: got %+v, %v", report, err)
+    }
+
+    report, err = c.Relabel(context.Background(), objects, labels.BulkOptions{Workers: 2}, labels.AddLabel("env", strPtr("prod")))
+    if err != nil || report.Err() != nil {
+        t.Fatal(err, report.Err())
+    }
+    if report.Count(labels.BulkStatusUpdated) != 4 || report.Count(labels.BulkStatusUnchanged) != 1 {
+        t.Errorf("got %d updated and %d unchanged", report.Count(labels.BulkStatusUpdated), report.Count(labels.BulkStatusUnchanged))
+    }
+    for i, item := range s.Items("/compute/servers")[:4] {
+        if env := item["labels"].(map[string]interface{})["env"]; env != "prod" {
+            t.Errorf("server %d: got env %v", i, env)
+        }
+        if report.Results[i].Object.Id != objects[i].Id {
+            t.Errorf("result %d is for %s, want the order of the objects", i, report.Results[i].Object.Id)
+        }
+    }
+
+    report, _ = c.Relabel(context.Background(), objects[:1], labels.BulkOptions{}, labels.AddLabel("owner", strPtr("ops")), labels.RenameLabel("owner", "env"))
+    if report.Count(labels.BulkStatusFailed) != 1 || report.Err() == nil {
+        t.Errorf("failing operation not reported: %+v", report.Results)
+    }
+}
+
+func TestRelabelCanceled(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    objects := seedServers(s, 6)
+    c := labels.Clients{Compute: s.ComputeClient(), Domain: s.DomainClient(), Addon: s.AddonClient()}
+
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+    opts := labels.BulkOptions{Workers: 1, OnResult: func(labels.BulkResult) { cancel() }}
+    report, err := c.Relabel(ctx, objects, opts, labels.AddLabel("env", strPtr("prod")))
+    if !errors.Is(err, context.Canceled) {
+        t.Errorf("got %v, want context.Canceled", err)
+    }
+    if len(report.Results) != len(objects) {
+        t.Fatalf("got %d results for %d objects", len(report.Results), len(objects))
+    }
+    if report.Results[0].Status != labels.BulkStatusUpdated {
+        t.Errorf("first object: got %s", report.Results[0].Status)
+    }
+    for i, result := range report.Results[1:] {
+        if result.Object.Id != objects[i+1].Id || result.Status != labels.BulkStatusFailed || !errors.Is(result.Err, context.Canceled) || len(result.Error) == 0 {
+            t.Errorf("object %d: got %+v, want a failure with context.Canceled", i+1, result)
+        }
+    }
+}
@@ -0,0 +1,359 @@
+package labels
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+    "github.com/lumaserv/lumaserv-api-go/addon"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+// ObjectType identifies the labeled resource types, using the object types of the API.
+type ObjectType string
+
+const (
+    ObjectTypeServer ObjectType = "SERVER"
+    ObjectTypeServerVolume ObjectType = "SERVER_VOLUME"
+    ObjectTypeNetwork ObjectType = "NETWORK"
+    ObjectTypeS3Bucket ObjectType = "S3_BUCKET"
+    ObjectTypeDNSZone ObjectType = "DNS_ZONE"
+    ObjectTypeDomain ObjectType = "DOMAIN"
+    ObjectTypeSSLCertificate ObjectType = "SSL_CERTIFICATE"
+    ObjectTypePleskLicense ObjectType = "PLESK_LICENSE"
+)
+
+// ObjectTypes lists all types supported by List.
+var ObjectTypes = []ObjectType{
+    ObjectTypeServer,
+    ObjectTypeServerVolume,
+    ObjectTypeNetwork,
+    ObjectTypeS3Bucket,
+    ObjectTypeDNSZone,
+    ObjectTypeDomain,
+    ObjectTypeSSLCertificate,
+    ObjectTypePleskLicense,
+}
+
+// ParseObjectType accepts the object types case insensitively and with dashes instead of
+// underscores, e.g. server-volume.
+func ParseObjectType(s string) (ObjectType, error) {
+    t := ObjectType(strings.ToUpper(strings.Replace(s, "-", "_", -1)))
+    for _, known := range ObjectTypes {
+        if t == known {
+            return t, nil
+        }
+    }
+    return "", fmt.Errorf("unknown object type %q", s)
+}
+
+// Object is a labeled resource of any type. DNS zones and domains are identified by their
+// name, which is used as Id. Resource holds the resource as returned by the API.
+type Object struct {
+    Type ObjectType `json:"type"`
+    Id string `json:"id"`
+    Name string `json:"name"`
+    ProjectId string `json:"project_id"`
+    Labels map[string]*string `json:"labels"`
+    Resource interface{} `json:"-"`
+}
+
+// Clients are the service clients List uses for the different object types.
+type Clients struct {
+    Compute compute.ComputeClient
+    Domain domain.DomainClient
+    Addon addon.AddonClient
+}
+
+// List returns the objects of the given types, or of all types if none are given, that
+// match the selector. Exact label matches are passed to the API, the rest of the selector
+// is applied to the results.
+func (c Clients) List(ctx context.Context, sel Selector, types ...ObjectType) ([]Object, error) {
+    if len(types) == 0 {
+        types = ObjectTypes
+    }
+    objects := []Object{}
+    for _, t := range types {
+        found, err := c.list(ctx, sel, t)
+        if err != nil {
+            return nil, fmt.Errorf("list %s: %w", strings.ToLower(string(t)), err)
+        }
+        objects = append(objects, found...)
+    }
+    sort.SliceStable(objects, func(i, j int) bool {
+        if objects[i].Type != objects[j].Type {
+            return objects[i].Type < objects[j].Type
+        }
+        return objects[i].Name < objects[j].Name
+    })
+    return objects, nil
+}
+
+func (c Clients) list(ctx context.Context, sel Selector, t ObjectType) ([]Object, error) {
+    objects := []Object{}
+    switch t {
+        case ObjectTypeServer:
+            servers, err := ListServers(ctx, c.Compute, sel)
+            for _, s := range servers {
+                objects = append(objects, serverObject(s))
+            }
+            return objects, err
+        case ObjectTypeServerVolume:
+            volumes, err := ListServerVolumes(ctx, c.Compute, sel)
+            for _, v := range volumes {
+                objects = append(objects, serverVolumeObject(v))
+            }
+            return objects, err
+        case ObjectTypeNetwork:
+            networks, err := ListNetworks(ctx, c.Compute, sel)
+            for _, n := range networks {
+                objects = append(objects, networkObject(n))
+            }
+            return objects, err
+        case ObjectTypeS3Bucket:
+            buckets, err := ListS3Buckets(ctx, c.Compute, sel)
+            for _, b := range buckets {
+                objects = append(objects, s3BucketObject(b))
+            }
+            return objects, err
+        case ObjectTypeDNSZone:
+            zones, err := ListDNSZones(ctx, c.Domain, sel)
+            for _, z := range zones {
+                objects = append(objects, dnsZoneObject(z))
+            }
+            return objects, err
+        case ObjectTypeDomain:
+            domains, err := ListDomains(ctx, c.Domain, sel)
+            for _, d := range domains {
+                objects = append(objects, domainObject(d))
+            }
+            return objects, err
+        case ObjectTypeSSLCertificate:
+            certificates, err := ListSSLCertificates(ctx, c.Addon, sel)
+            for _, s := range certificates {
+                objects = append(objects, sslCertificateObject(s))
+            }
+            return objects, err
+        case ObjectTypePleskLicense:
+            licenses, err := ListPleskLicenses(ctx, c.Addon, sel)
+            for _, l := range licenses {
+                objects = append(objects, pleskLicenseObject(l))
+            }
+            return objects, err
+    }
+    return nil, fmt.Errorf("unsupported object type %q", t)
+}
+
+// Get fetches a single object. DNS zones and domains are identified by their name.
+func (c Clients) Get(ctx context.Context, t ObjectType, id string) (Object, error) {
+    switch t {
+        case ObjectTypeServer:
+            res, _, err := c.Compute.GetServerWithContext(ctx, id)
+            return serverObject(res.Data), err
+        case ObjectTypeServerVolume:
+            res, _, err := c.Compute.GetServerVolumeWithContext(ctx, id)
+            return serverVolumeObject(res.Data), err
+        case ObjectTypeNetwork:
+            res, _, err := c.Compute.GetNetworkWithContext(ctx, id)
+            return networkObject(res.Data), err
+        case ObjectTypeS3Bucket:
+            res, _, err := c.Compute.GetS3BucketWithContext(ctx, id)
+            return s3BucketObject(res.Data), err
+        case ObjectTypeDNSZone:
+            res, _, err := c.Domain.GetDNSZoneWithContext(ctx, id)
+            return dnsZoneObject(res.Data), err
+        case ObjectTypeDomain:
+            res, _, err := c.Domain.GetDomainWithContext(ctx, id)
+            return domainObject(res.Data), err
+        case ObjectTypeSSLCertificate:
+            res, _, err := c.Addon.GetSSLCertificateWithContext(ctx, id)
+            return sslCertificateObject(res.Data), err
+        case ObjectTypePleskLicense:
+            res, _, err := c.Addon.GetPleskLicenseWithContext(ctx, id)
+            return pleskLicenseObject(res.Data), err
+    }
+    return Object{}, fmt.Errorf("unsupported object type %q", t)
+}
+
+func serverObject(s compute.Server) Object {
+    return Object{Type: ObjectTypeServer, Id: s.Id, Name: s.Name, ProjectId: s.ProjectId, Labels: s.Labels, Resource: s}
+}
+
+func serverVolumeObject(v compute.ServerVolume) Object {
+    return Object{Type: ObjectTypeServerVolume, Id: v.Id, Name: v.Title, ProjectId: v.ProjectId, Labels: v.Labels, Resource: v}
+}
+
+func networkObject(n compute.Network) Object {
+    return Object{Type: ObjectTypeNetwork, Id: n.Id, Name: n.Title, ProjectId: n.ProjectId, Labels: n.Labels, Resource: n}
+}
+
+func s3BucketObject(b compute.S3Bucket) Object {
+    return Object{Type: ObjectTypeS3Bucket, Id: b.Id, Name: b.Title, ProjectId: b.ProjectId, Labels: b.Labels, Resource: b}
+}
+
+func dnsZoneObject(z domain.DNSZone) Object {
+    return Object{Type: ObjectTypeDNSZone, Id: z.Name, Name: z.Name, ProjectId: z.ProjectId, Labels: z.Labels, Resource: z}
+}
+
+func domainObject(d domain.Domain) Object {
+    return Object{Type: ObjectTypeDomain, Id: d.Name, Name: d.Name, ProjectId: d.ProjectId, Labels: d.Labels, Resource: d}
+}
+
+func sslCertificateObject(s addon.SSLCertificate) Object {
+    return Object{Type: ObjectTypeSSLCertificate, Id: s.Id, Name: s.Id, ProjectId: s.ProjectId, Labels: s.Labels, Resource: s}
+}
+
+func pleskLicenseObject(l addon.PleskLicense) Object {
+    return Object{Type: ObjectTypePleskLicense, Id: l.Id, Name: l.Id, ProjectId: l.ProjectId, Labels: l.Labels, Resource: l}
+}
+
+func ListServers(ctx context.Context, c compute.ComputeClient, sel Selector) ([]compute.Server, error) {
+    withLabels := true
+    qParams := compute.GetServersQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &compute.GetServersQueryParamsFilter{Labels: filter}
+    }
+    servers, err := c.ListAllServers(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []compute.Server{}
+    for _, s := range servers {
+        if sel.Matches(s.Labels) {
+            matched = append(matched, s)
+        }
+    }
+    return matched, nil
+}
+
+func ListServerVolumes(ctx context.Context, c compute.ComputeClient, sel Selector) ([]compute.ServerVolume, error) {
+    withLabels := true
+    qParams := compute.GetServerVolumesQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &compute.GetServerVolumesQueryParamsFilter{Labels: filter}
+    }
+    volumes, err := c.ListAllServerVolumes(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []compute.ServerVolume{}
+    for _, v := range volumes {
+        if sel.Matches(v.Labels) {
+            matched = append(matched, v)
+        }
+    }
+    return matched, nil
+}
+
+func ListNetworks(ctx context.Context, c compute.ComputeClient, sel Selector) ([]compute.Network, error) {
+    withLabels := true
+    qParams := compute.GetNetworksQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &compute.GetNetworksQueryParamsFilter{Labels: filter}
+    }
+    networks, err := c.ListAllNetworks(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []compute.Network{}
+    for _, n := range networks {
+        if sel.Matches(n.Labels) {
+            matched = append(matched, n)
+        }
+    }
+    return matched, nil
+}
+
+func ListS3Buckets(ctx context.Context, c compute.ComputeClient, sel Selector) ([]compute.S3Bucket, error) {
+    withLabels := true
+    qParams := compute.GetS3BucketsQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &compute.GetS3BucketsQueryParamsFilter{Labels: filter}
+    }
+    buckets, err := c.ListAllS3Buckets(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []compute.S3Bucket{}
+    for _, b := range buckets {
+        if sel.Matches(b.Labels) {
+            matched = append(matched, b)
+        }
+    }
+    return matched, nil
+}
+
+func ListDNSZones(ctx context.Context, c domain.DomainClient, sel Selector) ([]domain.DNSZone, error) {
+    withLabels := true
+    qParams := domain.GetDNSZonesQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &domain.GetDNSZonesQueryParamsFilter{Labels: filter}
+    }
+    zones, err := c.ListAllDNSZones(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []domain.DNSZone{}
+    for _, z := range zones {
+        if sel.Matches(z.Labels) {
+            matched = append(matched, z)
+        }
+    }
+    return matched, nil
+}
+
+func ListDomains(ctx context.Context, c domain.DomainClient, sel Selector) ([]domain.Domain, error) {
+    withLabels := true
+    qParams := domain.GetDomainsQueryParams{WithLabels: &withLabels}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &domain.GetDomainsQueryParamsFilter{Labels: filter}
+    }
+    domains, err := c.ListAllDomains(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []domain.Domain{}
+    for _, d := range domains {
+        if sel.Matches(d.Labels) {
+            matched = append(matched, d)
+        }
+    }
+    return matched, nil
+}
+
+func ListSSLCertificates(ctx context.Context, c addon.AddonClient, sel Selector) ([]addon.SSLCertificate, error) {
+    qParams := addon.GetSSLCertificatesQueryParams{}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &addon.GetSSLCertificatesQueryParamsFilter{Labels: filter}
+    }
+    certificates, err := c.ListAllSSLCertificates(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []addon.SSLCertificate{}
+    for _, s := range certificates {
+        if sel.Matches(s.Labels) {
+            matched = append(matched, s)
+        }
+    }
+    return matched, nil
+}
+
+func ListPleskLicenses(ctx context.Context, c addon.AddonClient, sel Selector) ([]addon.PleskLicense, error) {
+    qParams := addon.GetPleskLicensesQueryParams{}
+    if filter := sel.Filter(); filter != nil {
+        qParams.Filter = &addon.GetPleskLicensesQueryParamsFilter{Labels: filter}
+    }
+    licenses, err := c.ListAllPleskLicenses(ctx, qParams).All()
+    if err != nil {
+        return nil, err
+    }
+    matched := []addon.PleskLicense{}
+    for _, l := range licenses {
+        if sel.Matches(l.Labels) {
+            matched = append(matched, l)
+        }
+    }
+    return matched, nil
+}
@@ -0,0 +1,296 @@
+package labels
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+)
+
+type Operator string
+
+const (
+    OperatorEquals Operator = "="
+    OperatorNotEquals Operator = "!="
+    OperatorIn Operator = "in"
+    OperatorNotIn Operator = "notin"
+    OperatorExists Operator = "exists"
+    OperatorDoesNotExist Operator = "!"
+)
+
+// Requirement is a single condition of a selector. Values holds one value for the
+// equality operators and none for the existence operators.
+type Requirement struct {
+    Key string `json:"key"`
+    Operator Operator `json:"operator"`
+    Values []string `json:"values,omitempty"`
+}
+
+// Selector is a conjunction of requirements, the empty selector matches everything.
+type Selector []Requirement
+
+// SelectorError describes a syntax error at the byte offset Pos of the selector.
+type SelectorError struct {
+    Selector string
+    Pos int
+    Msg string
+}
+
+func (e *SelectorError) Error() string {
+    return fmt.Sprintf("label selector %q: position %d: %s", e.Selector, e.Pos, e.Msg)
+}
+
+// Parse parses a selector in the Kubernetes syntax, e.g. env=prod,tier!=db,team in (a,b),!legacy.
+// Supported are key=value, key==value, key!=value, key in (...), key notin (...), key and !key.
+func Parse(selector string) (Selector, error) {
+    p := selectorParser{input: selector}
+    sel := Selector{}
+    if len(strings.TrimSpace(selector)) == 0 {
+        return sel, nil
+    }
+    for {
+        r, err := p.requirement()
+        if err != nil {
+            return nil, err
+        }
+        sel = append(sel, r)
+        tok, pos := p.next()
+        if len(tok) == 0 {
+            return sel, nil
+        }
+        if tok != "," {
+            return nil, p.errorf(pos, "expected , but found %q", tok)
+        }
+    }
+}
+
+// MustParse is like Parse but panics on errors, for selectors known at compile time.
+func MustParse(selector string) Selector {
+    sel, err := Parse(selector)
+    if err != nil {
+        panic(err)
+    }
+    return sel
+}
+
+// Matches reports whether the labels satisfy all requirements. A label without value is
+// treated like the empty value.
+func (s Selector) Matches(labels map[string]*string) bool {
+    for _, r := range s {
+        if !r.Matches(labels) {
+            return false
+        }
+    }
+    return true
+}
+
+func (r Requirement) Matches(labels map[string]*string) bool {
+    value, ok := labels[r.Key]
+    v := ""
+    if value != nil {
+        v = *value
+    }
+    switch r.Operator {
+        case OperatorExists:
+            return ok
+        case OperatorDoesNotExist:
+            return !ok
+        case OperatorEquals, OperatorIn:
+            return ok && containsString(r.Values, v)
+        case OperatorNotEquals, OperatorNotIn:
+            return !ok || !containsString(r.Values, v)
+    }
+    return false
+}
+
+func (s Selector) Empty() bool {
+    return len(s) == 0
+}
+
+func (s Selector) String() string {
+    parts := []string{}
+    for _, r := range s {
+        parts = append(parts, r.String())
+    }
+    return strings.Join(parts, ",")
+}
+
+func (r Requirement) String() string {
+    switch r.Operator {
+        case OperatorExists:
+            return r.Key
+        case OperatorDoesNotExist:
+            return "!" + r.Key
+        case OperatorIn, OperatorNotIn:
+            return r.Key + " " + string(r.Operator) + " (" + strings.Join(r.Values, ",") + ")"
+    }
+    return r.Key + string(r.Operator) + strings.Join(r.Values, "")
+}
+
+// Filter returns the requirements that can be passed as labels filter of list requests,
+// which only support exact matches. The remaining requirements still have to be checked
+// with Matches.
+func (s Selector) Filter() map[string]*string {
+    filter := map[string]*string{}
+    for _, r := range s {
+        if (r.Operator == OperatorEquals || r.Operator == OperatorIn) && len(r.Values) == 1 && len(r.Values[0]) > 0 {
+            if _, ok := filter[r.Key]; ok {
+                // Conflicting requirements on the same key are left to Matches.
+                continue
+            }
+            value := r.Values[0]
+            filter[r.Key] = &value
+        }
+    }
+    if len(filter) == 0 {
+        return nil
+    }
+    return filter
+}
+
+// Keys returns the distinct label keys used by the selector.
+func (s Selector) Keys() []string {
+    keys := []string{}
+    for _, r := range s {
+        if !containsString(keys, r.Key) {
+            keys = append(keys, r.Key)
+        }
+    }
+    sort.Strings(keys)
+    return keys
+}
+
+func containsString(values []string, value string) bool {
+    for _, v := range values {
+        if v == value {
+            return true
+        }
+    }
+    return false
+}
+
+type selectorParser struct {
+    input string
+    pos int
+}
+
+func (p *selectorParser) errorf(pos int, format string, args ...interface{}) error {
+    return &SelectorError{Selector: p.input, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func isSelectorSpecial(c byte) bool {
+    return c == ',' || c == '(' || c == ')' || c == '!' || c == '=' || c == ' ' || c == '\t'
+}
+
+// next returns the next token and its position, an empty token marks the end.
+func (p *selectorParser) next() (string, int) {
+    for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+        p.pos++
+    }
+    start := p.pos
+    if p.pos >= len(p.input) {
+        return "", start
+    }
+    c := p.input[p.pos]
+    switch {
+        case c == '!' && p.pos+1 < len(p.input) && p.input[p.pos+1] == '=':
+            p.pos += 2
+        case c == '=' && p.pos+1 < len(p.input) && p.input[p.pos+1] == '=':
+            p.pos += 2
+            return "=", start
+        case isSelectorSpecial(c):
+            p.pos++
+        default:
+            for p.pos < len(p.input) && !isSelectorSpecial(p.input[p.pos]) {
+                p.pos++
+            }
+    }
+    return p.input[start:p.pos], start
+}
+
+func (p *selectorParser) peek() string {
+    pos := p.pos
+    tok, _ := p.next()
+    p.pos = pos
+    return tok
+}
+
+func (p *selectorParser) key() (string, error) {
+    tok, pos := p.next()
+    if len(tok) == 0 || isSelectorSpecial(tok[0]) {
+        if len(tok) == 0 {
+            return "", p.errorf(pos, "expected label key")
+        }
+        return "", p.errorf(pos, "expected label key but found %q", tok)
+    }
+    if err := validateKey(tok); err != nil {
+        return "", p.errorf(pos, "%v", err)
+    }
+    return tok, nil
+}
+
+func (p *selectorParser) requirement() (Requirement, error) {
+    if p.peek() == "!" {
+        p.next()
+        key, err := p.key()
+        return Requirement{Key: key, Operator: OperatorDoesNotExist}, err
+    }
+    key, err := p.key()
+    if err != nil {
+        return Requirement{}, err
+    }
+    switch op := p.peek(); op {
+        case "", ",":
+            return Requirement{Key: key, Operator: OperatorExists}, nil
+        case "=", "!=":
+            p.next()
+            value := ""
+            if tok := p.peek(); len(tok) > 0 && !isSelectorSpecial(tok[0]) {
+                value, _ = p.next()
+            }
+            return Requirement{Key: key, Operator: Operator(op), Values: []string{value}}, nil
+        case "in", "notin":
+            p.next()
+            values, err := p.values()
+            return Requirement{Key: key, Operator: Operator(op), Values: values}, err
+        default:
+            _, pos := p.next()
+            return Requirement{}, p.errorf(pos, "expected operator but found %q", op)
+    }
+}
+
+func (p *selectorParser) values() ([]string, error) {
+    if tok, pos := p.next(); tok != "(" {
+        return nil, p.errorf(pos, "expected ( but found %q", tok)
+    }
+    values := []string{}
+    for {
+        tok, pos := p.next()
+        switch {
+            case len(tok) == 0:
+                return nil, p.errorf(pos, "expected )")
+            case tok == ")" && len(values) == 0:
+                return nil, p.errorf(pos, "empty value list")
+            case isSelectorSpecial(tok[0]):
+                return nil, p.errorf(pos, "expected value but found %q", tok)
+        }
+        values = append(values, tok)
+        tok, pos = p.next()
+        if tok == ")" {
+            return values, nil
+        }
+        if tok != "," {
+            return nil, p.errorf(pos, "expected , or ) but found %q", tok)
+        }
+    }
+}
+
+// validateKey checks a label key for characters that can not be used in selectors.
+func validateKey(key string) error {
+    for i := 0; i < len(key); i++ {
+        c := key[i]
+        if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == '/' || c == ':') {
+            return fmt.Errorf("invalid character %q in label key %q", c, key)
+        }
+    }
+    return nil
+}
@@ -0,0 +1,171 @@
+package labels_test
+
+import (
+    "context"
+    "errors"
+    "reflect"
+    "strings"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/labels"
+    "github.com/lumaserv/lumaserv-api-go/lumaservtest"
+)
+
+func strPtr(s string) *string {
+    return &s
+}
+
+func TestParse(t *testing.T) {
+    tests := []struct {
+        selector string
+        want labels.Selector
+        canonical string
+    }{
+        {"", labels.Selector{}, ""},
+        {"env=prod", labels.Selector{{Key: "env", Operator: labels.OperatorEquals, Values: []string{"prod"}}}, "env=prod"},
+        {"env==prod", labels.Selector{{Key: "env", Operator: labels.OperatorEquals, Values: []string{"prod"}}}, "env=prod"},
+        {"env=", labels.Selector{{Key: "env", Operator: labels.OperatorEquals, Values: []string{""}}}, "env="},
+        {
+            "env=prod, tier!=db,team in (a, b),!legacy",
+            labels.Selector{
+                {Key: "env", Operator: labels.OperatorEquals, Values: []string{"prod"}},
+                {Key: "tier", Operator: labels.OperatorNotEquals, Values: []string{"db"}},
+                {Key: "team", Operator: labels.OperatorIn, Values: []string{"a", "b"}},
+                {Key: "legacy", Operator: labels.OperatorDoesNotExist},
+            },
+            "env=prod,tier!=db,team in (a,b),!legacy",
+        },
+        {
+            "example.com/owner,zone notin (fra1)",
+            labels.Selector{
+                {Key: "example.com/owner", Operator: labels.OperatorExists},
+                {Key: "zone", Operator: labels.OperatorNotIn, Values: []string{"fra1"}},
+            },
+            "example.com/owner,zone notin (fra1)",
+        },
+    }
+    for _, tt := range tests {
+        sel, err := labels.Parse(tt.selector)
+        if err != nil {
+            t.Errorf("%q: %v", tt.selector, err)
+            continue
+        }
+        if !reflect.DeepEqual(sel, tt.want) {
+            t.Errorf("%q: got %+v, want %+v", tt.selector, sel, tt.want)
+        }
+        if sel.String() != tt.canonical {
+            t.Errorf("%q: got string %q, want %q", tt.selector, sel.String(), tt.canonical)
+        }
+        if again, err := labels.Parse(sel.String()); err != nil || !reflect.DeepEqual(again, sel) {
+            t.Errorf("%q: string does not parse to the same selector: %+v, %v", tt.selector, again, err)
+        }
+    }
+}
+
+func TestParseErrors(t *testing.T) {
+    tests := []struct {
+        selector string
+        pos int
+        msg string
+    }{
+        {"env=prod,", 9, "expected label key"},
+        {",env", 0, "expected label key but found \",\""},
+        {"env prod", 4, "expected operator"},
+        {"team in a,b", 8, "expected ( but found \"a\""},
+        {"team in ()", 9, "empty value list"},
+        {"team in (a,b", 12, "expected , or )"},
+        {"team in (a b)", 11, "expected , or )"},
+        {"env=prod tier=db", 9, "expected , but found \"tier\""},
+        {"e$nv=prod", 0, "invalid character '$'"},
+        {"!", 1, "expected label key"},
+    }
+    for _, tt := range tests {
+        _, err := labels.Parse(tt.selector)
+        selErr := &labels.SelectorError{}
+        if !errors.As(err, &selErr) {
+            t.Errorf("%q: got %v, want a SelectorError", tt.selector, err)
+            continue
+        }
+        if selErr.Pos != tt.pos || !strings.Contains(selErr.Msg, tt.msg) {
+            t.Errorf("%q: got %q at %d, want %q at %d", tt.selector, selErr.Msg, selErr.Pos, tt.msg, tt.pos)
+        }
+    }
+}
+
+func TestMatches(t *testing.T) {
+    objectLabels := map[string]*string{"env": strPtr("prod"), "team": strPtr("a"), "flag": nil}
+    tests := []struct {
+        selector string
+        matches bool
+    }{
+        {"", true},
+        {"env=prod", true},
+        {"env=dev", false},
+        {"env!=dev", true},
+        {"missing!=x", true},
+        {"team in (a,b)", true},
+        {"team notin (a,b)", false},
+        {"missing notin (a)", true},
+        {"flag", true},
+        {"flag=", true},
+        {"!flag", false},
+        {"!legacy,env=prod", true},
+        {"env=prod,team=b", false},
+    }
+    for _, tt := range tests {
+        if got := labels.MustParse(tt.selector).Matches(objectLabels); got != tt.matches {
+            t.Errorf("%q: got %v, want %v", tt.selector, got, tt.matches)
+        }
+    }
+}
+
+func TestFilterAndKeys(t *testing.T) {
+    sel := labels.MustParse("env=prod,env=dev,team in (a),tier in (x,y),owner!=me,role=,legacy")
+    filter := sel.Filter()
+    if len(filter) != 2 || *filter["env"] != "prod" || *filter["team"] != "a" {
+        t.Errorf("got filter %v", filter)
+    }
+    if filter := labels.MustParse("!legacy").Filter(); filter != nil {
+        t.Errorf("got filter %v for selector without exact matches", filter)
+    }
+    if keys := sel.Keys(); !reflect.DeepEqual(keys, []string{"env", "legacy", "owner", "role", "team", "tier"}) {
+        t.Errorf("got keys %v", keys)
+    }
+}
+
+func TestList(t *testing.T) {
+    s := lumaservtest.NewServer()
+    defer s.Close()
+    s.Seed("/compute/servers",
+        map[string]interface{}{"id": "s1", "name": "web-1", "labels": map[string]string{"env": "prod", "team": "a"}},
+        map[string]interface{}{"id": "s2", "name": "web-2", "labels": map[string]string{"env": "prod", "team": "b", "legacy": ""}},
+        map[string]interface{}{"id": "s3", "name": "dev-1", "labels": map[string]string{"env": "dev", "team": "a"}},
+    )
+    s.Seed("/domain/dns/zones",
+        map[string]interface{}{"name": "example.com", "labels": map[string]string{"env": "prod", "team": "a"}},
+        map[string]interface{}{"name": "example.org", "labels": map[string]string{"env": "dev"}},
+    )
+    c := labels.Clients{Compute: s.ComputeClient(), Domain: s.DomainClient(), Addon: s.AddonClient()}
+
+    objects, err := c.List(context.Background(), labels.MustParse("env=prod,team in (a,b),!legacy"), labels.ObjectTypeServer, labels.ObjectTypeDNSZone)
+    if err != nil {
+        t.Fatal(err)
+    }
+    names := []string{}
+    for _, o := range objects {
+        names = append(names, string(o.Type)+" "+o.Name)
+    }
+    if want := []string{"DNS_ZONE example.com", "SERVER web-1"}; !reflect.DeepEqual(names, want) {
+        t.Errorf("got %v, want %v", names, want)
+    }
+
+    // The exact match is passed to the API, the rest is applied to the results.
+    for _, r := range s.Requests() {
+        if !strings.Contains(r.Query, "filter%5Blabels%5D%5Benv%5D=prod") && !strings.Contains(r.Query, "filter[labels][env]=prod") {
+            t.Errorf("%s %s: label filter not passed: %q", r.Method, r.Path, r.Query)
+        }
+    }
+
+    if _, err := c.List(context.Background(), labels.Selector{}, "UNKNOWN"); err == nil {
+        t.Errorf("unknown object type accepted")
+    }
+}
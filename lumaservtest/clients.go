@@ -0,0 +1,29 @@
+package lumaservtest
+
+import (
+    "github.com/lumaserv/lumaserv-api-go/addon"
+    "github.com/lumaserv/lumaserv-api-go/auth"
+    "github.com/lumaserv/lumaserv-api-go/billing"
+    "github.com/lumaserv/lumaserv-api-go/compute"
+    "github.com/lumaserv/lumaserv-api-go/domain"
+)
+
+func (s *Server) AddonClient() addon.AddonClient {
+    return addon.NewClientWithUrl(s.Token, s.ServiceUrl("addon"))
+}
+
+func (s *Server) AuthClient() auth.AuthClient {
+    return auth.NewClientWithUrl(s.Token, s.ServiceUrl("auth"))
+}
+
+func (s *Server) BillingClient() billing.BillingClient {
+    return billing.NewClientWithUrl(s.Token, s.ServiceUrl("billing"))
+}
+
+func (s *Server) ComputeClient() compute.ComputeClient {
+    return compute.NewClientWithUrl(s.Token, s.ServiceUrl("compute"))
+}
+
+func (s *Server) DomainClient() domain.DomainClient {
+    return domain.NewClientWithUrl(s.Token, s.ServiceUrl("domain"))
+}
@@ -0,0 +1,55 @@
+package lumaservtest
+
+import (
+    "net/http"
+    "strings"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+// Fault makes the fake server misbehave for matching requests.
+type Fault struct {
+    // Method restricts the fault to one HTTP method. Empty matches all methods.
+    Method string
+    // Path is matched as a prefix of the request path, e.g. "/compute/servers".
+    Path string
+    // StatusCode is returned along with Errors in a failed response envelope. If zero,
+    // the request is processed normally after Latency.
+    StatusCode int
+    Errors []core.ResponseMessage
+    Header http.Header
+    Latency time.Duration
+    // Count limits the fault to the given number of requests. Zero means unlimited.
+    Count int
+}
+
+func (s *Server) InjectFault(f Fault) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.faults = append(s.faults, &f)
+}
+
+func (s *Server) ClearFaults() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.faults = nil
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+    for i, f := range s.faults {
+        if len(f.Method) > 0 && f.Method != r.Method {
+            continue
+        }
+        if !strings.HasPrefix(r.URL.Path, f.Path) {
+            continue
+        }
+        if f.Count > 0 {
+            f.Count--
+            if f.Count == 0 {
+                s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+            }
+        }
+        return f
+    }
+    return nil
+}
@@ -0,0 +1,105 @@
+package lumaservtest
+
+import (
+    "net/http"
+    "strings"
+    "time"
+)
+
+type handlerFunc func(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte)
+
+// handlers replace the generic handling of routes with side effects the collections can
+// not express, keyed by method and pattern. They are called with the server locked.
+var handlers = map[string]handlerFunc{
+    "POST /compute/servers": createServer,
+    "POST /compute/servers/{id}/start": setServerState("RUNNING"),
+    "POST /compute/servers/{id}/restart": setServerState("RUNNING"),
+    "POST /compute/servers/{id}/stop": setServerState("STOPPED"),
+    "POST /compute/servers/{id}/shutdown": setServerState("STOPPED"),
+    "POST /compute/server-firewalls/{id}/rules": createApplied,
+    "POST /compute/server-firewalls/{id}/members": createApplied,
+    "POST /domain/domains": createDomain,
+    "POST /domain/domain-handles": createHandle,
+    "GET /domain/domains/{name}/check": checkDomain,
+    "GET /domain/domains/{name}/authinfo": getAuthinfo,
+    "DELETE /domain/domains/{name}/authinfo": deleteAuthinfo,
+}
+
+// createServer creates servers in state RUNNING unless the request sets a state.
+func createServer(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    s.create(w, r, body, func(item map[string]interface{}) {
+        if _, ok := item["state"]; !ok {
+            item["state"] = "RUNNING"
+        }
+    })
+}
+
+// setServerState moves the server into the state the action ends in, then handles the
+// action as usual.
+func setServerState(state string) handlerFunc {
+    return func(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+        if item, _ := s.actionItem(r, params); item != nil {
+            item["state"] = state
+        }
+        s.action(w, r, rt, params, body)
+    }
+}
+
+// createApplied creates firewall rules and members, which are applied to the servers
+// immediately.
+func createApplied(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    s.create(w, r, body, func(item map[string]interface{}) {
+        item["applied"] = true
+    })
+}
+
+// createDomain registers domains as PENDING, or as TRANSFER_PENDING if the request
+// carries an authinfo for a transfer-in.
+func createDomain(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    s.create(w, r, body, func(item map[string]interface{}) {
+        item["status"] = "PENDING"
+        if authinfo, ok := item["authinfo"].(string); ok && len(authinfo) > 0 {
+            item["status"] = "TRANSFER_PENDING"
+        }
+        delete(item, "authinfo")
+    })
+}
+
+// createHandle generates the code of created domain handles, like the API.
+func createHandle(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    s.create(w, r, body, func(item map[string]interface{}) {
+        item["code"] = "FAKE-" + strings.ToUpper(strings.TrimPrefix(item["id"].(string), "fake-"))
+    })
+}
+
+// checkDomain reports seeded domains as registered and any other name as available.
+func checkDomain(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    item, _ := s.actionItem(r, params)
+    s.respond(w, http.StatusOK, map[string]interface{}{"available": item == nil}, nil)
+}
+
+// getAuthinfo creates an authinfo valid for 30 days on first use.
+func getAuthinfo(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    item, key := s.actionItem(r, params)
+    if item == nil {
+        s.notFound(w)
+        return
+    }
+    if _, ok := item["authinfo"]; !ok {
+        item["authinfo"] = map[string]interface{}{
+            "authinfo": "fake-authinfo-" + key,
+            "valid_until": time.Now().UTC().Add(time.Hour * 24 * 30).Format(time.RFC3339),
+        }
+    }
+    s.respond(w, http.StatusOK, item["authinfo"], nil)
+}
+
+func deleteAuthinfo(s *Server, w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    item, _ := s.actionItem(r, params)
+    if item == nil {
+        s.notFound(w)
+        return
+    }
+    delete(item, "authinfo")
+    s.respond(w, http.StatusOK, nil, nil)
+}
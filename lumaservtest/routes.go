@@ -0,0 +1,249 @@
+package lumaservtest
+
+var routes = []route{
+    {"POST", "/addon/ssl/certificates", routeCreate},
+    {"GET", "/addon/ssl/certificates", routeList},
+    {"GET", "/addon/license/plesk-types", routeList},
+    {"GET", "/addon/search", routeAction},
+    {"GET", "/addon/ssl/certificates/{id}", routeGet},
+    {"GET", "/addon/ssl/organisations/{id}", routeGet},
+    {"DELETE", "/addon/ssl/organisations/{id}", routeDelete},
+    {"POST", "/addon/ssl/contacts", routeCreate},
+    {"GET", "/addon/ssl/contacts", routeList},
+    {"POST", "/addon/ssl/organisations", routeCreate},
+    {"GET", "/addon/ssl/organisations", routeList},
+    {"GET", "/addon/ssl/types/{id}", routeGet},
+    {"GET", "/addon/ssl/contacts/{id}", routeGet},
+    {"DELETE", "/addon/ssl/contacts/{id}", routeDelete},
+    {"POST", "/addon/licenses/plesk", routeCreate},
+    {"GET", "/addon/licenses/plesk", routeList},
+    {"GET", "/addon/ssl/types", routeList},
+    {"GET", "/addon/licenses/plesk/{id}", routeGet},
+    {"PUT", "/addon/licenses/plesk/{id}", routeUpdate},
+    {"GET", "/addon/license/plesk-types/{id}", routeGet},
+    {"POST", "/auth/projects", routeCreate},
+    {"GET", "/auth/projects", routeList},
+    {"GET", "/auth/projects/{id}", routeGet},
+    {"DELETE", "/auth/projects/{id}", routeDelete},
+    {"PUT", "/auth/projects/{id}", routeUpdate},
+    {"POST", "/auth/login", routeAction},
+    {"POST", "/auth/users", routeCreate},
+    {"GET", "/auth/users", routeList},
+    {"GET", "/auth/users/{id}", routeGet},
+    {"PUT", "/auth/users/{id}", routeUpdate},
+    {"POST", "/auth/password-reset", routeAction},
+    {"PUT", "/auth/password-reset", routeAction},
+    {"PUT", "/auth/email-change", routeAction},
+    {"POST", "/auth/project-invites/{id}/reject", routeAction},
+    {"POST", "/auth/audit-log", routeCreate},
+    {"GET", "/auth/audit-log", routeList},
+    {"POST", "/auth/tokens", routeCreate},
+    {"GET", "/auth/tokens", routeList},
+    {"GET", "/auth/countries/{code}", routeGet},
+    {"PUT", "/auth/password-change", routeAction},
+    {"GET", "/auth/tokens/{id}", routeGet},
+    {"DELETE", "/auth/tokens/{id}", routeDelete},
+    {"DELETE", "/auth/project-invites/{id}", routeDelete},
+    {"GET", "/auth/validate/{token}", routeAction},
+    {"POST", "/auth/project-invites", routeCreate},
+    {"GET", "/auth/project-invites", routeList},
+    {"POST", "/auth/projects/{id}/members", routeCreate},
+    {"GET", "/auth/projects/{id}/members", routeList},
+    {"POST", "/auth/transaction-log", routeAction},
+    {"GET", "/auth/validate/self", routeAction},
+    {"POST", "/auth/project-invites/{id}/accept", routeAction},
+    {"DELETE", "/auth/projects/{id}/members/{user_id}", routeDelete},
+    {"GET", "/auth/users/{id}/project_memberships", routeList},
+    {"GET", "/auth/countries", routeList},
+    {"POST", "/billing/debit-mandates", routeCreate},
+    {"GET", "/billing/debit-mandates", routeList},
+    {"GET", "/billing/invoices/{id}/file", routeFile},
+    {"POST", "/billing/invoices/{id}/positions", routeCreate},
+    {"GET", "/billing/invoices/{id}/positions", routeList},
+    {"GET", "/billing/billing-positions/{id}", routeGet},
+    {"DELETE", "/billing/billing-positions/{id}", routeDelete},
+    {"PUT", "/billing/billing-positions/{id}", routeUpdate},
+    {"GET", "/billing/debit-mandates/{id}", routeGet},
+    {"POST", "/billing/billing-positions", routeCreate},
+    {"GET", "/billing/billing-positions", routeList},
+    {"POST", "/billing/customers", routeCreate},
+    {"GET", "/billing/customers", routeList},
+    {"GET", "/billing/invoices/{invoice_id}/positions/{id}", routeGet},
+    {"DELETE", "/billing/invoices/{invoice_id}/positions/{id}", routeDelete},
+    {"PUT", "/billing/invoices/{invoice_id}/positions/{id}", routeUpdate},
+    {"POST", "/billing/service-contracts", routeCreate},
+    {"GET", "/billing/service-contracts", routeList},
+    {"GET", "/billing/debits", routeList},
+    {"GET", "/billing/customers/{id}", routeGet},
+    {"PUT", "/billing/customers/{id}", routeUpdate},
+    {"GET", "/billing/invoices/{id}", routeGet},
+    {"DELETE", "/billing/invoices/{id}", routeDelete},
+    {"PUT", "/billing/invoices/{id}", routeUpdate},
+    {"GET", "/billing/service-contracts/{contract_id}/positions/{id}", routeGet},
+    {"DELETE", "/billing/service-contracts/{contract_id}/positions/{id}", routeDelete},
+    {"PUT", "/billing/service-contracts/{contract_id}/positions/{id}", routeUpdate},
+    {"POST", "/billing/invoices", routeCreate},
+    {"GET", "/billing/invoices", routeList},
+    {"GET", "/billing/debits/{id}", routeGet},
+    {"POST", "/billing/service-contracts/{contract_id}/positions", routeCreate},
+    {"GET", "/billing/service-contracts/{contract_id}/positions", routeList},
+    {"GET", "/billing/service-contracts/{id}", routeGet},
+    {"DELETE", "/billing/service-contracts/{id}", routeDelete},
+    {"PUT", "/billing/service-contracts/{id}", routeUpdate},
+    {"POST", "/compute/ssh-keys", routeCreate},
+    {"GET", "/compute/ssh-keys", routeList},
+    {"POST", "/compute/server-price-ranges", routeCreate},
+    {"GET", "/compute/server-price-ranges", routeList},
+    {"POST", "/compute/servers/{id}/start", routeAction},
+    {"POST", "/compute/availability-zones", routeCreate},
+    {"GET", "/compute/availability-zones", routeList},
+    {"GET", "/compute/server-templates/{id}", routeGet},
+    {"POST", "/compute/servers/{id}/shutdown", routeAction},
+    {"GET", "/compute/server-firewalls/{id}", routeGet},
+    {"DELETE", "/compute/server-firewalls/{id}", routeDelete},
+    {"GET", "/compute/servers/{id}", routeGet},
+    {"DELETE", "/compute/servers/{id}", routeDelete},
+    {"PUT", "/compute/servers/{id}", routeUpdate},
+    {"GET", "/compute/server-actions", routeList},
+    {"GET", "/compute/server-storage-classes/{id}", routeGet},
+    {"POST", "/compute/servers/{id}/restart", routeServerAction},
+    {"POST", "/compute/servers/{id}/mount", routeParent},
+    {"DELETE", "/compute/servers/{id}/mount", routeParent},
+    {"POST", "/compute/servers/{id}/restore", routeAction},
+    {"GET", "/compute/servers/{id}/graph", routeAction},
+    {"POST", "/compute/servers/{id}/recreate", routeAction},
+    {"POST", "/compute/server-firewalls", routeCreate},
+    {"GET", "/compute/server-firewalls", routeList},
+    {"GET", "/compute/server-firewalls/{id}/rules/{rule_id}", routeGet},
+    {"DELETE", "/compute/server-firewalls/{id}/rules/{rule_id}", routeDelete},
+    {"PUT", "/compute/server-firewalls/{id}/rules/{rule_id}", routeUpdate},
+    {"POST", "/compute/server-hosts", routeCreate},
+    {"GET", "/compute/server-hosts", routeList},
+    {"POST", "/compute/servers", routeCreate},
+    {"GET", "/compute/servers", routeList},
+    {"DELETE", "/compute/servers/{id}/networks/{network_id}", routeDelete},
+    {"GET", "/compute/availability-zones/{id}", routeGet},
+    {"PUT", "/compute/availability-zones/{id}", routeUpdate},
+    {"POST", "/compute/server-backups", routeCreate},
+    {"GET", "/compute/server-backups", routeList},
+    {"POST", "/compute/subnets", routeCreate},
+    {"GET", "/compute/subnets", routeList},
+    {"POST", "/compute/server-volumes", routeCreate},
+    {"GET", "/compute/server-volumes", routeList},
+    {"POST", "/compute/server-storage-classes", routeCreate},
+    {"GET", "/compute/server-storage-classes", routeList},
+    {"GET", "/compute/server-firewalls/{id}/members/{member_id}", routeGet},
+    {"DELETE", "/compute/server-firewalls/{id}/members/{member_id}", routeDelete},
+    {"GET", "/compute/search", routeAction},
+    {"GET", "/compute/servers/{id}/scheduled-actions/{action_id}", routeGet},
+    {"DELETE", "/compute/servers/{id}/scheduled-actions/{action_id}", routeDelete},
+    {"PUT", "/compute/servers/{id}/scheduled-actions/{action_id}", routeUpdate},
+    {"POST", "/compute/storage/s3/buckets", routeCreate},
+    {"GET", "/compute/storage/s3/buckets", routeList},
+    {"GET", "/compute/servers/{id}/status", routeAction},
+    {"POST", "/compute/server-firewalls/{id}/members", routeCreate},
+    {"GET", "/compute/server-firewalls/{id}/members", routeList},
+    {"GET", "/compute/server-price-ranges/{id}", routeGet},
+    {"GET", "/compute/server-actions/{id}", routeGet},
+    {"GET", "/compute/server-price-ranges/{id}/variant-prices/{variant_id}", routeGet},
+    {"DELETE", "/compute/server-price-ranges/{id}/variant-prices/{variant_id}", routeDelete},
+    {"PUT", "/compute/server-price-ranges/{id}/variant-prices/{variant_id}", routeUpdate},
+    {"GET", "/compute/pricing/server-volumes", routeList},
+    {"POST", "/compute/server-templates", routeCreate},
+    {"GET", "/compute/server-templates", routeList},
+    {"GET", "/compute/server-hosts/{id}", routeGet},
+    {"PUT", "/compute/server-hosts/{id}", routeUpdate},
+    {"POST", "/compute/server-firewalls/{id}/rules", routeCreate},
+    {"GET", "/compute/server-firewalls/{id}/rules", routeList},
+    {"POST", "/compute/server-price-ranges/{id}/volume-prices", routeCreate},
+    {"GET", "/compute/server-price-ranges/{id}/volume-prices", routeList},
+    {"POST", "/compute/servers/{id}/scheduled-actions", routeCreate},
+    {"GET", "/compute/servers/{id}/scheduled-actions", routeList},
+    {"GET", "/compute/pricing/servers", routeList},
+    {"POST", "/compute/servers/{id}/stop", routeAction},
+    {"GET", "/compute/server-volumes/{id}", routeGet},
+    {"DELETE", "/compute/server-volumes/{id}", routeDelete},
+    {"PUT", "/compute/server-volumes/{id}", routeUpdate},
+    {"POST", "/compute/servers/{id}/networks", routeCreate},
+    {"GET", "/compute/servers/{id}/networks", routeList},
+    {"POST", "/compute/server-variants", routeCreate},
+    {"GET", "/compute/server-variants", routeList},
+    {"GET", "/compute/server-storages/{id}", routeGet},
+    {"GET", "/compute/ssh-keys/{id}", routeGet},
+    {"DELETE", "/compute/ssh-keys/{id}", routeDelete},
+    {"PUT", "/compute/ssh-keys/{id}", routeUpdate},
+    {"POST", "/compute/server-price-range-assignments", routeCreate},
+    {"GET", "/compute/server-price-range-assignments", routeList},
+    {"GET", "/compute/addresses", routeList},
+    {"GET", "/compute/server-variants/{id}", routeGet},
+    {"DELETE", "/compute/server-variants/{id}", routeDelete},
+    {"DELETE", "/compute/storage/s3/access-keys/{access_key_id}/grants/{id}", routeDelete},
+    {"POST", "/compute/server-medias", routeCreate},
+    {"GET", "/compute/server-medias", routeList},
+    {"GET", "/compute/subnets/{id}", routeGet},
+    {"DELETE", "/compute/subnets/{id}", routeDelete},
+    {"POST", "/compute/server-volumes/{id}/attach", routeParent},
+    {"GET", "/compute/server-price-ranges/{id}/volume-prices/{class_id}", routeGet},
+    {"DELETE", "/compute/server-price-ranges/{id}/volume-prices/{class_id}", routeDelete},
+    {"PUT", "/compute/server-price-ranges/{id}/volume-prices/{class_id}", routeUpdate},
+    {"GET", "/compute/storage/s3/access-keys/{id}", routeGet},
+    {"DELETE", "/compute/storage/s3/access-keys/{id}", routeDelete},
+    {"POST", "/compute/storage/s3/access-keys", routeCreate},
+    {"GET", "/compute/storage/s3/access-keys", routeList},
+    {"GET", "/compute/addresses/{id}", routeGet},
+    {"GET", "/compute/server-backups/{id}", routeGet},
+    {"DELETE", "/compute/server-backups/{id}", routeDelete},
+    {"PUT", "/compute/server-backups/{id}", routeUpdate},
+    {"POST", "/compute/networks", routeCreate},
+    {"GET", "/compute/networks", routeList},
+    {"POST", "/compute/server-storages", routeCreate},
+    {"GET", "/compute/server-storages", routeList},
+    {"POST", "/compute/servers/{id}/resize", routeAction},
+    {"GET", "/compute/server-medias/{id}", routeGet},
+    {"DELETE", "/compute/server-medias/{id}", routeDelete},
+    {"POST", "/compute/storage/s3/access-keys/{access_key_id}/grants", routeCreate},
+    {"GET", "/compute/storage/s3/access-keys/{access_key_id}/grants", routeList},
+    {"GET", "/compute/server-price-range-assignments/{id}", routeGet},
+    {"DELETE", "/compute/server-price-range-assignments/{id}", routeDelete},
+    {"PUT", "/compute/server-price-range-assignments/{id}", routeUpdate},
+    {"GET", "/compute/servers/{id}/vnc", routeAction},
+    {"POST", "/compute/server-actions/{id}/cancel", routeParent},
+    {"GET", "/compute/networks/{id}", routeGet},
+    {"PUT", "/compute/networks/{id}", routeUpdate},
+    {"GET", "/compute/labels", routeList},
+    {"POST", "/compute/server-volumes/{id}/resize", routeParent},
+    {"GET", "/compute/storage/s3/buckets/{id}", routeGet},
+    {"DELETE", "/compute/storage/s3/buckets/{id}", routeDelete},
+    {"POST", "/compute/server-volumes/{id}/detach", routeParent},
+    {"POST", "/compute/server-price-ranges/{id}/variant-prices", routeCreate},
+    {"GET", "/compute/server-price-ranges/{id}/variant-prices", routeList},
+    {"GET", "/domain/domain-handles/{code}", routeGet},
+    {"DELETE", "/domain/domain-handles/{code}", routeDelete},
+    {"PUT", "/domain/domain-handles/{code}", routeUpdate},
+    {"POST", "/domain/domains/{name}/unschedule-delete", routeParent},
+    {"POST", "/domain/dns/zones/{name}/records", routeCreate},
+    {"GET", "/domain/dns/zones/{name}/records", routeList},
+    {"PUT", "/domain/dns/zones/{name}/records", routeReplace},
+    {"POST", "/domain/domains/{name}/schedule-delete", routeParent},
+    {"GET", "/domain/search", routeAction},
+    {"GET", "/domain/pricing/domains", routeList},
+    {"GET", "/domain/domains/{name}/authinfo", routeAction},
+    {"DELETE", "/domain/domains/{name}/authinfo", routeAction},
+    {"POST", "/domain/domains/{name}/restore", routeAction},
+    {"GET", "/domain/dns/zones", routeList},
+    {"DELETE", "/domain/dns/zones/{name}/records/{id}", routeDelete},
+    {"PUT", "/domain/dns/zones/{name}/records/{id}", routeUpdate},
+    {"POST", "/domain/domains/{name}/verification", routeAction},
+    {"GET", "/domain/domains/{name}/verification", routeAction},
+    {"GET", "/domain/dns/zones/{name}", routeGet},
+    {"PUT", "/domain/dns/zones/{name}", routeUpdate},
+    {"GET", "/domain/labels", routeList},
+    {"POST", "/domain/domain-handles", routeCreate},
+    {"GET", "/domain/domain-handles", routeList},
+    {"GET", "/domain/domains/{name}/check", routeAction},
+    {"POST", "/domain/domains", routeCreate},
+    {"GET", "/domain/domains", routeList},
+    {"GET", "/domain/domains/{name}", routeGet},
+    {"DELETE", "/domain/domains/{name}", routeDelete},
+    {"PUT", "/domain/domains/{name}", routeUpdate},
+}
@@ -0,0 +1,496 @@
+package lumaservtest
+
+import (
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "path"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+type routeKind int
+
+const (
+    routeList routeKind = iota
+    routeCreate
+    routeReplace
+    routeGet
+    routeUpdate
+    routeDelete
+    routeParent
+    routeServerAction
+    routeFile
+    routeAction
+)
+
+type route struct {
+    method string
+    pattern string
+    kind routeKind
+}
+
+// Request is a request recorded by the fake server.
+type Request struct {
+    Method string
+    Path string
+    Query string
+    Body []byte
+}
+
+// Server is an in-memory fake of the LUMASERV APIs backed by httptest. Every service is
+// served below its own path prefix (/addon, /auth, /billing, /compute and /domain), see
+// ServiceUrl and the client constructors.
+type Server struct {
+    *httptest.Server
+    // Token is the expected bearer token. If empty, any token is accepted.
+    Token string
+    // PageSize is used for list requests without a page_size parameter.
+    PageSize int
+
+    mu sync.Mutex
+    collections map[string][]map[string]interface{}
+    faults []*Fault
+    requests []Request
+    nextId int
+}
+
+// NewServer starts a new fake server. It has to be closed by the caller.
+func NewServer() *Server {
+    s := &Server{
+        PageSize: 25,
+        collections: map[string][]map[string]interface{}{},
+    }
+    s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+    return s
+}
+
+func (s *Server) ServiceUrl(service string) string {
+    return s.URL + "/" + service
+}
+
+// Seed stores items in the collection at the given path, e.g. "/compute/servers". Items
+// are encoded to JSON, so both API types and maps can be passed.
+func (s *Server) Seed(collection string, items ...interface{}) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    for _, item := range items {
+        j, err := json.Marshal(item)
+        if err != nil {
+            panic(err)
+        }
+        m := map[string]interface{}{}
+        if err := json.Unmarshal(j, &m); err != nil {
+            panic(err)
+        }
+        s.defaults(m)
+        s.collections[collection] = append(s.collections[collection], m)
+    }
+}
+
+// Items returns the items currently stored in the collection at the given path.
+func (s *Server) Items(collection string) []map[string]interface{} {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    items := make([]map[string]interface{}, len(s.collections[collection]))
+    for i, item := range s.collections[collection] {
+        items[i] = copyItem(item)
+    }
+    return items
+}
+
+// Requests returns all requests received so far.
+func (s *Server) Requests() []Request {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return append([]Request{}, s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+    body, _ := ioutil.ReadAll(r.Body)
+
+    s.mu.Lock()
+    s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: body})
+    fault := s.matchFault(r)
+    s.mu.Unlock()
+
+    if fault != nil {
+        if fault.Latency > 0 {
+            select {
+                case <-time.After(fault.Latency):
+                case <-r.Context().Done():
+                    return
+            }
+        }
+        if fault.StatusCode != 0 {
+            for k, v := range fault.Header {
+                w.Header()[k] = v
+            }
+            s.fail(w, fault.StatusCode, fault.Errors...)
+            return
+        }
+    }
+
+    if len(s.Token) > 0 && r.Header.Get("Authorization") != "Bearer "+s.Token {
+        s.fail(w, http.StatusUnauthorized, core.ResponseMessage{Key: "unauthorized", Message: "Unauthorized"})
+        return
+    }
+
+    rt, params := matchRoute(r.Method, r.URL.Path)
+    if rt == nil {
+        s.fail(w, http.StatusNotFound, core.ResponseMessage{Key: "route_not_found", Message: "Route not found"})
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if h, ok := handlers[rt.method+" "+rt.pattern]; ok {
+        h(s, w, r, rt, params, body)
+        return
+    }
+    switch rt.kind {
+        case routeList:
+            s.list(w, r)
+        case routeCreate:
+            s.create(w, r, body, nil)
+        case routeReplace:
+            s.replace(w, r, body)
+        case routeGet, routeUpdate, routeDelete:
+            s.item(w, r, rt.kind, params[len(params)-1], body)
+        case routeParent, routeServerAction, routeFile, routeAction:
+            s.action(w, r, rt, params, body)
+    }
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+    q := r.URL.Query()
+    if !s.parentExists(r.URL.Path) {
+        s.notFound(w)
+        return
+    }
+
+    items := []map[string]interface{}{}
+    for _, item := range s.collections[r.URL.Path] {
+        if matchFilter(item, q) {
+            items = append(items, item)
+        }
+    }
+
+    page, _ := strconv.Atoi(q.Get("page"))
+    if page < 1 {
+        page = 1
+    }
+    pageSize, _ := strconv.Atoi(q.Get("page_size"))
+    if pageSize < 1 {
+        pageSize = s.PageSize
+    }
+    total := len(items)
+    start := (page - 1) * pageSize
+    if start > total {
+        start = total
+    }
+    end := start + pageSize
+    if end > total {
+        end = total
+    }
+
+    s.respond(w, http.StatusOK, items[start:end], &core.ResponsePagination{Total: total, Page: page, PageSize: pageSize})
+}
+
+// create adds the item in the body to the collection, init can set additional fields.
+func (s *Server) create(w http.ResponseWriter, r *http.Request, body []byte, init func(item map[string]interface{})) {
+    if !s.parentExists(r.URL.Path) {
+        s.notFound(w)
+        return
+    }
+    item := map[string]interface{}{}
+    if err := json.Unmarshal(body, &item); err != nil {
+        s.fail(w, http.StatusBadRequest, core.ResponseMessage{Key: "invalid_body", Message: err.Error()})
+        return
+    }
+    s.defaults(item)
+    if init != nil {
+        init(item)
+    }
+    s.collections[r.URL.Path] = append(s.collections[r.URL.Path], item)
+    s.respond(w, http.StatusCreated, item, nil)
+}
+
+func (s *Server) replace(w http.ResponseWriter, r *http.Request, body []byte) {
+    if !s.parentExists(r.URL.Path) {
+        s.notFound(w)
+        return
+    }
+    var raw interface{}
+    if err := json.Unmarshal(body, &raw); err != nil {
+        s.fail(w, http.StatusBadRequest, core.ResponseMessage{Key: "invalid_body", Message: err.Error()})
+        return
+    }
+    list, ok := raw.([]interface{})
+    if obj, isObj := raw.(map[string]interface{}); isObj {
+        for _, v := range obj {
+            if l, isList := v.([]interface{}); isList {
+                list, ok = l, true
+            }
+        }
+    }
+    if !ok {
+        s.fail(w, http.StatusBadRequest, core.ResponseMessage{Key: "invalid_body", Message: "Expected a list of items"})
+        return
+    }
+
+    items := []map[string]interface{}{}
+    for _, v := range list {
+        item, isObj := v.(map[string]interface{})
+        if !isObj {
+            s.fail(w, http.StatusBadRequest, core.ResponseMessage{Key: "invalid_body", Message: "Expected a list of items"})
+            return
+        }
+        s.defaults(item)
+        items = append(items, item)
+    }
+    s.collections[r.URL.Path] = items
+    s.respond(w, http.StatusOK, items, &core.ResponsePagination{Total: len(items), Page: 1, PageSize: len(items)})
+}
+
+func (s *Server) item(w http.ResponseWriter, r *http.Request, kind routeKind, param string, body []byte) {
+    collection, key := path.Split(r.URL.Path)
+    collection = strings.TrimSuffix(collection, "/")
+    i := s.find(collection, param, key)
+    if i < 0 {
+        s.notFound(w)
+        return
+    }
+    item := s.collections[collection][i]
+
+    switch kind {
+        case routeUpdate:
+            update := map[string]interface{}{}
+            if err := json.Unmarshal(body, &update); err != nil {
+                s.fail(w, http.StatusBadRequest, core.ResponseMessage{Key: "invalid_body", Message: err.Error()})
+                return
+            }
+            // Like the API, null leaves a field unchanged.
+            for k, v := range update {
+                if v != nil {
+                    item[k] = v
+                }
+            }
+        case routeDelete:
+            items := s.collections[collection]
+            s.collections[collection] = append(items[:i:i], items[i+1:]...)
+            s.respond(w, http.StatusOK, nil, nil)
+            return
+    }
+    s.respond(w, http.StatusOK, item, nil)
+}
+
+func (s *Server) action(w http.ResponseWriter, r *http.Request, rt *route, params []string, body []byte) {
+    patternParent, _ := path.Split(rt.pattern)
+    if !strings.HasSuffix(patternParent, "}/") {
+        s.respond(w, http.StatusOK, nil, nil)
+        return
+    }
+    item, key := s.actionItem(r, params)
+    if item == nil {
+        s.notFound(w)
+        return
+    }
+    _, name := path.Split(r.URL.Path)
+
+    switch rt.kind {
+        case routeParent:
+            if len(body) > 0 {
+                update := map[string]interface{}{}
+                if err := json.Unmarshal(body, &update); err == nil {
+                    for k, v := range update {
+                        item[k] = v
+                    }
+                }
+            }
+            s.respond(w, http.StatusOK, item, nil)
+        case routeServerAction:
+            now := time.Now().UTC().Format(time.RFC3339)
+            action := map[string]interface{}{
+                "type": strings.ToUpper(name),
+                "state": "FINISHED",
+                "progress": 100,
+                "cancellable": false,
+                "started_at": now,
+                "ended_at": now,
+            }
+            s.defaults(action)
+            s.collections["/compute/server-actions"] = append(s.collections["/compute/server-actions"], action)
+            s.respond(w, http.StatusOK, action, nil)
+        case routeFile:
+            w.Header().Set("Content-Type", "application/pdf")
+            w.Header().Set("Content-Disposition", "attachment; filename=\""+key+".pdf\"")
+            w.Write([]byte("%PDF-1.4\n% lumaservtest " + key + "\n%%EOF\n"))
+        default:
+            s.respond(w, http.StatusOK, nil, nil)
+    }
+}
+
+// actionItem returns the item an action like /compute/servers/{id}/start belongs to and
+// its key. The item is nil if it does not exist.
+func (s *Server) actionItem(r *http.Request, params []string) (map[string]interface{}, string) {
+    parentPath, _ := path.Split(r.URL.Path)
+    collection, key := path.Split(strings.TrimSuffix(parentPath, "/"))
+    collection = strings.TrimSuffix(collection, "/")
+    i := s.find(collection, params[len(params)-1], key)
+    if i < 0 {
+        return nil, key
+    }
+    return s.collections[collection][i], key
+}
+
+// parentExists reports whether the item a nested collection belongs to exists.
+func (s *Server) parentExists(collection string) bool {
+    parent, key := path.Split(strings.TrimSuffix(path.Dir(collection), "/"))
+    parent = strings.TrimSuffix(parent, "/")
+    rt, params := matchRoute(http.MethodGet, parent)
+    if rt == nil || rt.kind != routeList {
+        return true
+    }
+    itemRoute, itemParams := matchRoute(http.MethodGet, parent+"/"+key)
+    if itemRoute == nil || len(itemParams) <= len(params) {
+        return true
+    }
+    return s.find(parent, itemParams[len(itemParams)-1], key) >= 0
+}
+
+func (s *Server) find(collection string, param string, key string) int {
+    for i, item := range s.collections[collection] {
+        v, ok := item[param]
+        if !ok {
+            v = item["id"]
+        }
+        if str, isStr := v.(string); isStr && str == key {
+            return i
+        }
+    }
+    return -1
+}
+
+func (s *Server) defaults(item map[string]interface{}) {
+    if _, ok := item["id"]; !ok {
+        s.nextId++
+        item["id"] = "fake-" + strconv.Itoa(s.nextId)
+    }
+    if _, ok := item["created_at"]; !ok {
+        item["created_at"] = time.Now().UTC().Format(time.RFC3339)
+    }
+}
+
+func (s *Server) notFound(w http.ResponseWriter) {
+    s.fail(w, http.StatusNotFound, core.ResponseMessage{Key: "not_found", Message: "Not found"})
+}
+
+func (s *Server) fail(w http.ResponseWriter, status int, errs ...core.ResponseMessage) {
+    s.write(w, status, envelope{
+        Success: false,
+        Messages: core.ResponseMessages{Errors: errs},
+    })
+}
+
+func (s *Server) respond(w http.ResponseWriter, status int, data interface{}, pagination *core.ResponsePagination) {
+    s.write(w, status, envelope{
+        Success: true,
+        Data: data,
+        Pagination: pagination,
+    })
+}
+
+type envelope struct {
+    Metadata core.ResponseMetadata `json:"metadata"`
+    Pagination *core.ResponsePagination `json:"pagination,omitempty"`
+    Data interface{} `json:"data"`
+    Success bool `json:"success"`
+    Messages core.ResponseMessages `json:"messages"`
+}
+
+func (s *Server) write(w http.ResponseWriter, status int, e envelope) {
+    e.Metadata.TransactionId = "fake-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+    e.Metadata.BuildCommit = "lumaservtest"
+    if e.Messages.Errors == nil {
+        e.Messages.Errors = []core.ResponseMessage{}
+    }
+    e.Messages.Warnings = []core.ResponseMessage{}
+    e.Messages.Infos = []core.ResponseMessage{}
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(status)
+    json.NewEncoder(w).Encode(e)
+}
+
+// matchRoute finds the route for the given request, preferring literal segments over
+// parameters, and returns the names of the matched parameters.
+func matchRoute(method string, p string) (*route, []string) {
+    segs := strings.Split(p, "/")
+    var best *route
+    var bestParams []string
+    for i := range routes {
+        rt := &routes[i]
+        if rt.method != method {
+            continue
+        }
+        patternSegs := strings.Split(rt.pattern, "/")
+        if len(patternSegs) != len(segs) {
+            continue
+        }
+        params := []string{}
+        ok := true
+        for j, ps := range patternSegs {
+            if strings.HasPrefix(ps, "{") {
+                params = append(params, strings.Trim(ps, "{}"))
+            } else if ps != segs[j] {
+                ok = false
+                break
+            }
+        }
+        if ok && (best == nil || len(params) < len(bestParams)) {
+            best, bestParams = rt, params
+        }
+    }
+    return best, bestParams
+}
+
+// matchFilter applies filter[field]=value and filter[labels][name]=value parameters.
+func matchFilter(item map[string]interface{}, q map[string][]string) bool {
+    keys := make([]string, 0, len(q))
+    for k := range q {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    for _, k := range keys {
+        if !strings.HasPrefix(k, "filter[") {
+            continue
+        }
+        parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(k, "filter["), "]"), "][")
+        value := q[k][0]
+        if len(parts) == 2 && parts[0] == "labels" {
+            labels, _ := item["labels"].(map[string]interface{})
+            if v, ok := labels[parts[1]].(string); !ok || v != value {
+                return false
+            }
+            continue
+        }
+        if v, ok := item[parts[0]]; ok {
+            if str, isStr := v.(string); isStr && str != value {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+func copyItem(item map[string]interface{}) map[string]interface{} {
+    j, _ := json.Marshal(item)
+    c := map[string]interface{}{}
+    json.Unmarshal(j, &c)
+    return c
+}
@@ -0,0 +1,165 @@
+package lumaservtest
+
+import (
+    "encoding/json"
+    "net/http"
+    "strings"
+    "testing"
+    "github.com/lumaserv/lumaserv-api-go/core"
+)
+
+func request(t *testing.T, s *Server, method string, p string, body string) (int, core.Envelope, map[string]interface{}) {
+    t.Helper()
+    req, err := http.NewRequest(method, s.URL+p, strings.NewReader(body))
+    if err != nil {
+        t.Fatal(err)
+    }
+    req.Header.Set("Authorization", "Bearer "+s.Token)
+    res, err := http.DefaultClient.Do(req)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer res.Body.Close()
+    raw := struct {
+        core.Envelope
+        Data json.RawMessage `json:"data"`
+    }{}
+    if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+        t.Fatal(err)
+    }
+    data := map[string]interface{}{}
+    json.Unmarshal(raw.Data, &data)
+    return res.StatusCode, raw.Envelope, data
+}
+
+func TestRoutes(t *testing.T) {
+    s := NewServer()
+    defer s.Close()
+    s.Seed("/compute/servers", map[string]interface{}{"id": "s1", "name": "web", "state": "STOPPED"})
+
+    tests := []struct {
+        method string
+        path string
+        body string
+        status int
+        field string
+        value interface{}
+    }{
+        {"GET", "/compute/servers/s1", "", 200, "name", "web"},
+        {"PUT", "/compute/servers/s1", `{"name":"app","labels":null}`, 200, "name", "app"},
+        {"POST", "/compute/servers/s1/start", "", 200, "", nil},
+        {"GET", "/compute/servers/s1", "", 200, "state", "RUNNING"},
+        {"POST", "/compute/servers", `{"name":"db"}`, 201, "state", "RUNNING"},
+        {"POST", "/compute/servers/missing/start", "", 404, "", nil},
+        {"GET", "/compute/unknown", "", 404, "", nil},
+        {"POST", "/compute/server-firewalls/f1/rules", `{}`, 404, "", nil},
+        {"DELETE", "/compute/servers/s1", "", 200, "", nil},
+        {"GET", "/compute/servers/s1", "", 404, "", nil},
+    }
+    for _, tt := range tests {
+        status, _, data := request(t, s, tt.method, tt.path, tt.body)
+        if status != tt.status {
+            t.Errorf("%s %s: got status %d, want %d", tt.method, tt.path, status, tt.status)
+            continue
+        }
+        if len(tt.field) > 0 && data[tt.field] != tt.value {
+            t.Errorf("%s %s: got %s %v, want %v", tt.method, tt.path, tt.field, data[tt.field], tt.value)
+        }
+    }
+}
+
+func TestListPagingAndFilter(t *testing.T) {
+    s := NewServer()
+    defer s.Close()
+    for i := 0; i < 5; i++ {
+        env := "dev"
+        if i%2 == 0 {
+            env = "prod"
+        }
+        s.Seed("/compute/servers", map[string]interface{}{"labels": map[string]string{"env": env}})
+    }
+
+    tests := []struct {
+        query string
+        count int
+        total int
+    }{
+        {"", 5, 5},
+        {"?page_size=2&page=3", 1, 5},
+        {"?page_size=2&page=4", 0, 5},
+        {"?filter[labels][env]=prod", 3, 3},
+        {"?filter[labels][env]=test", 0, 0},
+        {"?filter[id]=fake-2", 1, 1},
+    }
+    for _, tt := range tests {
+        res, err := http.Get(s.URL + "/compute/servers" + tt.query)
+        if err != nil {
+            t.Fatal(err)
+        }
+        body := struct {
+            Data []map[string]interface{} `json:"data"`
+            Pagination core.ResponsePagination `json:"pagination"`
+        }{}
+        json.NewDecoder(res.Body).Decode(&body)
+        res.Body.Close()
+        if len(body.Data) != tt.count || body.Pagination.Total != tt.total {
+            t.Errorf("%q: got %d of %d items, want %d of %d", tt.query, len(body.Data), body.Pagination.Total, tt.count, tt.total)
+        }
+    }
+}
+
+func TestHandlers(t *testing.T) {
+    s := NewServer()
+    defer s.Close()
+    s.Seed("/domain/domains", map[string]interface{}{"name": "example.com"})
+    s.Seed("/compute/server-firewalls", map[string]interface{}{"id": "f1"})
+
+    if _, _, data := request(t, s, "GET", "/domain/domains/example.com/check", ""); data["available"] != false {
+        t.Errorf("registered domain reported as available")
+    }
+    if _, _, data := request(t, s, "GET", "/domain/domains/example.org/check", ""); data["available"] != true {
+        t.Errorf("unknown domain reported as unavailable")
+    }
+
+    _, _, first := request(t, s, "GET", "/domain/domains/example.com/authinfo", "")
+    _, _, second := request(t, s, "GET", "/domain/domains/example.com/authinfo", "")
+    if first["authinfo"] == nil || first["authinfo"] != second["authinfo"] {
+        t.Errorf("authinfo not kept: %v, %v", first, second)
+    }
+    request(t, s, "DELETE", "/domain/domains/example.com/authinfo", "")
+    if _, ok := s.Items("/domain/domains")[0]["authinfo"]; ok {
+        t.Errorf("authinfo not deleted")
+    }
+
+    if status, _, data := request(t, s, "POST", "/compute/server-firewalls/f1/rules", `{"direction":"INGRESS"}`); status != 201 || data["applied"] != true {
+        t.Errorf("firewall rule not applied: %d %v", status, data)
+    }
+}
+
+func TestTokenAndFaults(t *testing.T) {
+    s := NewServer()
+    defer s.Close()
+    s.Token = "secret"
+    s.InjectFault(Fault{Path: "/auth/projects", StatusCode: 503, Count: 1, Errors: []core.ResponseMessage{{Key: "unavailable"}}})
+
+    status, env, _ := request(t, s, "GET", "/auth/projects", "")
+    if status != 503 || len(env.Messages.Errors) != 1 || env.Messages.Errors[0].Key != "unavailable" {
+        t.Errorf("fault not injected: %d %v", status, env.Messages.Errors)
+    }
+    if status, _, _ := request(t, s, "GET", "/auth/projects", ""); status != 200 {
+        t.Errorf("fault not cleared after Count requests: %d", status)
+    }
+
+    s.Token = "other"
+    res, err := http.Get(s.URL + "/auth/projects")
+    if err != nil {
+        t.Fatal(err)
+    }
+    res.Body.Close()
+    if res.StatusCode != 401 {
+        t.Errorf("missing token: got status %d, want 401", res.StatusCode)
+    }
+    if n := len(s.Requests()); n != 3 {
+        t.Errorf("got %d recorded requests, want 3", n)
+    }
+}